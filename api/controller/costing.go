@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PosCostingSettingController interface {
+	HandleReadPosCostingSettingRequest(c *gin.Context)
+	HandleUpdatePosCostingSettingRequest(c *gin.Context)
+}
+
+type posCostingSettingController struct {
+	service pb.PosCostingSettingServiceClient
+}
+
+func NewPosCostingSettingController(service pb.PosCostingSettingServiceClient) PosCostingSettingController {
+	return &posCostingSettingController{
+		service: service,
+	}
+}
+
+func (ctrl *posCostingSettingController) HandleReadPosCostingSettingRequest(c *gin.Context) {
+	var req pb.ReadPosCostingSettingRequest
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_COSTING_SETTING, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.ReadPosCostingSetting(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_COSTING_SETTING, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_GET_COSTING_SETTING, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posCostingSettingController) HandleUpdatePosCostingSettingRequest(c *gin.Context) {
+	var req pb.UpdatePosCostingSettingRequest
+
+	if err := c.ShouldBindJSON(&req.PosCostingSetting); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_COSTING_SETTING, err.Error(), nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_COSTING_SETTING, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.UpdatePosCostingSetting(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_COSTING_SETTING, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_UPDATE_COSTING_SETTING, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
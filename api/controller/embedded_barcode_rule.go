@@ -0,0 +1,185 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PosEmbeddedBarcodeRuleController interface {
+	HandleCreatePosEmbeddedBarcodeRuleRequest(c *gin.Context)
+	HandleUpdatePosEmbeddedBarcodeRuleRequest(c *gin.Context)
+	HandleDeletePosEmbeddedBarcodeRuleRequest(c *gin.Context)
+	HandleReadAllPosEmbeddedBarcodeRulesRequest(c *gin.Context)
+}
+
+type posEmbeddedBarcodeRuleController struct {
+	service pb.PosEmbeddedBarcodeRuleServiceClient
+}
+
+func NewPosEmbeddedBarcodeRuleController(service pb.PosEmbeddedBarcodeRuleServiceClient) PosEmbeddedBarcodeRuleController {
+	return &posEmbeddedBarcodeRuleController{
+		service: service,
+	}
+}
+
+func (ctrl *posEmbeddedBarcodeRuleController) HandleCreatePosEmbeddedBarcodeRuleRequest(c *gin.Context) {
+	var req pb.CreatePosEmbeddedBarcodeRuleRequest
+
+	if err := c.ShouldBindJSON(&req.PosEmbeddedBarcodeRule); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_EMBEDDED_BARCODE_RULE, err.Error(), nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_EMBEDDED_BARCODE_RULE, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.CreatePosEmbeddedBarcodeRule(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_EMBEDDED_BARCODE_RULE, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_CREATE_EMBEDDED_BARCODE_RULE, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posEmbeddedBarcodeRuleController) HandleUpdatePosEmbeddedBarcodeRuleRequest(c *gin.Context) {
+	var req pb.UpdatePosEmbeddedBarcodeRuleRequest
+
+	if err := c.ShouldBindJSON(&req.PosEmbeddedBarcodeRule); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_EMBEDDED_BARCODE_RULE, err.Error(), nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	req.PosEmbeddedBarcodeRule.RuleId = c.Param("id")
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_EMBEDDED_BARCODE_RULE, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.UpdatePosEmbeddedBarcodeRule(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_EMBEDDED_BARCODE_RULE, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_UPDATE_EMBEDDED_BARCODE_RULE, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posEmbeddedBarcodeRuleController) HandleDeletePosEmbeddedBarcodeRuleRequest(c *gin.Context) {
+	var req pb.DeletePosEmbeddedBarcodeRuleRequest
+
+	req.RuleId = c.Param("id")
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_EMBEDDED_BARCODE_RULE, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.DeletePosEmbeddedBarcodeRule(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_EMBEDDED_BARCODE_RULE, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_DELETE_EMBEDDED_BARCODE_RULE, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posEmbeddedBarcodeRuleController) HandleReadAllPosEmbeddedBarcodeRulesRequest(c *gin.Context) {
+	var req pb.ReadAllPosEmbeddedBarcodeRulesRequest
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_EMBEDDED_BARCODE_RULE, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.ReadAllPosEmbeddedBarcodeRules(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_EMBEDDED_BARCODE_RULE, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_GET_EMBEDDED_BARCODE_RULE, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
@@ -11,14 +11,16 @@ import (
 	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type PosInventoryHistoryController interface {
 	HandleCreatePosInventoryHistoryRequest(c *gin.Context)
 	HandleReadPosInventoryHistoryRequest(c *gin.Context)
-	HandleUpdatePosInventoryHistoryRequest(c *gin.Context)
-	HandleDeletePosInventoryHistoryRequest(c *gin.Context)
+	HandleReversePosInventoryHistoryRequest(c *gin.Context)
 	HandleReadAllPosInventoryHistoriesRequest(c *gin.Context)
+	HandleGetStockAsOfRequest(c *gin.Context)
+	HandleReadPosStockCardRequest(c *gin.Context)
 }
 
 type posInventoryHistoryController struct {
@@ -40,6 +42,22 @@ func (ctrl *posInventoryHistoryController) HandleCreatePosInventoryHistoryReques
 		return
 	}
 
+	if req.PosInventoryHistory == nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_INVENTORY_HISTORY, "Inventory History is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Check movement type and quantity sign before calling the service, a quantity given in another
+	// unit is only known once the service converts it to the base unit
+	if req.PosInventoryHistory.Unit == "" {
+		if err := utils.ValidateMovementQuantity(req.PosInventoryHistory.MovementType, int(req.PosInventoryHistory.Quantity)); err != nil {
+			errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_INVENTORY_HISTORY, err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+	}
+
 	// Get JWT Payload data from middleware
 	getJwtPayload, exist := c.Get("user")
 	if !exist {
@@ -108,21 +126,115 @@ func (ctrl *posInventoryHistoryController) HandleReadPosInventoryHistoryRequest(
 	c.JSON(http.StatusOK, resp)
 }
 
-func (ctrl *posInventoryHistoryController) HandleUpdatePosInventoryHistoryRequest(c *gin.Context) {
-	var req pb.UpdatePosInventoryHistoryRequest
+func (ctrl *posInventoryHistoryController) HandleReversePosInventoryHistoryRequest(c *gin.Context) {
+	var req pb.ReversePosInventoryHistoryRequest
+
+	// The body with the note of the reversal is optional
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_REVERSE_INVENTORY_HISTORY, err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+	}
 
 	inventoryID := c.Param("id")
+	req.InventoryId = inventoryID
 
-	if err := c.ShouldBindJSON(&req.PosInventoryHistory); err != nil {
-		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_INVENTORY_HISTORY, err.Error(), nil)
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_REVERSE_INVENTORY_HISTORY, "Jwt Payload is Empty", nil)
 		c.JSON(http.StatusBadRequest, errorResponse)
 		return
 	}
 
-	// Get JWT Payload data from middleware
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.ReversePosInventoryHistory(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_REVERSE_INVENTORY_HISTORY, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (ctrl *posInventoryHistoryController) HandleReadAllPosInventoryHistoriesRequest(c *gin.Context) {
+	limitQuery := c.Query("limit")
+	pageQuery := c.Query("page")
+
+	if (limitQuery == "" && pageQuery != "") || (limitQuery != "" && pageQuery == "") {
+		errorResponse := utils.BuildResponseFailed("Both limit and page must be provided", "Value Is Empty", pb.CreatePosInventoryHistoryResponse{})
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	var req pb.ReadAllPosInventoryHistoriesRequest
+
+	if limitQuery != "" && pageQuery != "" {
+		limit, err := strconv.Atoi(limitQuery)
+		if err != nil {
+			errorResponse := utils.BuildResponseFailed("Invalid limit value", err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+
+		page, err := strconv.Atoi(pageQuery)
+		if err != nil {
+			errorResponse := utils.BuildResponseFailed("Invalid page value", err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+
+		req = pb.ReadAllPosInventoryHistoriesRequest{
+			Limit: int32(limit),
+			Page:  int32(page),
+		}
+	}
+
+	if dateFromQuery := c.Query("date_from"); dateFromQuery != "" {
+		dateFrom, err := utils.ParseFromTime(dateFromQuery)
+		if err != nil {
+			errorResponse := utils.BuildResponseFailed("Invalid date_from value", "date_from must be a date (YYYY-MM-DD) or an RFC3339 time", nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+		req.DateFrom = timestamppb.New(dateFrom)
+	}
+
+	if dateToQuery := c.Query("date_to"); dateToQuery != "" {
+		dateTo, err := utils.ParseAsOfTime(dateToQuery)
+		if err != nil {
+			errorResponse := utils.BuildResponseFailed("Invalid date_to value", "date_to must be a date (YYYY-MM-DD) or an RFC3339 time", nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+		req.DateTo = timestamppb.New(dateTo)
+	}
+	req.ProductId = c.Query("product_id")
+	req.StoreId = c.Query("store_id")
+	req.BranchId = c.Query("branch_id")
+	req.QuantitySign = c.Query("quantity_sign")
+	req.CreatedBy = c.Query("created_by")
+	req.SortBy = c.Query("sort_by")
+	req.SortOrder = c.Query("sort_order")
+
 	getJwtPayload, exist := c.Get("user")
 	if !exist {
-		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_INVENTORY_HISTORY, "Jwt Payload is Empty", nil)
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_CATEGORY, "Jwt Payload is Empty", nil)
 		c.JSON(http.StatusBadRequest, errorResponse)
 		return
 	}
@@ -139,27 +251,64 @@ func (ctrl *posInventoryHistoryController) HandleUpdatePosInventoryHistoryReques
 
 	token := bearerToken[1]
 	req.JwtToken = token
-	req.PosInventoryHistory.InventoryId = inventoryID
-	resp, err := ctrl.service.UpdatePosInventoryHistory(c.Request.Context(), &req)
+
+	res, err := ctrl.service.ReadAllPosInventoryHistories(c.Request.Context(), &req)
 	if err != nil {
-		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_INVENTORY_HISTORY, err.Error(), nil)
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_INVENTORY_HISTORY, err.Error(), nil)
 		c.JSON(http.StatusInternalServerError, errorResponse)
 		return
 	}
 
-	c.JSON(http.StatusOK, resp)
+	c.JSON(http.StatusOK, res)
 }
 
-func (ctrl *posInventoryHistoryController) HandleDeletePosInventoryHistoryRequest(c *gin.Context) {
-	var req pb.DeletePosInventoryHistoryRequest
+func (ctrl *posInventoryHistoryController) HandleGetStockAsOfRequest(c *gin.Context) {
+	limitQuery := c.Query("limit")
+	pageQuery := c.Query("page")
 
-	inventoryID := c.Param("id")
-	req.InventoryId = inventoryID
+	if (limitQuery == "" && pageQuery != "") || (limitQuery != "" && pageQuery == "") {
+		errorResponse := utils.BuildResponseFailed("Both limit and page must be provided", "Value Is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	var req pb.GetStockAsOfRequest
+
+	if limitQuery != "" && pageQuery != "" {
+		limit, err := strconv.Atoi(limitQuery)
+		if err != nil {
+			errorResponse := utils.BuildResponseFailed("Invalid limit value", err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+
+		page, err := strconv.Atoi(pageQuery)
+		if err != nil {
+			errorResponse := utils.BuildResponseFailed("Invalid page value", err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+
+		req.Limit = int32(limit)
+		req.Page = int32(page)
+	}
+
+	if asOfQuery := c.Query("as_of"); asOfQuery != "" {
+		asOf, err := utils.ParseAsOfTime(asOfQuery)
+		if err != nil {
+			errorResponse := utils.BuildResponseFailed("Invalid as_of value", err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+		req.AsOf = timestamppb.New(asOf)
+	}
+	req.ProductId = c.Query("product_id")
+	req.StoreId = c.Query("store_id")
 
 	// Get JWT Payload data from middleware
 	getJwtPayload, exist := c.Get("user")
 	if !exist {
-		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_INVENTORY_HISTORY, "Jwt Payload is Empty", nil)
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_STOCK_AS_OF, "Jwt Payload is Empty", nil)
 		c.JSON(http.StatusBadRequest, errorResponse)
 		return
 	}
@@ -177,27 +326,27 @@ func (ctrl *posInventoryHistoryController) HandleDeletePosInventoryHistoryReques
 	token := bearerToken[1]
 	req.JwtToken = token
 
-	resp, err := ctrl.service.DeletePosInventoryHistory(c.Request.Context(), &req)
+	res, err := ctrl.service.GetStockAsOf(c.Request.Context(), &req)
 	if err != nil {
-		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_INVENTORY_HISTORY, err.Error(), nil)
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_STOCK_AS_OF, err.Error(), nil)
 		c.JSON(http.StatusInternalServerError, errorResponse)
 		return
 	}
 
-	c.JSON(http.StatusOK, resp)
+	c.JSON(http.StatusOK, res)
 }
 
-func (ctrl *posInventoryHistoryController) HandleReadAllPosInventoryHistoriesRequest(c *gin.Context) {
+func (ctrl *posInventoryHistoryController) HandleReadPosStockCardRequest(c *gin.Context) {
 	limitQuery := c.Query("limit")
 	pageQuery := c.Query("page")
 
 	if (limitQuery == "" && pageQuery != "") || (limitQuery != "" && pageQuery == "") {
-		errorResponse := utils.BuildResponseFailed("Both limit and page must be provided", "Value Is Empty", pb.CreatePosInventoryHistoryResponse{})
+		errorResponse := utils.BuildResponseFailed("Both limit and page must be provided", "Value Is Empty", nil)
 		c.JSON(http.StatusBadRequest, errorResponse)
 		return
 	}
 
-	var req pb.ReadAllPosInventoryHistoriesRequest
+	var req pb.ReadPosStockCardRequest
 
 	if limitQuery != "" && pageQuery != "" {
 		limit, err := strconv.Atoi(limitQuery)
@@ -214,14 +363,36 @@ func (ctrl *posInventoryHistoryController) HandleReadAllPosInventoryHistoriesReq
 			return
 		}
 
-		req = pb.ReadAllPosInventoryHistoriesRequest{
-			Limit: int32(limit),
-			Page:  int32(page),
+		req.Limit = int32(limit)
+		req.Page = int32(page)
+	}
+
+	if fromQuery := c.Query("from"); fromQuery != "" {
+		from, err := utils.ParseFromTime(fromQuery)
+		if err != nil {
+			errorResponse := utils.BuildResponseFailed("Invalid from value", "from must be a date (YYYY-MM-DD) or an RFC3339 time", nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+		req.From = timestamppb.New(from)
+	}
+
+	if toQuery := c.Query("to"); toQuery != "" {
+		to, err := utils.ParseAsOfTime(toQuery)
+		if err != nil {
+			errorResponse := utils.BuildResponseFailed("Invalid to value", err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
 		}
+		req.To = timestamppb.New(to)
 	}
+	req.ProductId = c.Param("product_id")
+	req.StoreId = c.Query("store_id")
+
+	// Get JWT Payload data from middleware
 	getJwtPayload, exist := c.Get("user")
 	if !exist {
-		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_CATEGORY, "Jwt Payload is Empty", nil)
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_STOCK_CARD, "Jwt Payload is Empty", nil)
 		c.JSON(http.StatusBadRequest, errorResponse)
 		return
 	}
@@ -239,9 +410,9 @@ func (ctrl *posInventoryHistoryController) HandleReadAllPosInventoryHistoriesReq
 	token := bearerToken[1]
 	req.JwtToken = token
 
-	res, err := ctrl.service.ReadAllPosInventoryHistories(c.Request.Context(), &req)
+	res, err := ctrl.service.ReadPosStockCard(c.Request.Context(), &req)
 	if err != nil {
-		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_INVENTORY_HISTORY, err.Error(), nil)
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_STOCK_CARD, err.Error(), nil)
 		c.JSON(http.StatusInternalServerError, errorResponse)
 		return
 	}
@@ -0,0 +1,194 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PosLowStockController interface {
+	HandleListLowStockProductsRequest(c *gin.Context)
+	HandleAcknowledgePosLowStockAlertRequest(c *gin.Context)
+	HandleReadAllPosLowStockAlertsRequest(c *gin.Context)
+}
+
+type posLowStockController struct {
+	service pb.PosLowStockServiceClient
+}
+
+func NewPosLowStockController(service pb.PosLowStockServiceClient) PosLowStockController {
+	return &posLowStockController{
+		service: service,
+	}
+}
+
+func (ctrl *posLowStockController) HandleListLowStockProductsRequest(c *gin.Context) {
+	limitQuery := c.Query("limit")
+	pageQuery := c.Query("page")
+
+	if (limitQuery == "" && pageQuery != "") || (limitQuery != "" && pageQuery == "") {
+		errorResponse := utils.BuildResponseFailed("Both limit and page must be provided", "Value Is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	var req pb.ListLowStockProductsRequest
+
+	if limitQuery != "" && pageQuery != "" {
+		limit, err := strconv.Atoi(limitQuery)
+		if err != nil {
+			errorResponse := utils.BuildResponseFailed("Invalid limit value", err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+
+		page, err := strconv.Atoi(pageQuery)
+		if err != nil {
+			errorResponse := utils.BuildResponseFailed("Invalid page value", err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+
+		req = pb.ListLowStockProductsRequest{
+			Limit: int32(limit),
+			Page:  int32(page),
+		}
+	}
+	req.StoreId = c.Query("store_id")
+
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_LOW_STOCK_PRODUCTS, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	res, err := ctrl.service.ListLowStockProducts(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_LOW_STOCK_PRODUCTS, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (ctrl *posLowStockController) HandleAcknowledgePosLowStockAlertRequest(c *gin.Context) {
+	var req pb.AcknowledgePosLowStockAlertRequest
+
+	alertID := c.Param("id")
+	req.AlertId = alertID
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_ACKNOWLEDGE_LOW_STOCK_ALERT, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.AcknowledgePosLowStockAlert(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_ACKNOWLEDGE_LOW_STOCK_ALERT, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_ACKNOWLEDGE_LOW_STOCK_ALERT, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posLowStockController) HandleReadAllPosLowStockAlertsRequest(c *gin.Context) {
+	limitQuery := c.Query("limit")
+	pageQuery := c.Query("page")
+
+	if (limitQuery == "" && pageQuery != "") || (limitQuery != "" && pageQuery == "") {
+		errorResponse := utils.BuildResponseFailed("Both limit and page must be provided", "Value Is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	var req pb.ReadAllPosLowStockAlertsRequest
+
+	if limitQuery != "" && pageQuery != "" {
+		limit, err := strconv.Atoi(limitQuery)
+		if err != nil {
+			errorResponse := utils.BuildResponseFailed("Invalid limit value", err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+
+		page, err := strconv.Atoi(pageQuery)
+		if err != nil {
+			errorResponse := utils.BuildResponseFailed("Invalid page value", err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+
+		req = pb.ReadAllPosLowStockAlertsRequest{
+			Limit: int32(limit),
+			Page:  int32(page),
+		}
+	}
+	req.Status = c.Query("status")
+
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_LOW_STOCK_ALERT, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	res, err := ctrl.service.ReadAllPosLowStockAlerts(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_LOW_STOCK_ALERT, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
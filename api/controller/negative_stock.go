@@ -0,0 +1,294 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PosNegativeStockController interface {
+	HandleReadPosNegativeStockSettingsRequest(c *gin.Context)
+	HandleResolvePosNegativeStockPolicyRequest(c *gin.Context)
+	HandleUpdatePosNegativeStockSettingRequest(c *gin.Context)
+	HandleDeletePosNegativeStockSettingRequest(c *gin.Context)
+	HandleReadAllPosNegativeStockViolationsRequest(c *gin.Context)
+	HandleReviewPosNegativeStockViolationRequest(c *gin.Context)
+}
+
+type posNegativeStockController struct {
+	service pb.PosNegativeStockServiceClient
+}
+
+func NewPosNegativeStockController(service pb.PosNegativeStockServiceClient) PosNegativeStockController {
+	return &posNegativeStockController{
+		service: service,
+	}
+}
+
+func (ctrl *posNegativeStockController) HandleReadPosNegativeStockSettingsRequest(c *gin.Context) {
+	var req pb.ReadPosNegativeStockSettingsRequest
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_NEGATIVE_STOCK_SETTING, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.ReadPosNegativeStockSettings(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_NEGATIVE_STOCK_SETTING, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_GET_NEGATIVE_STOCK_SETTING, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posNegativeStockController) HandleResolvePosNegativeStockPolicyRequest(c *gin.Context) {
+	var req pb.ResolvePosNegativeStockPolicyRequest
+
+	req.BranchId = c.Query("branch_id")
+	req.StoreId = c.Query("store_id")
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_RESOLVE_NEGATIVE_STOCK_POLICY, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.ResolvePosNegativeStockPolicy(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_RESOLVE_NEGATIVE_STOCK_POLICY, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_RESOLVE_NEGATIVE_STOCK_POLICY, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posNegativeStockController) HandleUpdatePosNegativeStockSettingRequest(c *gin.Context) {
+	var req pb.UpdatePosNegativeStockSettingRequest
+
+	if err := c.ShouldBindJSON(&req.PosNegativeStockSetting); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_NEGATIVE_STOCK_SETTING, err.Error(), nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_NEGATIVE_STOCK_SETTING, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.UpdatePosNegativeStockSetting(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_NEGATIVE_STOCK_SETTING, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_UPDATE_NEGATIVE_STOCK_SETTING, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posNegativeStockController) HandleDeletePosNegativeStockSettingRequest(c *gin.Context) {
+	var req pb.DeletePosNegativeStockSettingRequest
+
+	req.ScopeId = c.Param("scope_id")
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_NEGATIVE_STOCK_SETTING, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.DeletePosNegativeStockSetting(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_NEGATIVE_STOCK_SETTING, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_DELETE_NEGATIVE_STOCK_SETTING, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posNegativeStockController) HandleReadAllPosNegativeStockViolationsRequest(c *gin.Context) {
+	limitQuery := c.Query("limit")
+	pageQuery := c.Query("page")
+
+	if (limitQuery == "" && pageQuery != "") || (limitQuery != "" && pageQuery == "") {
+		errorResponse := utils.BuildResponseFailed("Both limit and page must be provided", "Value Is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	var req pb.ReadAllPosNegativeStockViolationsRequest
+
+	if limitQuery != "" && pageQuery != "" {
+		limit, err := strconv.Atoi(limitQuery)
+		if err != nil {
+			errorResponse := utils.BuildResponseFailed("Invalid limit value", err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+
+		page, err := strconv.Atoi(pageQuery)
+		if err != nil {
+			errorResponse := utils.BuildResponseFailed("Invalid page value", err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+
+		req = pb.ReadAllPosNegativeStockViolationsRequest{
+			Limit: int32(limit),
+			Page:  int32(page),
+		}
+	}
+	req.Status = c.Query("status")
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_NEGATIVE_STOCK_VIOLATION, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.ReadAllPosNegativeStockViolations(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_NEGATIVE_STOCK_VIOLATION, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (ctrl *posNegativeStockController) HandleReviewPosNegativeStockViolationRequest(c *gin.Context) {
+	var req pb.ReviewPosNegativeStockViolationRequest
+
+	// The body with the note of the review is optional
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_REVIEW_NEGATIVE_STOCK_VIOLATION, err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+	}
+
+	req.ViolationId = c.Param("id")
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_REVIEW_NEGATIVE_STOCK_VIOLATION, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.ReviewPosNegativeStockViolation(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_REVIEW_NEGATIVE_STOCK_VIOLATION, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_REVIEW_NEGATIVE_STOCK_VIOLATION, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
@@ -76,6 +76,7 @@ func (ctrl *posProductController) HandleReadPosProductRequest(c *gin.Context) {
 
 	productID := c.Param("id")
 	req.ProductId = productID
+	req.IncludeVariants = c.Query("include_variants") == "true"
 
 	// Get JWT Payload data from middleware
 	getJwtPayload, exist := c.Get("user")
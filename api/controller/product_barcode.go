@@ -0,0 +1,185 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PosProductBarcodeController interface {
+	HandleCreatePosProductBarcodeRequest(c *gin.Context)
+	HandleDeletePosProductBarcodeRequest(c *gin.Context)
+	HandleReadAllPosProductBarcodesRequest(c *gin.Context)
+	HandleGenerateInternalBarcodeRequest(c *gin.Context)
+}
+
+type posProductBarcodeController struct {
+	service pb.PosProductBarcodeServiceClient
+}
+
+func NewPosProductBarcodeController(service pb.PosProductBarcodeServiceClient) PosProductBarcodeController {
+	return &posProductBarcodeController{
+		service: service,
+	}
+}
+
+func (ctrl *posProductBarcodeController) HandleCreatePosProductBarcodeRequest(c *gin.Context) {
+	var req pb.CreatePosProductBarcodeRequest
+
+	if err := c.ShouldBindJSON(&req.PosProductBarcode); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_PRODUCT_BARCODE, err.Error(), nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_PRODUCT_BARCODE, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.CreatePosProductBarcode(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_PRODUCT_BARCODE, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_CREATE_PRODUCT_BARCODE, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posProductBarcodeController) HandleDeletePosProductBarcodeRequest(c *gin.Context) {
+	var req pb.DeletePosProductBarcodeRequest
+
+	req.BarcodeId = c.Param("id")
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_PRODUCT_BARCODE, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.DeletePosProductBarcode(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_PRODUCT_BARCODE, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_DELETE_PRODUCT_BARCODE, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posProductBarcodeController) HandleReadAllPosProductBarcodesRequest(c *gin.Context) {
+	var req pb.ReadAllPosProductBarcodesRequest
+
+	req.ProductId = c.Query("product_id")
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_PRODUCT_BARCODE, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.ReadAllPosProductBarcodes(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_PRODUCT_BARCODE, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_GET_PRODUCT_BARCODE, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posProductBarcodeController) HandleGenerateInternalBarcodeRequest(c *gin.Context) {
+	var req pb.GenerateInternalBarcodeRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GENERATE_BARCODE, err.Error(), nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GENERATE_BARCODE, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.GenerateInternalBarcode(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GENERATE_BARCODE, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_GENERATE_BARCODE, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
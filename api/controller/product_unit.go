@@ -0,0 +1,187 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PosProductUnitController interface {
+	HandleCreatePosProductUnitRequest(c *gin.Context)
+	HandleUpdatePosProductUnitRequest(c *gin.Context)
+	HandleDeletePosProductUnitRequest(c *gin.Context)
+	HandleReadAllPosProductUnitsRequest(c *gin.Context)
+}
+
+type posProductUnitController struct {
+	service pb.PosProductUnitServiceClient
+}
+
+func NewPosProductUnitController(service pb.PosProductUnitServiceClient) PosProductUnitController {
+	return &posProductUnitController{
+		service: service,
+	}
+}
+
+func (ctrl *posProductUnitController) HandleCreatePosProductUnitRequest(c *gin.Context) {
+	var req pb.CreatePosProductUnitRequest
+
+	if err := c.ShouldBindJSON(&req.PosProductUnit); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_PRODUCT_UNIT, err.Error(), nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_PRODUCT_UNIT, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.CreatePosProductUnit(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_PRODUCT_UNIT, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_CREATE_PRODUCT_UNIT, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posProductUnitController) HandleUpdatePosProductUnitRequest(c *gin.Context) {
+	var req pb.UpdatePosProductUnitRequest
+
+	if err := c.ShouldBindJSON(&req.PosProductUnit); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_PRODUCT_UNIT, err.Error(), nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	req.PosProductUnit.ProductUnitId = c.Param("id")
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_PRODUCT_UNIT, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.UpdatePosProductUnit(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_PRODUCT_UNIT, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_UPDATE_PRODUCT_UNIT, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posProductUnitController) HandleDeletePosProductUnitRequest(c *gin.Context) {
+	var req pb.DeletePosProductUnitRequest
+
+	req.ProductUnitId = c.Param("id")
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_PRODUCT_UNIT, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.DeletePosProductUnit(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DELETE_PRODUCT_UNIT, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_DELETE_PRODUCT_UNIT, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posProductUnitController) HandleReadAllPosProductUnitsRequest(c *gin.Context) {
+	var req pb.ReadAllPosProductUnitsRequest
+
+	req.ProductId = c.Query("product_id")
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_PRODUCT_UNIT, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.ReadAllPosProductUnits(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_PRODUCT_UNIT, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_GET_PRODUCT_UNIT, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
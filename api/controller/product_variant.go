@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PosProductVariantController interface {
+	HandleSetPosProductOptionsRequest(c *gin.Context)
+	HandleCreatePosProductVariantRequest(c *gin.Context)
+}
+
+type posProductVariantController struct {
+	service pb.PosProductVariantServiceClient
+}
+
+func NewPosProductVariantController(service pb.PosProductVariantServiceClient) PosProductVariantController {
+	return &posProductVariantController{
+		service: service,
+	}
+}
+
+func (ctrl *posProductVariantController) HandleSetPosProductOptionsRequest(c *gin.Context) {
+	var req pb.SetPosProductOptionsRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_SET_PRODUCT_OPTIONS, err.Error(), nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	req.ProductId = c.Param("id")
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_SET_PRODUCT_OPTIONS, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.SetPosProductOptions(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_SET_PRODUCT_OPTIONS, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_SET_PRODUCT_OPTIONS, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posProductVariantController) HandleCreatePosProductVariantRequest(c *gin.Context) {
+	var req pb.CreatePosProductVariantRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_PRODUCT_VARIANT, err.Error(), nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	req.ParentProductId = c.Param("id")
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_PRODUCT_VARIANT, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.CreatePosProductVariant(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_PRODUCT_VARIANT, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_CREATE_PRODUCT_VARIANT, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
@@ -0,0 +1,376 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PosPurchaseOrderController interface {
+	HandleCreatePosPurchaseOrderRequest(c *gin.Context)
+	HandleReadPosPurchaseOrderRequest(c *gin.Context)
+	HandleUpdatePosPurchaseOrderRequest(c *gin.Context)
+	HandleSubmitPosPurchaseOrderRequest(c *gin.Context)
+	HandleReceivePosPurchaseOrderRequest(c *gin.Context)
+	HandleCancelPosPurchaseOrderRequest(c *gin.Context)
+	HandleReadAllPosPurchaseOrdersRequest(c *gin.Context)
+	HandleGenerateReplenishmentPurchaseOrdersRequest(c *gin.Context)
+}
+
+type posPurchaseOrderController struct {
+	service pb.PosPurchaseOrderServiceClient
+}
+
+func NewPosPurchaseOrderController(service pb.PosPurchaseOrderServiceClient) PosPurchaseOrderController {
+	return &posPurchaseOrderController{
+		service: service,
+	}
+}
+
+func (ctrl *posPurchaseOrderController) HandleCreatePosPurchaseOrderRequest(c *gin.Context) {
+	var req pb.CreatePosPurchaseOrderRequest
+
+	if err := c.ShouldBindJSON(&req.PosPurchaseOrder); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_PURCHASE_ORDER, err.Error(), nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_PURCHASE_ORDER, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.CreatePosPurchaseOrder(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CREATE_PURCHASE_ORDER, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_CREATE_PURCHASE_ORDER, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posPurchaseOrderController) HandleReadPosPurchaseOrderRequest(c *gin.Context) {
+	var req pb.ReadPosPurchaseOrderRequest
+
+	purchaseOrderID := c.Param("id")
+	req.PurchaseOrderId = purchaseOrderID
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_PURCHASE_ORDER, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.ReadPosPurchaseOrder(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_PURCHASE_ORDER, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_GET_PURCHASE_ORDER, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posPurchaseOrderController) HandleUpdatePosPurchaseOrderRequest(c *gin.Context) {
+	var req pb.UpdatePosPurchaseOrderRequest
+	purchaseOrderID := c.Param("id")
+
+	if err := c.ShouldBindJSON(&req.PosPurchaseOrder); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_PURCHASE_ORDER, err.Error(), nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	req.PosPurchaseOrder.PurchaseOrderId = purchaseOrderID
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_PURCHASE_ORDER, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.UpdatePosPurchaseOrder(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_PURCHASE_ORDER, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_UPDATE_PURCHASE_ORDER, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posPurchaseOrderController) HandleSubmitPosPurchaseOrderRequest(c *gin.Context) {
+	var req pb.SubmitPosPurchaseOrderRequest
+
+	purchaseOrderID := c.Param("id")
+	req.PurchaseOrderId = purchaseOrderID
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_SUBMIT_PURCHASE_ORDER, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.SubmitPosPurchaseOrder(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_SUBMIT_PURCHASE_ORDER, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_SUBMIT_PURCHASE_ORDER, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posPurchaseOrderController) HandleReceivePosPurchaseOrderRequest(c *gin.Context) {
+	var req pb.ReceivePosPurchaseOrderRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_RECEIVE_PURCHASE_ORDER, err.Error(), nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	purchaseOrderID := c.Param("id")
+	req.PurchaseOrderId = purchaseOrderID
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_RECEIVE_PURCHASE_ORDER, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.ReceivePosPurchaseOrder(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_RECEIVE_PURCHASE_ORDER, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_RECEIVE_PURCHASE_ORDER, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posPurchaseOrderController) HandleCancelPosPurchaseOrderRequest(c *gin.Context) {
+	var req pb.CancelPosPurchaseOrderRequest
+
+	purchaseOrderID := c.Param("id")
+	req.PurchaseOrderId = purchaseOrderID
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CANCEL_PURCHASE_ORDER, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.CancelPosPurchaseOrder(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_CANCEL_PURCHASE_ORDER, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_CANCEL_PURCHASE_ORDER, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posPurchaseOrderController) HandleReadAllPosPurchaseOrdersRequest(c *gin.Context) {
+	limitQuery := c.Query("limit")
+	pageQuery := c.Query("page")
+
+	if (limitQuery == "" && pageQuery != "") || (limitQuery != "" && pageQuery == "") {
+		errorResponse := utils.BuildResponseFailed("Both limit and page must be provided", "Value Is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	var req pb.ReadAllPosPurchaseOrdersRequest
+
+	if limitQuery != "" && pageQuery != "" {
+		limit, err := strconv.Atoi(limitQuery)
+		if err != nil {
+			errorResponse := utils.BuildResponseFailed("Invalid limit value", err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+
+		page, err := strconv.Atoi(pageQuery)
+		if err != nil {
+			errorResponse := utils.BuildResponseFailed("Invalid page value", err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+
+		req = pb.ReadAllPosPurchaseOrdersRequest{
+			Limit: int32(limit),
+			Page:  int32(page),
+		}
+	}
+	req.Status = c.Query("status")
+	req.SupplierId = c.Query("supplier_id")
+
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_PURCHASE_ORDER, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	res, err := ctrl.service.ReadAllPosPurchaseOrders(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_PURCHASE_ORDER, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (ctrl *posPurchaseOrderController) HandleGenerateReplenishmentPurchaseOrdersRequest(c *gin.Context) {
+	var req pb.GenerateReplenishmentPurchaseOrdersRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GENERATE_REPLENISHMENT_PURCHASE_ORDERS, err.Error(), nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GENERATE_REPLENISHMENT_PURCHASE_ORDERS, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.GenerateReplenishmentPurchaseOrders(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GENERATE_REPLENISHMENT_PURCHASE_ORDERS, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_GENERATE_REPLENISHMENT_PURCHASE_ORDERS, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
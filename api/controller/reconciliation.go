@@ -0,0 +1,86 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PosInventoryReconciliationController interface {
+	HandleGetPosInventoryDiscrepanciesRequest(c *gin.Context)
+	HandleReconcilePosInventoryRequest(c *gin.Context)
+}
+
+type posInventoryReconciliationController struct {
+	service pb.PosInventoryReconciliationServiceClient
+}
+
+func NewPosInventoryReconciliationController(service pb.PosInventoryReconciliationServiceClient) PosInventoryReconciliationController {
+	return &posInventoryReconciliationController{
+		service: service,
+	}
+}
+
+func (ctrl *posInventoryReconciliationController) HandleGetPosInventoryDiscrepanciesRequest(c *gin.Context) {
+	var req pb.ReconcilePosInventoryRequest
+
+	req.ProductId = c.Query("product_id")
+	req.BranchId = c.Query("branch_id")
+	req.StoreId = c.Query("store_id")
+
+	ctrl.reconcile(c, &req, dto.MESSAGE_FAILED_GET_INVENTORY_DISCREPANCIES, dto.MESSAGE_SUCCESS_GET_INVENTORY_DISCREPANCIES)
+}
+
+func (ctrl *posInventoryReconciliationController) HandleReconcilePosInventoryRequest(c *gin.Context) {
+	var req pb.ReconcilePosInventoryRequest
+
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_RECONCILE_INVENTORY, err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+	}
+	req.Apply = true
+
+	ctrl.reconcile(c, &req, dto.MESSAGE_FAILED_RECONCILE_INVENTORY, dto.MESSAGE_SUCCESS_RECONCILE_INVENTORY)
+}
+
+// reconcile adds the JWT of the request and calls the reconciliation, reporting with the given messages
+func (ctrl *posInventoryReconciliationController) reconcile(c *gin.Context, req *pb.ReconcilePosInventoryRequest, failedMessage string, successMessage string) {
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(failedMessage, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	res, err := ctrl.service.ReconcilePosInventory(c.Request.Context(), req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(failedMessage, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(successMessage, res)
+	c.JSON(http.StatusOK, successResponse)
+}
@@ -0,0 +1,107 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PosSaleStockController interface {
+	HandleDeductStockForSaleRequest(c *gin.Context)
+	HandleReturnStockForSaleRequest(c *gin.Context)
+}
+
+type posSaleStockController struct {
+	service pb.PosSaleStockServiceClient
+}
+
+func NewPosSaleStockController(service pb.PosSaleStockServiceClient) PosSaleStockController {
+	return &posSaleStockController{
+		service: service,
+	}
+}
+
+func (ctrl *posSaleStockController) HandleDeductStockForSaleRequest(c *gin.Context) {
+	var req pb.DeductStockForSaleRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DEDUCT_STOCK_FOR_SALE, err.Error(), nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DEDUCT_STOCK_FOR_SALE, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.DeductStockForSale(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_DEDUCT_STOCK_FOR_SALE, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_DEDUCT_STOCK_FOR_SALE, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posSaleStockController) HandleReturnStockForSaleRequest(c *gin.Context) {
+	var req pb.ReturnStockForSaleRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_RETURN_STOCK_FOR_SALE, err.Error(), nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_RETURN_STOCK_FOR_SALE, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.ReturnStockForSale(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_RETURN_STOCK_FOR_SALE, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_RETURN_STOCK_FOR_SALE, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
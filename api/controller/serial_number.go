@@ -0,0 +1,63 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PosSerialNumberController interface {
+	HandleReadPosSerialNumberHistoryRequest(c *gin.Context)
+}
+
+type posSerialNumberController struct {
+	service pb.PosSerialNumberServiceClient
+}
+
+func NewPosSerialNumberController(service pb.PosSerialNumberServiceClient) PosSerialNumberController {
+	return &posSerialNumberController{
+		service: service,
+	}
+}
+
+func (ctrl *posSerialNumberController) HandleReadPosSerialNumberHistoryRequest(c *gin.Context) {
+	var req pb.ReadPosSerialNumberHistoryRequest
+
+	req.SerialNumber = c.Param("serial_number")
+	req.ProductId = c.Query("product_id")
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_SERIAL_NUMBER_HISTORY, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.ReadPosSerialNumberHistory(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_SERIAL_NUMBER_HISTORY, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
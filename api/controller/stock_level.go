@@ -0,0 +1,172 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PosStockLevelController interface {
+	HandleReadPosStockLevelRequest(c *gin.Context)
+	HandleUpdatePosStockLevelRequest(c *gin.Context)
+	HandleReadAllPosStockLevelsRequest(c *gin.Context)
+}
+
+type posStockLevelController struct {
+	service pb.PosStockLevelServiceClient
+}
+
+func NewPosStockLevelController(service pb.PosStockLevelServiceClient) PosStockLevelController {
+	return &posStockLevelController{
+		service: service,
+	}
+}
+
+func (ctrl *posStockLevelController) HandleReadPosStockLevelRequest(c *gin.Context) {
+	var req pb.ReadPosStockLevelRequest
+
+	req.ProductId = c.Param("product_id")
+	req.StoreId = c.Param("store_id")
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_STOCK_LEVEL, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.ReadPosStockLevel(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_STOCK_LEVEL, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_GET_STOCK_LEVEL, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posStockLevelController) HandleUpdatePosStockLevelRequest(c *gin.Context) {
+	var req pb.UpdatePosStockLevelRequest
+
+	if err := c.ShouldBindJSON(&req.PosStockLevel); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_STOCK_LEVEL, err.Error(), nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_STOCK_LEVEL, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+	req.PosStockLevel.ProductId = c.Param("product_id")
+	req.PosStockLevel.StoreId = c.Param("store_id")
+
+	resp, err := ctrl.service.UpdatePosStockLevel(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_UPDATE_STOCK_LEVEL, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_UPDATE_STOCK_LEVEL, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posStockLevelController) HandleReadAllPosStockLevelsRequest(c *gin.Context) {
+	limitQuery := c.Query("limit")
+	pageQuery := c.Query("page")
+
+	if (limitQuery == "" && pageQuery != "") || (limitQuery != "" && pageQuery == "") {
+		errorResponse := utils.BuildResponseFailed("Both limit and page must be provided", "Value Is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	var req pb.ReadAllPosStockLevelsRequest
+
+	if limitQuery != "" && pageQuery != "" {
+		limit, err := strconv.Atoi(limitQuery)
+		if err != nil {
+			errorResponse := utils.BuildResponseFailed("Invalid limit value", err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+
+		page, err := strconv.Atoi(pageQuery)
+		if err != nil {
+			errorResponse := utils.BuildResponseFailed("Invalid page value", err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+
+		req = pb.ReadAllPosStockLevelsRequest{
+			Limit: int32(limit),
+			Page:  int32(page),
+		}
+	}
+	req.ProductId = c.Query("product_id")
+
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_STOCK_LEVEL, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	res, err := ctrl.service.ReadAllPosStockLevels(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_STOCK_LEVEL, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,151 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PosStockReservationController interface {
+	HandleReservePosStockRequest(c *gin.Context)
+	HandleCommitPosStockReservationRequest(c *gin.Context)
+	HandleReleasePosStockReservationRequest(c *gin.Context)
+}
+
+type posStockReservationController struct {
+	service pb.PosStockReservationServiceClient
+}
+
+func NewPosStockReservationController(service pb.PosStockReservationServiceClient) PosStockReservationController {
+	return &posStockReservationController{
+		service: service,
+	}
+}
+
+func (ctrl *posStockReservationController) HandleReservePosStockRequest(c *gin.Context) {
+	var req pb.ReservePosStockRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_RESERVE_STOCK, err.Error(), nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_RESERVE_STOCK, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.ReservePosStock(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_RESERVE_STOCK, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_RESERVE_STOCK, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posStockReservationController) HandleCommitPosStockReservationRequest(c *gin.Context) {
+	var req pb.CommitPosStockReservationRequest
+
+	// The note of the sale and the serial numbers sold are optional
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_COMMIT_STOCK_RESERVATION, err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+	}
+
+	reservationID := c.Param("id")
+	req.ReservationId = reservationID
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_COMMIT_STOCK_RESERVATION, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.CommitPosStockReservation(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_COMMIT_STOCK_RESERVATION, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_COMMIT_STOCK_RESERVATION, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+func (ctrl *posStockReservationController) HandleReleasePosStockReservationRequest(c *gin.Context) {
+	var req pb.ReleasePosStockReservationRequest
+
+	reservationID := c.Param("id")
+	req.ReservationId = reservationID
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_RELEASE_STOCK_RESERVATION, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.ReleasePosStockReservation(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_RELEASE_STOCK_RESERVATION, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_RELEASE_STOCK_RESERVATION, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
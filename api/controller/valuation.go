@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/csv"
+	"net/http"
+	"strconv"
+	"strings"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosInventoryValuationController interface {
+	HandleGetPosInventoryValuationRequest(c *gin.Context)
+}
+
+type posInventoryValuationController struct {
+	service pb.PosInventoryValuationServiceClient
+}
+
+func NewPosInventoryValuationController(service pb.PosInventoryValuationServiceClient) PosInventoryValuationController {
+	return &posInventoryValuationController{
+		service: service,
+	}
+}
+
+func (ctrl *posInventoryValuationController) HandleGetPosInventoryValuationRequest(c *gin.Context) {
+	var req pb.GetPosInventoryValuationRequest
+
+	if asOfQuery := c.Query("as_of"); asOfQuery != "" {
+		asOf, err := utils.ParseAsOfTime(asOfQuery)
+		if err != nil {
+			errorResponse := utils.BuildResponseFailed("Invalid as_of value", err.Error(), nil)
+			c.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+		req.AsOf = timestamppb.New(asOf)
+	}
+	req.GroupBy = c.Query("group_by")
+	req.BranchId = c.Query("branch_id")
+	req.StoreId = c.Query("store_id")
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "csv" {
+		errorResponse := utils.BuildResponseFailed("Invalid format value", "format must be json or csv", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Get JWT Payload data from middleware
+	getJwtPayload, exist := c.Get("user")
+	if !exist {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_INVENTORY_VALUATION, "Jwt Payload is Empty", nil)
+		c.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	// Add JWT payload from middleware into req body
+	req.JwtPayload = getJwtPayload.(*pb.JWTPayload)
+	authHeader := c.GetHeader("Authorization")
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization token format"})
+		c.Abort()
+		return
+	}
+
+	token := bearerToken[1]
+	req.JwtToken = token
+
+	resp, err := ctrl.service.GetPosInventoryValuation(c.Request.Context(), &req)
+	if err != nil {
+		errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_INVENTORY_VALUATION, err.Error(), nil)
+		c.JSON(http.StatusInternalServerError, errorResponse)
+		return
+	}
+
+	if format == "csv" {
+		csvData, err := buildInventoryValuationCSV(resp)
+		if err != nil {
+			errorResponse := utils.BuildResponseFailed(dto.MESSAGE_FAILED_GET_INVENTORY_VALUATION, err.Error(), nil)
+			c.JSON(http.StatusInternalServerError, errorResponse)
+			return
+		}
+
+		fileName := "inventory_valuation_" + resp.AsOf.AsTime().Format("2006-01-02") + ".csv"
+		c.Header("Content-Disposition", "attachment; filename="+fileName)
+		c.Data(http.StatusOK, "text/csv", csvData)
+		return
+	}
+
+	successResponse := utils.BuildResponseSuccess(dto.MESSAGE_SUCCESS_GET_INVENTORY_VALUATION, resp)
+	c.JSON(http.StatusOK, successResponse)
+}
+
+// buildInventoryValuationCSV writes one row per valuation line followed by a total row
+func buildInventoryValuationCSV(resp *pb.GetPosInventoryValuationResponse) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	formatMoney := func(value float64) string {
+		return strconv.FormatFloat(value, 'f', 2, 64)
+	}
+
+	records := [][]string{{
+		"company_id", "branch_id", "store_id", "category_id", "category_name", "sub_category_id", "sub_category_name",
+		"quantity", "cost_value", "retail_value", "margin_value", "margin_percent",
+	}}
+	for _, line := range resp.Lines {
+		records = append(records, []string{
+			line.CompanyId, line.BranchId, line.StoreId, line.CategoryId, line.CategoryName, line.SubCategoryId, line.SubCategoryName,
+			strconv.FormatInt(line.Quantity, 10), formatMoney(line.CostValue), formatMoney(line.RetailValue),
+			formatMoney(line.MarginValue), formatMoney(line.MarginPercent),
+		})
+	}
+	records = append(records, []string{
+		"total", "", "", "", "", "", "",
+		strconv.FormatInt(resp.TotalQuantity, 10), formatMoney(resp.TotalCostValue), formatMoney(resp.TotalRetailValue),
+		formatMoney(resp.TotalMarginValue), formatMoney(resp.TotalMarginPercent),
+	})
+
+	if err := writer.WriteAll(records); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
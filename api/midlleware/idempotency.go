@@ -0,0 +1,34 @@
+package midlleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/metadata"
+)
+
+// IdempotencyKeyMiddleware passes the Idempotency-Key header of a request to the gRPC calls made with
+// the request context, so a retried Create call returns the response of the first one
+func IdempotencyKeyMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idempotencyKey := strings.TrimSpace(c.GetHeader(utils.IdempotencyKeyHeader))
+		if idempotencyKey == "" {
+			c.Next()
+			return
+		}
+
+		if len(idempotencyKey) > utils.MaxIdempotencyKeyLength {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Idempotency-Key header is too long"})
+			c.Abort()
+			return
+		}
+
+		ctx := metadata.AppendToOutgoingContext(c.Request.Context(), utils.IdempotencyKeyMetadata, idempotencyKey)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
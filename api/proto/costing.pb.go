@@ -0,0 +1,529 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v5.26.1
+// source: costing.proto
+
+package alpha_pos_system_product_service
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PosCostingSetting
+type PosCostingSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	CostingMethod string                 `protobuf:"bytes,2,opt,name=costing_method,json=costingMethod,proto3" json:"costing_method,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+}
+
+func (x *PosCostingSetting) Reset() {
+	*x = PosCostingSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_costing_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PosCostingSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PosCostingSetting) ProtoMessage() {}
+
+func (x *PosCostingSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PosCostingSetting.ProtoReflect.Descriptor instead.
+func (*PosCostingSetting) Descriptor() ([]byte, []int) {
+	return file_costing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PosCostingSetting) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *PosCostingSetting) GetCostingMethod() string {
+	if x != nil {
+		return x.CostingMethod
+	}
+	return ""
+}
+
+func (x *PosCostingSetting) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PosCostingSetting) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *PosCostingSetting) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PosCostingSetting) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+// Request and Response messages
+type ReadPosCostingSettingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JwtPayload *JWTPayload `protobuf:"bytes,1,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken   string      `protobuf:"bytes,2,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+}
+
+func (x *ReadPosCostingSettingRequest) Reset() {
+	*x = ReadPosCostingSettingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_costing_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadPosCostingSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadPosCostingSettingRequest) ProtoMessage() {}
+
+func (x *ReadPosCostingSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadPosCostingSettingRequest.ProtoReflect.Descriptor instead.
+func (*ReadPosCostingSettingRequest) Descriptor() ([]byte, []int) {
+	return file_costing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReadPosCostingSettingRequest) GetJwtPayload() *JWTPayload {
+	if x != nil {
+		return x.JwtPayload
+	}
+	return nil
+}
+
+func (x *ReadPosCostingSettingRequest) GetJwtToken() string {
+	if x != nil {
+		return x.JwtToken
+	}
+	return ""
+}
+
+type ReadPosCostingSettingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PosCostingSetting *PosCostingSetting `protobuf:"bytes,1,opt,name=pos_costing_setting,json=posCostingSetting,proto3" json:"pos_costing_setting,omitempty"`
+}
+
+func (x *ReadPosCostingSettingResponse) Reset() {
+	*x = ReadPosCostingSettingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_costing_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadPosCostingSettingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadPosCostingSettingResponse) ProtoMessage() {}
+
+func (x *ReadPosCostingSettingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadPosCostingSettingResponse.ProtoReflect.Descriptor instead.
+func (*ReadPosCostingSettingResponse) Descriptor() ([]byte, []int) {
+	return file_costing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReadPosCostingSettingResponse) GetPosCostingSetting() *PosCostingSetting {
+	if x != nil {
+		return x.PosCostingSetting
+	}
+	return nil
+}
+
+type UpdatePosCostingSettingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PosCostingSetting *PosCostingSetting `protobuf:"bytes,1,opt,name=pos_costing_setting,json=posCostingSetting,proto3" json:"pos_costing_setting,omitempty"`
+	JwtPayload        *JWTPayload        `protobuf:"bytes,2,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken          string             `protobuf:"bytes,3,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+}
+
+func (x *UpdatePosCostingSettingRequest) Reset() {
+	*x = UpdatePosCostingSettingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_costing_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePosCostingSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePosCostingSettingRequest) ProtoMessage() {}
+
+func (x *UpdatePosCostingSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePosCostingSettingRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePosCostingSettingRequest) Descriptor() ([]byte, []int) {
+	return file_costing_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdatePosCostingSettingRequest) GetPosCostingSetting() *PosCostingSetting {
+	if x != nil {
+		return x.PosCostingSetting
+	}
+	return nil
+}
+
+func (x *UpdatePosCostingSettingRequest) GetJwtPayload() *JWTPayload {
+	if x != nil {
+		return x.JwtPayload
+	}
+	return nil
+}
+
+func (x *UpdatePosCostingSettingRequest) GetJwtToken() string {
+	if x != nil {
+		return x.JwtToken
+	}
+	return ""
+}
+
+type UpdatePosCostingSettingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PosCostingSetting *PosCostingSetting `protobuf:"bytes,1,opt,name=pos_costing_setting,json=posCostingSetting,proto3" json:"pos_costing_setting,omitempty"`
+}
+
+func (x *UpdatePosCostingSettingResponse) Reset() {
+	*x = UpdatePosCostingSettingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_costing_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePosCostingSettingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePosCostingSettingResponse) ProtoMessage() {}
+
+func (x *UpdatePosCostingSettingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePosCostingSettingResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePosCostingSettingResponse) Descriptor() ([]byte, []int) {
+	return file_costing_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdatePosCostingSettingResponse) GetPosCostingSetting() *PosCostingSetting {
+	if x != nil {
+		return x.PosCostingSetting
+	}
+	return nil
+}
+
+var File_costing_proto protoreflect.FileDescriptor
+
+var file_costing_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x63, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x03, 0x70, 0x6f, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x8d, 0x02, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x22, 0x6d, 0x0a, 0x1c, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x43, 0x6f,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a,
+	0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x67, 0x0a, 0x1d, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x43, 0x6f, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x13, 0x70, 0x6f, 0x73, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x11, 0x70, 0x6f, 0x73, 0x43, 0x6f, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x22, 0xb7, 0x01, 0x0a, 0x1e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46,
+	0x0a, 0x13, 0x70, 0x6f, 0x73, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6f,
+	0x73, 0x2e, 0x50, 0x6f, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x11, 0x70, 0x6f, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f,
+	0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77,
+	0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x69, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x13, 0x70, 0x6f, 0x73, 0x5f,
+	0x63, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x43,
+	0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x11, 0x70,
+	0x6f, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x32, 0xe0, 0x01, 0x0a, 0x18, 0x50, 0x6f, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5e, 0x0a,
+	0x15, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x50, 0x6f, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x6f, 0x73, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a,
+	0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x70, 0x6f, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x43, 0x6f, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x41, 0x6e, 0x64, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x69, 0x66, 0x62, 0x2f, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x2d, 0x70, 0x6f, 0x73, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2d, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_costing_proto_rawDescOnce sync.Once
+	file_costing_proto_rawDescData = file_costing_proto_rawDesc
+)
+
+func file_costing_proto_rawDescGZIP() []byte {
+	file_costing_proto_rawDescOnce.Do(func() {
+		file_costing_proto_rawDescData = protoimpl.X.CompressGZIP(file_costing_proto_rawDescData)
+	})
+	return file_costing_proto_rawDescData
+}
+
+var file_costing_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_costing_proto_goTypes = []interface{}{
+	(*PosCostingSetting)(nil),               // 0: pos.PosCostingSetting
+	(*ReadPosCostingSettingRequest)(nil),    // 1: pos.ReadPosCostingSettingRequest
+	(*ReadPosCostingSettingResponse)(nil),   // 2: pos.ReadPosCostingSettingResponse
+	(*UpdatePosCostingSettingRequest)(nil),  // 3: pos.UpdatePosCostingSettingRequest
+	(*UpdatePosCostingSettingResponse)(nil), // 4: pos.UpdatePosCostingSettingResponse
+	(*timestamppb.Timestamp)(nil),           // 5: google.protobuf.Timestamp
+	(*JWTPayload)(nil),                      // 6: pos.JWTPayload
+}
+var file_costing_proto_depIdxs = []int32{
+	5, // 0: pos.PosCostingSetting.created_at:type_name -> google.protobuf.Timestamp
+	5, // 1: pos.PosCostingSetting.updated_at:type_name -> google.protobuf.Timestamp
+	6, // 2: pos.ReadPosCostingSettingRequest.jwt_payload:type_name -> pos.JWTPayload
+	0, // 3: pos.ReadPosCostingSettingResponse.pos_costing_setting:type_name -> pos.PosCostingSetting
+	0, // 4: pos.UpdatePosCostingSettingRequest.pos_costing_setting:type_name -> pos.PosCostingSetting
+	6, // 5: pos.UpdatePosCostingSettingRequest.jwt_payload:type_name -> pos.JWTPayload
+	0, // 6: pos.UpdatePosCostingSettingResponse.pos_costing_setting:type_name -> pos.PosCostingSetting
+	1, // 7: pos.PosCostingSettingService.ReadPosCostingSetting:input_type -> pos.ReadPosCostingSettingRequest
+	3, // 8: pos.PosCostingSettingService.UpdatePosCostingSetting:input_type -> pos.UpdatePosCostingSettingRequest
+	2, // 9: pos.PosCostingSettingService.ReadPosCostingSetting:output_type -> pos.ReadPosCostingSettingResponse
+	4, // 10: pos.PosCostingSettingService.UpdatePosCostingSetting:output_type -> pos.UpdatePosCostingSettingResponse
+	9, // [9:11] is the sub-list for method output_type
+	7, // [7:9] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_costing_proto_init() }
+func file_costing_proto_init() {
+	if File_costing_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_costing_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PosCostingSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_costing_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadPosCostingSettingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_costing_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadPosCostingSettingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_costing_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePosCostingSettingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_costing_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePosCostingSettingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_costing_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_costing_proto_goTypes,
+		DependencyIndexes: file_costing_proto_depIdxs,
+		MessageInfos:      file_costing_proto_msgTypes,
+	}.Build()
+	File_costing_proto = out.File
+	file_costing_proto_rawDesc = nil
+	file_costing_proto_goTypes = nil
+	file_costing_proto_depIdxs = nil
+}
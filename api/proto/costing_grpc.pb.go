@@ -0,0 +1,142 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: costing.proto
+
+package alpha_pos_system_product_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosCostingSettingServiceClient is the client API for PosCostingSettingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosCostingSettingServiceClient interface {
+	ReadPosCostingSetting(ctx context.Context, in *ReadPosCostingSettingRequest, opts ...grpc.CallOption) (*ReadPosCostingSettingResponse, error)
+	UpdatePosCostingSetting(ctx context.Context, in *UpdatePosCostingSettingRequest, opts ...grpc.CallOption) (*UpdatePosCostingSettingResponse, error)
+}
+
+type posCostingSettingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosCostingSettingServiceClient(cc grpc.ClientConnInterface) PosCostingSettingServiceClient {
+	return &posCostingSettingServiceClient{cc}
+}
+
+func (c *posCostingSettingServiceClient) ReadPosCostingSetting(ctx context.Context, in *ReadPosCostingSettingRequest, opts ...grpc.CallOption) (*ReadPosCostingSettingResponse, error) {
+	out := new(ReadPosCostingSettingResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosCostingSettingService/ReadPosCostingSetting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posCostingSettingServiceClient) UpdatePosCostingSetting(ctx context.Context, in *UpdatePosCostingSettingRequest, opts ...grpc.CallOption) (*UpdatePosCostingSettingResponse, error) {
+	out := new(UpdatePosCostingSettingResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosCostingSettingService/UpdatePosCostingSetting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosCostingSettingServiceServer is the server API for PosCostingSettingService service.
+// All implementations must embed UnimplementedPosCostingSettingServiceServer
+// for forward compatibility
+type PosCostingSettingServiceServer interface {
+	ReadPosCostingSetting(context.Context, *ReadPosCostingSettingRequest) (*ReadPosCostingSettingResponse, error)
+	UpdatePosCostingSetting(context.Context, *UpdatePosCostingSettingRequest) (*UpdatePosCostingSettingResponse, error)
+	mustEmbedUnimplementedPosCostingSettingServiceServer()
+}
+
+// UnimplementedPosCostingSettingServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosCostingSettingServiceServer struct {
+}
+
+func (UnimplementedPosCostingSettingServiceServer) ReadPosCostingSetting(context.Context, *ReadPosCostingSettingRequest) (*ReadPosCostingSettingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadPosCostingSetting not implemented")
+}
+func (UnimplementedPosCostingSettingServiceServer) UpdatePosCostingSetting(context.Context, *UpdatePosCostingSettingRequest) (*UpdatePosCostingSettingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePosCostingSetting not implemented")
+}
+func (UnimplementedPosCostingSettingServiceServer) mustEmbedUnimplementedPosCostingSettingServiceServer() {
+}
+
+// UnsafePosCostingSettingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosCostingSettingServiceServer will
+// result in compilation errors.
+type UnsafePosCostingSettingServiceServer interface {
+	mustEmbedUnimplementedPosCostingSettingServiceServer()
+}
+
+func RegisterPosCostingSettingServiceServer(s grpc.ServiceRegistrar, srv PosCostingSettingServiceServer) {
+	s.RegisterService(&PosCostingSettingService_ServiceDesc, srv)
+}
+
+func _PosCostingSettingService_ReadPosCostingSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPosCostingSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosCostingSettingServiceServer).ReadPosCostingSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosCostingSettingService/ReadPosCostingSetting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosCostingSettingServiceServer).ReadPosCostingSetting(ctx, req.(*ReadPosCostingSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosCostingSettingService_UpdatePosCostingSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePosCostingSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosCostingSettingServiceServer).UpdatePosCostingSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosCostingSettingService/UpdatePosCostingSetting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosCostingSettingServiceServer).UpdatePosCostingSetting(ctx, req.(*UpdatePosCostingSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosCostingSettingService_ServiceDesc is the grpc.ServiceDesc for PosCostingSettingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosCostingSettingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosCostingSettingService",
+	HandlerType: (*PosCostingSettingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReadPosCostingSetting",
+			Handler:    _PosCostingSettingService_ReadPosCostingSetting_Handler,
+		},
+		{
+			MethodName: "UpdatePosCostingSetting",
+			Handler:    _PosCostingSettingService_UpdatePosCostingSetting_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "costing.proto",
+}
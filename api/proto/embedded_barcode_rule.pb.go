@@ -0,0 +1,1050 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v5.26.1
+// source: embedded_barcode_rule.proto
+
+package alpha_pos_system_product_service
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PosEmbeddedBarcodeRule decodes the EAN-13 scale labels of a company that start with prefix, e.g.
+// 21 with 5 plu_digits and 5 value_digits for 21 PPPPP VVVVV C. value_type is price or weight, a
+// weight is in unit (kg when not set). The product of a PLU is found by the label of that PLU with
+// all digits after the PLU set to zero, which must be one of its barcodes.
+type PosEmbeddedBarcodeRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleId      string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Prefix      string                 `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	PluDigits   int32                  `protobuf:"varint,3,opt,name=plu_digits,json=pluDigits,proto3" json:"plu_digits,omitempty"`
+	ValueDigits int32                  `protobuf:"varint,4,opt,name=value_digits,json=valueDigits,proto3" json:"value_digits,omitempty"`
+	ValueType   string                 `protobuf:"bytes,5,opt,name=value_type,json=valueType,proto3" json:"value_type,omitempty"`
+	Decimals    int32                  `protobuf:"varint,6,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Unit        string                 `protobuf:"bytes,7,opt,name=unit,proto3" json:"unit,omitempty"`
+	CompanyId   string                 `protobuf:"bytes,8,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy   string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy   string                 `protobuf:"bytes,12,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+}
+
+func (x *PosEmbeddedBarcodeRule) Reset() {
+	*x = PosEmbeddedBarcodeRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_embedded_barcode_rule_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PosEmbeddedBarcodeRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PosEmbeddedBarcodeRule) ProtoMessage() {}
+
+func (x *PosEmbeddedBarcodeRule) ProtoReflect() protoreflect.Message {
+	mi := &file_embedded_barcode_rule_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PosEmbeddedBarcodeRule.ProtoReflect.Descriptor instead.
+func (*PosEmbeddedBarcodeRule) Descriptor() ([]byte, []int) {
+	return file_embedded_barcode_rule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PosEmbeddedBarcodeRule) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *PosEmbeddedBarcodeRule) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *PosEmbeddedBarcodeRule) GetPluDigits() int32 {
+	if x != nil {
+		return x.PluDigits
+	}
+	return 0
+}
+
+func (x *PosEmbeddedBarcodeRule) GetValueDigits() int32 {
+	if x != nil {
+		return x.ValueDigits
+	}
+	return 0
+}
+
+func (x *PosEmbeddedBarcodeRule) GetValueType() string {
+	if x != nil {
+		return x.ValueType
+	}
+	return ""
+}
+
+func (x *PosEmbeddedBarcodeRule) GetDecimals() int32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *PosEmbeddedBarcodeRule) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *PosEmbeddedBarcodeRule) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *PosEmbeddedBarcodeRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PosEmbeddedBarcodeRule) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *PosEmbeddedBarcodeRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PosEmbeddedBarcodeRule) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+// PosEmbeddedBarcode is what a scale label carries. price is set for price labels, weight and
+// unit for weight labels. quantity is the number of base units of the product the label stands
+// for, 1 for price labels.
+type PosEmbeddedBarcode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleId    string  `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Plu       string  `protobuf:"bytes,2,opt,name=plu,proto3" json:"plu,omitempty"`
+	ValueType string  `protobuf:"bytes,3,opt,name=value_type,json=valueType,proto3" json:"value_type,omitempty"`
+	Price     float64 `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Weight    float64 `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	Unit      string  `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`
+	Quantity  int32   `protobuf:"varint,7,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (x *PosEmbeddedBarcode) Reset() {
+	*x = PosEmbeddedBarcode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_embedded_barcode_rule_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PosEmbeddedBarcode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PosEmbeddedBarcode) ProtoMessage() {}
+
+func (x *PosEmbeddedBarcode) ProtoReflect() protoreflect.Message {
+	mi := &file_embedded_barcode_rule_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PosEmbeddedBarcode.ProtoReflect.Descriptor instead.
+func (*PosEmbeddedBarcode) Descriptor() ([]byte, []int) {
+	return file_embedded_barcode_rule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PosEmbeddedBarcode) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *PosEmbeddedBarcode) GetPlu() string {
+	if x != nil {
+		return x.Plu
+	}
+	return ""
+}
+
+func (x *PosEmbeddedBarcode) GetValueType() string {
+	if x != nil {
+		return x.ValueType
+	}
+	return ""
+}
+
+func (x *PosEmbeddedBarcode) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PosEmbeddedBarcode) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *PosEmbeddedBarcode) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *PosEmbeddedBarcode) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Request and Response messages
+type CreatePosEmbeddedBarcodeRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PosEmbeddedBarcodeRule *PosEmbeddedBarcodeRule `protobuf:"bytes,1,opt,name=pos_embedded_barcode_rule,json=posEmbeddedBarcodeRule,proto3" json:"pos_embedded_barcode_rule,omitempty"`
+	JwtPayload             *JWTPayload             `protobuf:"bytes,2,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken               string                  `protobuf:"bytes,3,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+}
+
+func (x *CreatePosEmbeddedBarcodeRuleRequest) Reset() {
+	*x = CreatePosEmbeddedBarcodeRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_embedded_barcode_rule_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePosEmbeddedBarcodeRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePosEmbeddedBarcodeRuleRequest) ProtoMessage() {}
+
+func (x *CreatePosEmbeddedBarcodeRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_embedded_barcode_rule_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePosEmbeddedBarcodeRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreatePosEmbeddedBarcodeRuleRequest) Descriptor() ([]byte, []int) {
+	return file_embedded_barcode_rule_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreatePosEmbeddedBarcodeRuleRequest) GetPosEmbeddedBarcodeRule() *PosEmbeddedBarcodeRule {
+	if x != nil {
+		return x.PosEmbeddedBarcodeRule
+	}
+	return nil
+}
+
+func (x *CreatePosEmbeddedBarcodeRuleRequest) GetJwtPayload() *JWTPayload {
+	if x != nil {
+		return x.JwtPayload
+	}
+	return nil
+}
+
+func (x *CreatePosEmbeddedBarcodeRuleRequest) GetJwtToken() string {
+	if x != nil {
+		return x.JwtToken
+	}
+	return ""
+}
+
+type CreatePosEmbeddedBarcodeRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PosEmbeddedBarcodeRule *PosEmbeddedBarcodeRule `protobuf:"bytes,1,opt,name=pos_embedded_barcode_rule,json=posEmbeddedBarcodeRule,proto3" json:"pos_embedded_barcode_rule,omitempty"`
+}
+
+func (x *CreatePosEmbeddedBarcodeRuleResponse) Reset() {
+	*x = CreatePosEmbeddedBarcodeRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_embedded_barcode_rule_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePosEmbeddedBarcodeRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePosEmbeddedBarcodeRuleResponse) ProtoMessage() {}
+
+func (x *CreatePosEmbeddedBarcodeRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_embedded_barcode_rule_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePosEmbeddedBarcodeRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreatePosEmbeddedBarcodeRuleResponse) Descriptor() ([]byte, []int) {
+	return file_embedded_barcode_rule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreatePosEmbeddedBarcodeRuleResponse) GetPosEmbeddedBarcodeRule() *PosEmbeddedBarcodeRule {
+	if x != nil {
+		return x.PosEmbeddedBarcodeRule
+	}
+	return nil
+}
+
+type UpdatePosEmbeddedBarcodeRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PosEmbeddedBarcodeRule *PosEmbeddedBarcodeRule `protobuf:"bytes,1,opt,name=pos_embedded_barcode_rule,json=posEmbeddedBarcodeRule,proto3" json:"pos_embedded_barcode_rule,omitempty"`
+	JwtPayload             *JWTPayload             `protobuf:"bytes,2,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken               string                  `protobuf:"bytes,3,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+}
+
+func (x *UpdatePosEmbeddedBarcodeRuleRequest) Reset() {
+	*x = UpdatePosEmbeddedBarcodeRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_embedded_barcode_rule_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePosEmbeddedBarcodeRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePosEmbeddedBarcodeRuleRequest) ProtoMessage() {}
+
+func (x *UpdatePosEmbeddedBarcodeRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_embedded_barcode_rule_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePosEmbeddedBarcodeRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePosEmbeddedBarcodeRuleRequest) Descriptor() ([]byte, []int) {
+	return file_embedded_barcode_rule_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdatePosEmbeddedBarcodeRuleRequest) GetPosEmbeddedBarcodeRule() *PosEmbeddedBarcodeRule {
+	if x != nil {
+		return x.PosEmbeddedBarcodeRule
+	}
+	return nil
+}
+
+func (x *UpdatePosEmbeddedBarcodeRuleRequest) GetJwtPayload() *JWTPayload {
+	if x != nil {
+		return x.JwtPayload
+	}
+	return nil
+}
+
+func (x *UpdatePosEmbeddedBarcodeRuleRequest) GetJwtToken() string {
+	if x != nil {
+		return x.JwtToken
+	}
+	return ""
+}
+
+type UpdatePosEmbeddedBarcodeRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PosEmbeddedBarcodeRule *PosEmbeddedBarcodeRule `protobuf:"bytes,1,opt,name=pos_embedded_barcode_rule,json=posEmbeddedBarcodeRule,proto3" json:"pos_embedded_barcode_rule,omitempty"`
+}
+
+func (x *UpdatePosEmbeddedBarcodeRuleResponse) Reset() {
+	*x = UpdatePosEmbeddedBarcodeRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_embedded_barcode_rule_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePosEmbeddedBarcodeRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePosEmbeddedBarcodeRuleResponse) ProtoMessage() {}
+
+func (x *UpdatePosEmbeddedBarcodeRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_embedded_barcode_rule_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePosEmbeddedBarcodeRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePosEmbeddedBarcodeRuleResponse) Descriptor() ([]byte, []int) {
+	return file_embedded_barcode_rule_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdatePosEmbeddedBarcodeRuleResponse) GetPosEmbeddedBarcodeRule() *PosEmbeddedBarcodeRule {
+	if x != nil {
+		return x.PosEmbeddedBarcodeRule
+	}
+	return nil
+}
+
+type DeletePosEmbeddedBarcodeRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleId     string      `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	JwtPayload *JWTPayload `protobuf:"bytes,2,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken   string      `protobuf:"bytes,3,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+}
+
+func (x *DeletePosEmbeddedBarcodeRuleRequest) Reset() {
+	*x = DeletePosEmbeddedBarcodeRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_embedded_barcode_rule_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePosEmbeddedBarcodeRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePosEmbeddedBarcodeRuleRequest) ProtoMessage() {}
+
+func (x *DeletePosEmbeddedBarcodeRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_embedded_barcode_rule_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePosEmbeddedBarcodeRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeletePosEmbeddedBarcodeRuleRequest) Descriptor() ([]byte, []int) {
+	return file_embedded_barcode_rule_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeletePosEmbeddedBarcodeRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *DeletePosEmbeddedBarcodeRuleRequest) GetJwtPayload() *JWTPayload {
+	if x != nil {
+		return x.JwtPayload
+	}
+	return nil
+}
+
+func (x *DeletePosEmbeddedBarcodeRuleRequest) GetJwtToken() string {
+	if x != nil {
+		return x.JwtToken
+	}
+	return ""
+}
+
+type DeletePosEmbeddedBarcodeRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *DeletePosEmbeddedBarcodeRuleResponse) Reset() {
+	*x = DeletePosEmbeddedBarcodeRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_embedded_barcode_rule_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePosEmbeddedBarcodeRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePosEmbeddedBarcodeRuleResponse) ProtoMessage() {}
+
+func (x *DeletePosEmbeddedBarcodeRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_embedded_barcode_rule_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePosEmbeddedBarcodeRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeletePosEmbeddedBarcodeRuleResponse) Descriptor() ([]byte, []int) {
+	return file_embedded_barcode_rule_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeletePosEmbeddedBarcodeRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ReadAllPosEmbeddedBarcodeRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JwtPayload *JWTPayload `protobuf:"bytes,1,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken   string      `protobuf:"bytes,2,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+}
+
+func (x *ReadAllPosEmbeddedBarcodeRulesRequest) Reset() {
+	*x = ReadAllPosEmbeddedBarcodeRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_embedded_barcode_rule_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadAllPosEmbeddedBarcodeRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAllPosEmbeddedBarcodeRulesRequest) ProtoMessage() {}
+
+func (x *ReadAllPosEmbeddedBarcodeRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_embedded_barcode_rule_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAllPosEmbeddedBarcodeRulesRequest.ProtoReflect.Descriptor instead.
+func (*ReadAllPosEmbeddedBarcodeRulesRequest) Descriptor() ([]byte, []int) {
+	return file_embedded_barcode_rule_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReadAllPosEmbeddedBarcodeRulesRequest) GetJwtPayload() *JWTPayload {
+	if x != nil {
+		return x.JwtPayload
+	}
+	return nil
+}
+
+func (x *ReadAllPosEmbeddedBarcodeRulesRequest) GetJwtToken() string {
+	if x != nil {
+		return x.JwtToken
+	}
+	return ""
+}
+
+type ReadAllPosEmbeddedBarcodeRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PosEmbeddedBarcodeRules []*PosEmbeddedBarcodeRule `protobuf:"bytes,1,rep,name=pos_embedded_barcode_rules,json=posEmbeddedBarcodeRules,proto3" json:"pos_embedded_barcode_rules,omitempty"`
+}
+
+func (x *ReadAllPosEmbeddedBarcodeRulesResponse) Reset() {
+	*x = ReadAllPosEmbeddedBarcodeRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_embedded_barcode_rule_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadAllPosEmbeddedBarcodeRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAllPosEmbeddedBarcodeRulesResponse) ProtoMessage() {}
+
+func (x *ReadAllPosEmbeddedBarcodeRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_embedded_barcode_rule_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAllPosEmbeddedBarcodeRulesResponse.ProtoReflect.Descriptor instead.
+func (*ReadAllPosEmbeddedBarcodeRulesResponse) Descriptor() ([]byte, []int) {
+	return file_embedded_barcode_rule_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReadAllPosEmbeddedBarcodeRulesResponse) GetPosEmbeddedBarcodeRules() []*PosEmbeddedBarcodeRule {
+	if x != nil {
+		return x.PosEmbeddedBarcodeRules
+	}
+	return nil
+}
+
+var File_embedded_barcode_rule_proto protoreflect.FileDescriptor
+
+var file_embedded_barcode_rule_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x72, 0x63, 0x6f,
+	0x64, 0x65, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x70,
+	0x6f, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xad, 0x03, 0x0a, 0x16, 0x50, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65,
+	0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x6c, 0x75, 0x5f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x70, 0x6c, 0x75, 0x44, 0x69, 0x67, 0x69, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x69, 0x67, 0x69, 0x74, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e,
+	0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x22, 0xbc, 0x01, 0x0a, 0x12, 0x50, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65,
+	0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6c, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x70, 0x6c, 0x75, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x6e, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x22, 0xcc, 0x01, 0x0a, 0x23, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x45, 0x6d,
+	0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x19, 0x70, 0x6f, 0x73, 0x5f,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f,
+	0x73, 0x2e, 0x50, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72,
+	0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x16, 0x70, 0x6f, 0x73, 0x45, 0x6d, 0x62,
+	0x65, 0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65,
+	0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x7e, 0x0a, 0x24, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x19, 0x70, 0x6f, 0x73, 0x5f, 0x65,
+	0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x5f,
+	0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x73,
+	0x2e, 0x50, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63,
+	0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x16, 0x70, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x22,
+	0xcc, 0x01, 0x0a, 0x23, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x45, 0x6d, 0x62,
+	0x65, 0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x19, 0x70, 0x6f, 0x73, 0x5f, 0x65,
+	0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x5f,
+	0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x73,
+	0x2e, 0x50, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63,
+	0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x16, 0x70, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7e,
+	0x0a, 0x24, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x19, 0x70, 0x6f, 0x73, 0x5f, 0x65, 0x6d,
+	0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x72,
+	0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x73, 0x2e,
+	0x50, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63, 0x6f,
+	0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x16, 0x70, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x22, 0x8d,
+	0x01, 0x0a, 0x23, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12,
+	0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x40,
+	0x0a, 0x24, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x22, 0x76, 0x0a, 0x25, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x45, 0x6d,
+	0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74,
+	0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52,
+	0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a,
+	0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x82, 0x01, 0x0a, 0x26, 0x52, 0x65, 0x61,
+	0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x42,
+	0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x1a, 0x70, 0x6f, 0x73, 0x5f, 0x65, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f,
+	0x73, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x17, 0x70, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65,
+	0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x32, 0xf9, 0x03,
+	0x0a, 0x1d, 0x50, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72,
+	0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x73, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x28, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x45,
+	0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6f, 0x73, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65,
+	0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x73, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65,
+	0x52, 0x75, 0x6c, 0x65, 0x12, 0x28, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63,
+	0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x45, 0x6d,
+	0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x1c, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x42, 0x61,
+	0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x28, 0x2e, 0x70, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65,
+	0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63, 0x6f,
+	0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79,
+	0x0a, 0x1e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x45, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x2a, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f,
+	0x73, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70,
+	0x6f, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x45, 0x6d, 0x62,
+	0x65, 0x64, 0x64, 0x65, 0x64, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x6e, 0x64, 0x72, 0x65, 0x77, 0x61, 0x6c,
+	0x69, 0x66, 0x62, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d, 0x70, 0x6f, 0x73, 0x2d, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x2d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2d, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_embedded_barcode_rule_proto_rawDescOnce sync.Once
+	file_embedded_barcode_rule_proto_rawDescData = file_embedded_barcode_rule_proto_rawDesc
+)
+
+func file_embedded_barcode_rule_proto_rawDescGZIP() []byte {
+	file_embedded_barcode_rule_proto_rawDescOnce.Do(func() {
+		file_embedded_barcode_rule_proto_rawDescData = protoimpl.X.CompressGZIP(file_embedded_barcode_rule_proto_rawDescData)
+	})
+	return file_embedded_barcode_rule_proto_rawDescData
+}
+
+var file_embedded_barcode_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_embedded_barcode_rule_proto_goTypes = []interface{}{
+	(*PosEmbeddedBarcodeRule)(nil),                 // 0: pos.PosEmbeddedBarcodeRule
+	(*PosEmbeddedBarcode)(nil),                     // 1: pos.PosEmbeddedBarcode
+	(*CreatePosEmbeddedBarcodeRuleRequest)(nil),    // 2: pos.CreatePosEmbeddedBarcodeRuleRequest
+	(*CreatePosEmbeddedBarcodeRuleResponse)(nil),   // 3: pos.CreatePosEmbeddedBarcodeRuleResponse
+	(*UpdatePosEmbeddedBarcodeRuleRequest)(nil),    // 4: pos.UpdatePosEmbeddedBarcodeRuleRequest
+	(*UpdatePosEmbeddedBarcodeRuleResponse)(nil),   // 5: pos.UpdatePosEmbeddedBarcodeRuleResponse
+	(*DeletePosEmbeddedBarcodeRuleRequest)(nil),    // 6: pos.DeletePosEmbeddedBarcodeRuleRequest
+	(*DeletePosEmbeddedBarcodeRuleResponse)(nil),   // 7: pos.DeletePosEmbeddedBarcodeRuleResponse
+	(*ReadAllPosEmbeddedBarcodeRulesRequest)(nil),  // 8: pos.ReadAllPosEmbeddedBarcodeRulesRequest
+	(*ReadAllPosEmbeddedBarcodeRulesResponse)(nil), // 9: pos.ReadAllPosEmbeddedBarcodeRulesResponse
+	(*timestamppb.Timestamp)(nil),                  // 10: google.protobuf.Timestamp
+	(*JWTPayload)(nil),                             // 11: pos.JWTPayload
+}
+var file_embedded_barcode_rule_proto_depIdxs = []int32{
+	10, // 0: pos.PosEmbeddedBarcodeRule.created_at:type_name -> google.protobuf.Timestamp
+	10, // 1: pos.PosEmbeddedBarcodeRule.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: pos.CreatePosEmbeddedBarcodeRuleRequest.pos_embedded_barcode_rule:type_name -> pos.PosEmbeddedBarcodeRule
+	11, // 3: pos.CreatePosEmbeddedBarcodeRuleRequest.jwt_payload:type_name -> pos.JWTPayload
+	0,  // 4: pos.CreatePosEmbeddedBarcodeRuleResponse.pos_embedded_barcode_rule:type_name -> pos.PosEmbeddedBarcodeRule
+	0,  // 5: pos.UpdatePosEmbeddedBarcodeRuleRequest.pos_embedded_barcode_rule:type_name -> pos.PosEmbeddedBarcodeRule
+	11, // 6: pos.UpdatePosEmbeddedBarcodeRuleRequest.jwt_payload:type_name -> pos.JWTPayload
+	0,  // 7: pos.UpdatePosEmbeddedBarcodeRuleResponse.pos_embedded_barcode_rule:type_name -> pos.PosEmbeddedBarcodeRule
+	11, // 8: pos.DeletePosEmbeddedBarcodeRuleRequest.jwt_payload:type_name -> pos.JWTPayload
+	11, // 9: pos.ReadAllPosEmbeddedBarcodeRulesRequest.jwt_payload:type_name -> pos.JWTPayload
+	0,  // 10: pos.ReadAllPosEmbeddedBarcodeRulesResponse.pos_embedded_barcode_rules:type_name -> pos.PosEmbeddedBarcodeRule
+	2,  // 11: pos.PosEmbeddedBarcodeRuleService.CreatePosEmbeddedBarcodeRule:input_type -> pos.CreatePosEmbeddedBarcodeRuleRequest
+	4,  // 12: pos.PosEmbeddedBarcodeRuleService.UpdatePosEmbeddedBarcodeRule:input_type -> pos.UpdatePosEmbeddedBarcodeRuleRequest
+	6,  // 13: pos.PosEmbeddedBarcodeRuleService.DeletePosEmbeddedBarcodeRule:input_type -> pos.DeletePosEmbeddedBarcodeRuleRequest
+	8,  // 14: pos.PosEmbeddedBarcodeRuleService.ReadAllPosEmbeddedBarcodeRules:input_type -> pos.ReadAllPosEmbeddedBarcodeRulesRequest
+	3,  // 15: pos.PosEmbeddedBarcodeRuleService.CreatePosEmbeddedBarcodeRule:output_type -> pos.CreatePosEmbeddedBarcodeRuleResponse
+	5,  // 16: pos.PosEmbeddedBarcodeRuleService.UpdatePosEmbeddedBarcodeRule:output_type -> pos.UpdatePosEmbeddedBarcodeRuleResponse
+	7,  // 17: pos.PosEmbeddedBarcodeRuleService.DeletePosEmbeddedBarcodeRule:output_type -> pos.DeletePosEmbeddedBarcodeRuleResponse
+	9,  // 18: pos.PosEmbeddedBarcodeRuleService.ReadAllPosEmbeddedBarcodeRules:output_type -> pos.ReadAllPosEmbeddedBarcodeRulesResponse
+	15, // [15:19] is the sub-list for method output_type
+	11, // [11:15] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_embedded_barcode_rule_proto_init() }
+func file_embedded_barcode_rule_proto_init() {
+	if File_embedded_barcode_rule_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_embedded_barcode_rule_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PosEmbeddedBarcodeRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_embedded_barcode_rule_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PosEmbeddedBarcode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_embedded_barcode_rule_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePosEmbeddedBarcodeRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_embedded_barcode_rule_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePosEmbeddedBarcodeRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_embedded_barcode_rule_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePosEmbeddedBarcodeRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_embedded_barcode_rule_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePosEmbeddedBarcodeRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_embedded_barcode_rule_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePosEmbeddedBarcodeRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_embedded_barcode_rule_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePosEmbeddedBarcodeRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_embedded_barcode_rule_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadAllPosEmbeddedBarcodeRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_embedded_barcode_rule_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadAllPosEmbeddedBarcodeRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_embedded_barcode_rule_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_embedded_barcode_rule_proto_goTypes,
+		DependencyIndexes: file_embedded_barcode_rule_proto_depIdxs,
+		MessageInfos:      file_embedded_barcode_rule_proto_msgTypes,
+	}.Build()
+	File_embedded_barcode_rule_proto = out.File
+	file_embedded_barcode_rule_proto_rawDesc = nil
+	file_embedded_barcode_rule_proto_goTypes = nil
+	file_embedded_barcode_rule_proto_depIdxs = nil
+}
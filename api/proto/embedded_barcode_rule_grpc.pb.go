@@ -0,0 +1,214 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: embedded_barcode_rule.proto
+
+package alpha_pos_system_product_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosEmbeddedBarcodeRuleServiceClient is the client API for PosEmbeddedBarcodeRuleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosEmbeddedBarcodeRuleServiceClient interface {
+	CreatePosEmbeddedBarcodeRule(ctx context.Context, in *CreatePosEmbeddedBarcodeRuleRequest, opts ...grpc.CallOption) (*CreatePosEmbeddedBarcodeRuleResponse, error)
+	UpdatePosEmbeddedBarcodeRule(ctx context.Context, in *UpdatePosEmbeddedBarcodeRuleRequest, opts ...grpc.CallOption) (*UpdatePosEmbeddedBarcodeRuleResponse, error)
+	DeletePosEmbeddedBarcodeRule(ctx context.Context, in *DeletePosEmbeddedBarcodeRuleRequest, opts ...grpc.CallOption) (*DeletePosEmbeddedBarcodeRuleResponse, error)
+	ReadAllPosEmbeddedBarcodeRules(ctx context.Context, in *ReadAllPosEmbeddedBarcodeRulesRequest, opts ...grpc.CallOption) (*ReadAllPosEmbeddedBarcodeRulesResponse, error)
+}
+
+type posEmbeddedBarcodeRuleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosEmbeddedBarcodeRuleServiceClient(cc grpc.ClientConnInterface) PosEmbeddedBarcodeRuleServiceClient {
+	return &posEmbeddedBarcodeRuleServiceClient{cc}
+}
+
+func (c *posEmbeddedBarcodeRuleServiceClient) CreatePosEmbeddedBarcodeRule(ctx context.Context, in *CreatePosEmbeddedBarcodeRuleRequest, opts ...grpc.CallOption) (*CreatePosEmbeddedBarcodeRuleResponse, error) {
+	out := new(CreatePosEmbeddedBarcodeRuleResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosEmbeddedBarcodeRuleService/CreatePosEmbeddedBarcodeRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posEmbeddedBarcodeRuleServiceClient) UpdatePosEmbeddedBarcodeRule(ctx context.Context, in *UpdatePosEmbeddedBarcodeRuleRequest, opts ...grpc.CallOption) (*UpdatePosEmbeddedBarcodeRuleResponse, error) {
+	out := new(UpdatePosEmbeddedBarcodeRuleResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosEmbeddedBarcodeRuleService/UpdatePosEmbeddedBarcodeRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posEmbeddedBarcodeRuleServiceClient) DeletePosEmbeddedBarcodeRule(ctx context.Context, in *DeletePosEmbeddedBarcodeRuleRequest, opts ...grpc.CallOption) (*DeletePosEmbeddedBarcodeRuleResponse, error) {
+	out := new(DeletePosEmbeddedBarcodeRuleResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosEmbeddedBarcodeRuleService/DeletePosEmbeddedBarcodeRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posEmbeddedBarcodeRuleServiceClient) ReadAllPosEmbeddedBarcodeRules(ctx context.Context, in *ReadAllPosEmbeddedBarcodeRulesRequest, opts ...grpc.CallOption) (*ReadAllPosEmbeddedBarcodeRulesResponse, error) {
+	out := new(ReadAllPosEmbeddedBarcodeRulesResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosEmbeddedBarcodeRuleService/ReadAllPosEmbeddedBarcodeRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosEmbeddedBarcodeRuleServiceServer is the server API for PosEmbeddedBarcodeRuleService service.
+// All implementations must embed UnimplementedPosEmbeddedBarcodeRuleServiceServer
+// for forward compatibility
+type PosEmbeddedBarcodeRuleServiceServer interface {
+	CreatePosEmbeddedBarcodeRule(context.Context, *CreatePosEmbeddedBarcodeRuleRequest) (*CreatePosEmbeddedBarcodeRuleResponse, error)
+	UpdatePosEmbeddedBarcodeRule(context.Context, *UpdatePosEmbeddedBarcodeRuleRequest) (*UpdatePosEmbeddedBarcodeRuleResponse, error)
+	DeletePosEmbeddedBarcodeRule(context.Context, *DeletePosEmbeddedBarcodeRuleRequest) (*DeletePosEmbeddedBarcodeRuleResponse, error)
+	ReadAllPosEmbeddedBarcodeRules(context.Context, *ReadAllPosEmbeddedBarcodeRulesRequest) (*ReadAllPosEmbeddedBarcodeRulesResponse, error)
+	mustEmbedUnimplementedPosEmbeddedBarcodeRuleServiceServer()
+}
+
+// UnimplementedPosEmbeddedBarcodeRuleServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosEmbeddedBarcodeRuleServiceServer struct {
+}
+
+func (UnimplementedPosEmbeddedBarcodeRuleServiceServer) CreatePosEmbeddedBarcodeRule(context.Context, *CreatePosEmbeddedBarcodeRuleRequest) (*CreatePosEmbeddedBarcodeRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePosEmbeddedBarcodeRule not implemented")
+}
+func (UnimplementedPosEmbeddedBarcodeRuleServiceServer) UpdatePosEmbeddedBarcodeRule(context.Context, *UpdatePosEmbeddedBarcodeRuleRequest) (*UpdatePosEmbeddedBarcodeRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePosEmbeddedBarcodeRule not implemented")
+}
+func (UnimplementedPosEmbeddedBarcodeRuleServiceServer) DeletePosEmbeddedBarcodeRule(context.Context, *DeletePosEmbeddedBarcodeRuleRequest) (*DeletePosEmbeddedBarcodeRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePosEmbeddedBarcodeRule not implemented")
+}
+func (UnimplementedPosEmbeddedBarcodeRuleServiceServer) ReadAllPosEmbeddedBarcodeRules(context.Context, *ReadAllPosEmbeddedBarcodeRulesRequest) (*ReadAllPosEmbeddedBarcodeRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAllPosEmbeddedBarcodeRules not implemented")
+}
+func (UnimplementedPosEmbeddedBarcodeRuleServiceServer) mustEmbedUnimplementedPosEmbeddedBarcodeRuleServiceServer() {
+}
+
+// UnsafePosEmbeddedBarcodeRuleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosEmbeddedBarcodeRuleServiceServer will
+// result in compilation errors.
+type UnsafePosEmbeddedBarcodeRuleServiceServer interface {
+	mustEmbedUnimplementedPosEmbeddedBarcodeRuleServiceServer()
+}
+
+func RegisterPosEmbeddedBarcodeRuleServiceServer(s grpc.ServiceRegistrar, srv PosEmbeddedBarcodeRuleServiceServer) {
+	s.RegisterService(&PosEmbeddedBarcodeRuleService_ServiceDesc, srv)
+}
+
+func _PosEmbeddedBarcodeRuleService_CreatePosEmbeddedBarcodeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePosEmbeddedBarcodeRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosEmbeddedBarcodeRuleServiceServer).CreatePosEmbeddedBarcodeRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosEmbeddedBarcodeRuleService/CreatePosEmbeddedBarcodeRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosEmbeddedBarcodeRuleServiceServer).CreatePosEmbeddedBarcodeRule(ctx, req.(*CreatePosEmbeddedBarcodeRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosEmbeddedBarcodeRuleService_UpdatePosEmbeddedBarcodeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePosEmbeddedBarcodeRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosEmbeddedBarcodeRuleServiceServer).UpdatePosEmbeddedBarcodeRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosEmbeddedBarcodeRuleService/UpdatePosEmbeddedBarcodeRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosEmbeddedBarcodeRuleServiceServer).UpdatePosEmbeddedBarcodeRule(ctx, req.(*UpdatePosEmbeddedBarcodeRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosEmbeddedBarcodeRuleService_DeletePosEmbeddedBarcodeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePosEmbeddedBarcodeRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosEmbeddedBarcodeRuleServiceServer).DeletePosEmbeddedBarcodeRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosEmbeddedBarcodeRuleService/DeletePosEmbeddedBarcodeRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosEmbeddedBarcodeRuleServiceServer).DeletePosEmbeddedBarcodeRule(ctx, req.(*DeletePosEmbeddedBarcodeRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosEmbeddedBarcodeRuleService_ReadAllPosEmbeddedBarcodeRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAllPosEmbeddedBarcodeRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosEmbeddedBarcodeRuleServiceServer).ReadAllPosEmbeddedBarcodeRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosEmbeddedBarcodeRuleService/ReadAllPosEmbeddedBarcodeRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosEmbeddedBarcodeRuleServiceServer).ReadAllPosEmbeddedBarcodeRules(ctx, req.(*ReadAllPosEmbeddedBarcodeRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosEmbeddedBarcodeRuleService_ServiceDesc is the grpc.ServiceDesc for PosEmbeddedBarcodeRuleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosEmbeddedBarcodeRuleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosEmbeddedBarcodeRuleService",
+	HandlerType: (*PosEmbeddedBarcodeRuleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePosEmbeddedBarcodeRule",
+			Handler:    _PosEmbeddedBarcodeRuleService_CreatePosEmbeddedBarcodeRule_Handler,
+		},
+		{
+			MethodName: "UpdatePosEmbeddedBarcodeRule",
+			Handler:    _PosEmbeddedBarcodeRuleService_UpdatePosEmbeddedBarcodeRule_Handler,
+		},
+		{
+			MethodName: "DeletePosEmbeddedBarcodeRule",
+			Handler:    _PosEmbeddedBarcodeRuleService_DeletePosEmbeddedBarcodeRule_Handler,
+		},
+		{
+			MethodName: "ReadAllPosEmbeddedBarcodeRules",
+			Handler:    _PosEmbeddedBarcodeRuleService_ReadAllPosEmbeddedBarcodeRules_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "embedded_barcode_rule.proto",
+}
@@ -27,17 +27,31 @@ type PosInventoryHistory struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InventoryId string                 `protobuf:"bytes,1,opt,name=inventory_id,json=inventoryId,proto3" json:"inventory_id,omitempty"`
-	ProductId   string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	StoreId     string                 `protobuf:"bytes,3,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
-	Date        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
-	Quantity    int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	BranchId    string                 `protobuf:"bytes,6,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`
-	CompanyId   string                 `protobuf:"bytes,7,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
-	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CreatedBy   string                 `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	UpdatedBy   string                 `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	InventoryId     string                 `protobuf:"bytes,1,opt,name=inventory_id,json=inventoryId,proto3" json:"inventory_id,omitempty"`
+	ProductId       string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	StoreId         string                 `protobuf:"bytes,3,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	Date            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Quantity        int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	BranchId        string                 `protobuf:"bytes,6,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`
+	CompanyId       string                 `protobuf:"bytes,7,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy       string                 `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy       string                 `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	MovementType    string                 `protobuf:"bytes,12,opt,name=movement_type,json=movementType,proto3" json:"movement_type,omitempty"`
+	Note            string                 `protobuf:"bytes,13,opt,name=note,proto3" json:"note,omitempty"`
+	ReferenceNo     string                 `protobuf:"bytes,14,opt,name=reference_no,json=referenceNo,proto3" json:"reference_no,omitempty"`
+	TransferId      string                 `protobuf:"bytes,15,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	PurchaseOrderId string                 `protobuf:"bytes,16,opt,name=purchase_order_id,json=purchaseOrderId,proto3" json:"purchase_order_id,omitempty"`
+	LotCode         string                 `protobuf:"bytes,17,opt,name=lot_code,json=lotCode,proto3" json:"lot_code,omitempty"`
+	ExpiryDate      *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"`
+	UnitCost        float64                `protobuf:"fixed64,19,opt,name=unit_cost,json=unitCost,proto3" json:"unit_cost,omitempty"`
+	CostAmount      float64                `protobuf:"fixed64,20,opt,name=cost_amount,json=costAmount,proto3" json:"cost_amount,omitempty"`
+	ReversalOfId    string                 `protobuf:"bytes,21,opt,name=reversal_of_id,json=reversalOfId,proto3" json:"reversal_of_id,omitempty"`
+	SerialNumbers   []string               `protobuf:"bytes,22,rep,name=serial_numbers,json=serialNumbers,proto3" json:"serial_numbers,omitempty"`
+	Unit            string                 `protobuf:"bytes,23,opt,name=unit,proto3" json:"unit,omitempty"`
+	UnitQuantity    float64                `protobuf:"fixed64,24,opt,name=unit_quantity,json=unitQuantity,proto3" json:"unit_quantity,omitempty"`
+	SaleId          string                 `protobuf:"bytes,25,opt,name=sale_id,json=saleId,proto3" json:"sale_id,omitempty"`
 }
 
 func (x *PosInventoryHistory) Reset() {
@@ -149,6 +163,104 @@ func (x *PosInventoryHistory) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *PosInventoryHistory) GetMovementType() string {
+	if x != nil {
+		return x.MovementType
+	}
+	return ""
+}
+
+func (x *PosInventoryHistory) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *PosInventoryHistory) GetReferenceNo() string {
+	if x != nil {
+		return x.ReferenceNo
+	}
+	return ""
+}
+
+func (x *PosInventoryHistory) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *PosInventoryHistory) GetPurchaseOrderId() string {
+	if x != nil {
+		return x.PurchaseOrderId
+	}
+	return ""
+}
+
+func (x *PosInventoryHistory) GetLotCode() string {
+	if x != nil {
+		return x.LotCode
+	}
+	return ""
+}
+
+func (x *PosInventoryHistory) GetExpiryDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiryDate
+	}
+	return nil
+}
+
+func (x *PosInventoryHistory) GetUnitCost() float64 {
+	if x != nil {
+		return x.UnitCost
+	}
+	return 0
+}
+
+func (x *PosInventoryHistory) GetCostAmount() float64 {
+	if x != nil {
+		return x.CostAmount
+	}
+	return 0
+}
+
+func (x *PosInventoryHistory) GetReversalOfId() string {
+	if x != nil {
+		return x.ReversalOfId
+	}
+	return ""
+}
+
+func (x *PosInventoryHistory) GetSerialNumbers() []string {
+	if x != nil {
+		return x.SerialNumbers
+	}
+	return nil
+}
+
+func (x *PosInventoryHistory) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *PosInventoryHistory) GetUnitQuantity() float64 {
+	if x != nil {
+		return x.UnitQuantity
+	}
+	return 0
+}
+
+func (x *PosInventoryHistory) GetSaleId() string {
+	if x != nil {
+		return x.SaleId
+	}
+	return ""
+}
+
 // Request and Response messages
 type CreatePosInventoryHistoryRequest struct {
 	state         protoimpl.MessageState
@@ -219,6 +331,7 @@ type CreatePosInventoryHistoryResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	PosInventoryHistory *PosInventoryHistory `protobuf:"bytes,1,opt,name=pos_inventory_history,json=posInventoryHistory,proto3" json:"pos_inventory_history,omitempty"`
+	Warning             string               `protobuf:"bytes,2,opt,name=warning,proto3" json:"warning,omitempty"`
 }
 
 func (x *CreatePosInventoryHistoryResponse) Reset() {
@@ -260,6 +373,13 @@ func (x *CreatePosInventoryHistoryResponse) GetPosInventoryHistory() *PosInvento
 	return nil
 }
 
+func (x *CreatePosInventoryHistoryResponse) GetWarning() string {
+	if x != nil {
+		return x.Warning
+	}
+	return ""
+}
+
 type ReadPosInventoryHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -370,18 +490,19 @@ func (x *ReadPosInventoryHistoryResponse) GetPosInventoryHistory() *PosInventory
 	return nil
 }
 
-type UpdatePosInventoryHistoryRequest struct {
+type ReversePosInventoryHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PosInventoryHistory *PosInventoryHistory `protobuf:"bytes,1,opt,name=pos_inventory_history,json=posInventoryHistory,proto3" json:"pos_inventory_history,omitempty"`
-	JwtPayload          *JWTPayload          `protobuf:"bytes,2,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
-	JwtToken            string               `protobuf:"bytes,3,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+	InventoryId string      `protobuf:"bytes,1,opt,name=inventory_id,json=inventoryId,proto3" json:"inventory_id,omitempty"`
+	Note        string      `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	JwtPayload  *JWTPayload `protobuf:"bytes,3,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken    string      `protobuf:"bytes,4,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
 }
 
-func (x *UpdatePosInventoryHistoryRequest) Reset() {
-	*x = UpdatePosInventoryHistoryRequest{}
+func (x *ReversePosInventoryHistoryRequest) Reset() {
+	*x = ReversePosInventoryHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_inventory_history_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -389,13 +510,13 @@ func (x *UpdatePosInventoryHistoryRequest) Reset() {
 	}
 }
 
-func (x *UpdatePosInventoryHistoryRequest) String() string {
+func (x *ReversePosInventoryHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePosInventoryHistoryRequest) ProtoMessage() {}
+func (*ReversePosInventoryHistoryRequest) ProtoMessage() {}
 
-func (x *UpdatePosInventoryHistoryRequest) ProtoReflect() protoreflect.Message {
+func (x *ReversePosInventoryHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_history_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -407,33 +528,40 @@ func (x *UpdatePosInventoryHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePosInventoryHistoryRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePosInventoryHistoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReversePosInventoryHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ReversePosInventoryHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_inventory_history_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *UpdatePosInventoryHistoryRequest) GetPosInventoryHistory() *PosInventoryHistory {
+func (x *ReversePosInventoryHistoryRequest) GetInventoryId() string {
 	if x != nil {
-		return x.PosInventoryHistory
+		return x.InventoryId
 	}
-	return nil
+	return ""
+}
+
+func (x *ReversePosInventoryHistoryRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
 }
 
-func (x *UpdatePosInventoryHistoryRequest) GetJwtPayload() *JWTPayload {
+func (x *ReversePosInventoryHistoryRequest) GetJwtPayload() *JWTPayload {
 	if x != nil {
 		return x.JwtPayload
 	}
 	return nil
 }
 
-func (x *UpdatePosInventoryHistoryRequest) GetJwtToken() string {
+func (x *ReversePosInventoryHistoryRequest) GetJwtToken() string {
 	if x != nil {
 		return x.JwtToken
 	}
 	return ""
 }
 
-type UpdatePosInventoryHistoryResponse struct {
+type ReversePosInventoryHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -441,8 +569,8 @@ type UpdatePosInventoryHistoryResponse struct {
 	PosInventoryHistory *PosInventoryHistory `protobuf:"bytes,1,opt,name=pos_inventory_history,json=posInventoryHistory,proto3" json:"pos_inventory_history,omitempty"`
 }
 
-func (x *UpdatePosInventoryHistoryResponse) Reset() {
-	*x = UpdatePosInventoryHistoryResponse{}
+func (x *ReversePosInventoryHistoryResponse) Reset() {
+	*x = ReversePosInventoryHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_inventory_history_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -450,13 +578,13 @@ func (x *UpdatePosInventoryHistoryResponse) Reset() {
 	}
 }
 
-func (x *UpdatePosInventoryHistoryResponse) String() string {
+func (x *ReversePosInventoryHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePosInventoryHistoryResponse) ProtoMessage() {}
+func (*ReversePosInventoryHistoryResponse) ProtoMessage() {}
 
-func (x *UpdatePosInventoryHistoryResponse) ProtoReflect() protoreflect.Message {
+func (x *ReversePosInventoryHistoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_history_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -468,30 +596,42 @@ func (x *UpdatePosInventoryHistoryResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePosInventoryHistoryResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePosInventoryHistoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReversePosInventoryHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ReversePosInventoryHistoryResponse) Descriptor() ([]byte, []int) {
 	return file_inventory_history_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *UpdatePosInventoryHistoryResponse) GetPosInventoryHistory() *PosInventoryHistory {
+func (x *ReversePosInventoryHistoryResponse) GetPosInventoryHistory() *PosInventoryHistory {
 	if x != nil {
 		return x.PosInventoryHistory
 	}
 	return nil
 }
 
-type DeletePosInventoryHistoryRequest struct {
+// ReadAllPosInventoryHistoriesRequest, every filter is optional. quantity_sign is positive or
+// negative, sort_by is date (default), created_at or quantity and sort_order is asc or desc (default).
+type ReadAllPosInventoryHistoriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InventoryId string      `protobuf:"bytes,1,opt,name=inventory_id,json=inventoryId,proto3" json:"inventory_id,omitempty"`
-	JwtPayload  *JWTPayload `protobuf:"bytes,2,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
-	JwtToken    string      `protobuf:"bytes,3,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+	Limit        int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page         int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	JwtPayload   *JWTPayload            `protobuf:"bytes,3,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken     string                 `protobuf:"bytes,4,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+	ProductId    string                 `protobuf:"bytes,5,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	StoreId      string                 `protobuf:"bytes,6,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	BranchId     string                 `protobuf:"bytes,7,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`
+	DateFrom     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	QuantitySign string                 `protobuf:"bytes,10,opt,name=quantity_sign,json=quantitySign,proto3" json:"quantity_sign,omitempty"`
+	CreatedBy    string                 `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	SortBy       string                 `protobuf:"bytes,12,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortOrder    string                 `protobuf:"bytes,13,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
 }
 
-func (x *DeletePosInventoryHistoryRequest) Reset() {
-	*x = DeletePosInventoryHistoryRequest{}
+func (x *ReadAllPosInventoryHistoriesRequest) Reset() {
+	*x = ReadAllPosInventoryHistoriesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_inventory_history_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -499,13 +639,13 @@ func (x *DeletePosInventoryHistoryRequest) Reset() {
 	}
 }
 
-func (x *DeletePosInventoryHistoryRequest) String() string {
+func (x *ReadAllPosInventoryHistoriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePosInventoryHistoryRequest) ProtoMessage() {}
+func (*ReadAllPosInventoryHistoriesRequest) ProtoMessage() {}
 
-func (x *DeletePosInventoryHistoryRequest) ProtoReflect() protoreflect.Message {
+func (x *ReadAllPosInventoryHistoriesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_history_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -517,42 +657,116 @@ func (x *DeletePosInventoryHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePosInventoryHistoryRequest.ProtoReflect.Descriptor instead.
-func (*DeletePosInventoryHistoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadAllPosInventoryHistoriesRequest.ProtoReflect.Descriptor instead.
+func (*ReadAllPosInventoryHistoriesRequest) Descriptor() ([]byte, []int) {
 	return file_inventory_history_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *DeletePosInventoryHistoryRequest) GetInventoryId() string {
+func (x *ReadAllPosInventoryHistoriesRequest) GetLimit() int32 {
 	if x != nil {
-		return x.InventoryId
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *DeletePosInventoryHistoryRequest) GetJwtPayload() *JWTPayload {
+func (x *ReadAllPosInventoryHistoriesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ReadAllPosInventoryHistoriesRequest) GetJwtPayload() *JWTPayload {
 	if x != nil {
 		return x.JwtPayload
 	}
 	return nil
 }
 
-func (x *DeletePosInventoryHistoryRequest) GetJwtToken() string {
+func (x *ReadAllPosInventoryHistoriesRequest) GetJwtToken() string {
 	if x != nil {
 		return x.JwtToken
 	}
 	return ""
 }
 
-type DeletePosInventoryHistoryResponse struct {
+func (x *ReadAllPosInventoryHistoriesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ReadAllPosInventoryHistoriesRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *ReadAllPosInventoryHistoriesRequest) GetBranchId() string {
+	if x != nil {
+		return x.BranchId
+	}
+	return ""
+}
+
+func (x *ReadAllPosInventoryHistoriesRequest) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *ReadAllPosInventoryHistoriesRequest) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *ReadAllPosInventoryHistoriesRequest) GetQuantitySign() string {
+	if x != nil {
+		return x.QuantitySign
+	}
+	return ""
+}
+
+func (x *ReadAllPosInventoryHistoriesRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ReadAllPosInventoryHistoriesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ReadAllPosInventoryHistoriesRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+type ReadAllPosInventoryHistoriesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	PosInventoryHistories []*PosInventoryHistory `protobuf:"bytes,1,rep,name=pos_inventory_histories,json=posInventoryHistories,proto3" json:"pos_inventory_histories,omitempty"`
+	Limit                 int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page                  int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	MaxPage               int32                  `protobuf:"varint,4,opt,name=max_page,json=maxPage,proto3" json:"max_page,omitempty"`
+	Count                 int64                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *DeletePosInventoryHistoryResponse) Reset() {
-	*x = DeletePosInventoryHistoryResponse{}
+func (x *ReadAllPosInventoryHistoriesResponse) Reset() {
+	*x = ReadAllPosInventoryHistoriesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_inventory_history_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -560,13 +774,13 @@ func (x *DeletePosInventoryHistoryResponse) Reset() {
 	}
 }
 
-func (x *DeletePosInventoryHistoryResponse) String() string {
+func (x *ReadAllPosInventoryHistoriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePosInventoryHistoryResponse) ProtoMessage() {}
+func (*ReadAllPosInventoryHistoriesResponse) ProtoMessage() {}
 
-func (x *DeletePosInventoryHistoryResponse) ProtoReflect() protoreflect.Message {
+func (x *ReadAllPosInventoryHistoriesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_history_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -578,31 +792,61 @@ func (x *DeletePosInventoryHistoryResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePosInventoryHistoryResponse.ProtoReflect.Descriptor instead.
-func (*DeletePosInventoryHistoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadAllPosInventoryHistoriesResponse.ProtoReflect.Descriptor instead.
+func (*ReadAllPosInventoryHistoriesResponse) Descriptor() ([]byte, []int) {
 	return file_inventory_history_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DeletePosInventoryHistoryResponse) GetSuccess() bool {
+func (x *ReadAllPosInventoryHistoriesResponse) GetPosInventoryHistories() []*PosInventoryHistory {
+	if x != nil {
+		return x.PosInventoryHistories
+	}
+	return nil
+}
+
+func (x *ReadAllPosInventoryHistoriesResponse) GetLimit() int32 {
 	if x != nil {
-		return x.Success
+		return x.Limit
 	}
-	return false
+	return 0
 }
 
-type ReadAllPosInventoryHistoriesRequest struct {
+func (x *ReadAllPosInventoryHistoriesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ReadAllPosInventoryHistoriesResponse) GetMaxPage() int32 {
+	if x != nil {
+		return x.MaxPage
+	}
+	return 0
+}
+
+func (x *ReadAllPosInventoryHistoriesResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// PosStockAsOf is the stock of a product in a store rebuilt from the inventory ledger
+type PosStockAsOf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limit      int32       `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Page       int32       `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	JwtPayload *JWTPayload `protobuf:"bytes,3,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
-	JwtToken   string      `protobuf:"bytes,4,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	StoreId   string `protobuf:"bytes,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	BranchId  string `protobuf:"bytes,3,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`
+	CompanyId string `protobuf:"bytes,4,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Quantity  int64  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
 }
 
-func (x *ReadAllPosInventoryHistoriesRequest) Reset() {
-	*x = ReadAllPosInventoryHistoriesRequest{}
+func (x *PosStockAsOf) Reset() {
+	*x = PosStockAsOf{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_inventory_history_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -610,13 +854,13 @@ func (x *ReadAllPosInventoryHistoriesRequest) Reset() {
 	}
 }
 
-func (x *ReadAllPosInventoryHistoriesRequest) String() string {
+func (x *PosStockAsOf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadAllPosInventoryHistoriesRequest) ProtoMessage() {}
+func (*PosStockAsOf) ProtoMessage() {}
 
-func (x *ReadAllPosInventoryHistoriesRequest) ProtoReflect() protoreflect.Message {
+func (x *PosStockAsOf) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_history_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -628,53 +872,62 @@ func (x *ReadAllPosInventoryHistoriesRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadAllPosInventoryHistoriesRequest.ProtoReflect.Descriptor instead.
-func (*ReadAllPosInventoryHistoriesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PosStockAsOf.ProtoReflect.Descriptor instead.
+func (*PosStockAsOf) Descriptor() ([]byte, []int) {
 	return file_inventory_history_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ReadAllPosInventoryHistoriesRequest) GetLimit() int32 {
+func (x *PosStockAsOf) GetProductId() string {
 	if x != nil {
-		return x.Limit
+		return x.ProductId
 	}
-	return 0
+	return ""
 }
 
-func (x *ReadAllPosInventoryHistoriesRequest) GetPage() int32 {
+func (x *PosStockAsOf) GetStoreId() string {
 	if x != nil {
-		return x.Page
+		return x.StoreId
 	}
-	return 0
+	return ""
 }
 
-func (x *ReadAllPosInventoryHistoriesRequest) GetJwtPayload() *JWTPayload {
+func (x *PosStockAsOf) GetBranchId() string {
 	if x != nil {
-		return x.JwtPayload
+		return x.BranchId
 	}
-	return nil
+	return ""
 }
 
-func (x *ReadAllPosInventoryHistoriesRequest) GetJwtToken() string {
+func (x *PosStockAsOf) GetCompanyId() string {
 	if x != nil {
-		return x.JwtToken
+		return x.CompanyId
 	}
 	return ""
 }
 
-type ReadAllPosInventoryHistoriesResponse struct {
+func (x *PosStockAsOf) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type GetStockAsOfRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PosInventoryHistories []*PosInventoryHistory `protobuf:"bytes,1,rep,name=pos_inventory_histories,json=posInventoryHistories,proto3" json:"pos_inventory_histories,omitempty"`
-	Limit                 int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Page                  int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	MaxPage               int32                  `protobuf:"varint,4,opt,name=max_page,json=maxPage,proto3" json:"max_page,omitempty"`
-	Count                 int64                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Limit      int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page       int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	JwtPayload *JWTPayload            `protobuf:"bytes,3,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken   string                 `protobuf:"bytes,4,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+	AsOf       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	ProductId  string                 `protobuf:"bytes,6,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	StoreId    string                 `protobuf:"bytes,7,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
 }
 
-func (x *ReadAllPosInventoryHistoriesResponse) Reset() {
-	*x = ReadAllPosInventoryHistoriesResponse{}
+func (x *GetStockAsOfRequest) Reset() {
+	*x = GetStockAsOfRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_inventory_history_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -682,13 +935,13 @@ func (x *ReadAllPosInventoryHistoriesResponse) Reset() {
 	}
 }
 
-func (x *ReadAllPosInventoryHistoriesResponse) String() string {
+func (x *GetStockAsOfRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadAllPosInventoryHistoriesResponse) ProtoMessage() {}
+func (*GetStockAsOfRequest) ProtoMessage() {}
 
-func (x *ReadAllPosInventoryHistoriesResponse) ProtoReflect() protoreflect.Message {
+func (x *GetStockAsOfRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_history_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -700,40 +953,387 @@ func (x *ReadAllPosInventoryHistoriesResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadAllPosInventoryHistoriesResponse.ProtoReflect.Descriptor instead.
-func (*ReadAllPosInventoryHistoriesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStockAsOfRequest.ProtoReflect.Descriptor instead.
+func (*GetStockAsOfRequest) Descriptor() ([]byte, []int) {
 	return file_inventory_history_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ReadAllPosInventoryHistoriesResponse) GetPosInventoryHistories() []*PosInventoryHistory {
+func (x *GetStockAsOfRequest) GetLimit() int32 {
 	if x != nil {
-		return x.PosInventoryHistories
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-func (x *ReadAllPosInventoryHistoriesResponse) GetLimit() int32 {
+func (x *GetStockAsOfRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetStockAsOfRequest) GetJwtPayload() *JWTPayload {
+	if x != nil {
+		return x.JwtPayload
+	}
+	return nil
+}
+
+func (x *GetStockAsOfRequest) GetJwtToken() string {
+	if x != nil {
+		return x.JwtToken
+	}
+	return ""
+}
+
+func (x *GetStockAsOfRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *GetStockAsOfRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetStockAsOfRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+type GetStockAsOfResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stocks  []*PosStockAsOf        `protobuf:"bytes,1,rep,name=stocks,proto3" json:"stocks,omitempty"`
+	AsOf    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	Limit   int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page    int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	MaxPage int32                  `protobuf:"varint,5,opt,name=max_page,json=maxPage,proto3" json:"max_page,omitempty"`
+	Count   int64                  `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetStockAsOfResponse) Reset() {
+	*x = GetStockAsOfResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inventory_history_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStockAsOfResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockAsOfResponse) ProtoMessage() {}
+
+func (x *GetStockAsOfResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_history_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockAsOfResponse.ProtoReflect.Descriptor instead.
+func (*GetStockAsOfResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_history_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetStockAsOfResponse) GetStocks() []*PosStockAsOf {
+	if x != nil {
+		return x.Stocks
+	}
+	return nil
+}
+
+func (x *GetStockAsOfResponse) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *GetStockAsOfResponse) GetLimit() int32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-func (x *ReadAllPosInventoryHistoriesResponse) GetPage() int32 {
+func (x *GetStockAsOfResponse) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *ReadAllPosInventoryHistoriesResponse) GetMaxPage() int32 {
+func (x *GetStockAsOfResponse) GetMaxPage() int32 {
 	if x != nil {
 		return x.MaxPage
 	}
 	return 0
 }
 
-func (x *ReadAllPosInventoryHistoriesResponse) GetCount() int64 {
+func (x *GetStockAsOfResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// PosStockCardEntry is a movement of the stock card with the balance after the movement
+type PosStockCardEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PosInventoryHistory *PosInventoryHistory `protobuf:"bytes,1,opt,name=pos_inventory_history,json=posInventoryHistory,proto3" json:"pos_inventory_history,omitempty"`
+	RunningBalance      int64                `protobuf:"varint,2,opt,name=running_balance,json=runningBalance,proto3" json:"running_balance,omitempty"`
+}
+
+func (x *PosStockCardEntry) Reset() {
+	*x = PosStockCardEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inventory_history_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PosStockCardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PosStockCardEntry) ProtoMessage() {}
+
+func (x *PosStockCardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_history_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PosStockCardEntry.ProtoReflect.Descriptor instead.
+func (*PosStockCardEntry) Descriptor() ([]byte, []int) {
+	return file_inventory_history_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PosStockCardEntry) GetPosInventoryHistory() *PosInventoryHistory {
+	if x != nil {
+		return x.PosInventoryHistory
+	}
+	return nil
+}
+
+func (x *PosStockCardEntry) GetRunningBalance() int64 {
+	if x != nil {
+		return x.RunningBalance
+	}
+	return 0
+}
+
+type ReadPosStockCardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit      int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page       int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	JwtPayload *JWTPayload            `protobuf:"bytes,3,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken   string                 `protobuf:"bytes,4,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+	ProductId  string                 `protobuf:"bytes,5,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	StoreId    string                 `protobuf:"bytes,6,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	From       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=from,proto3" json:"from,omitempty"`
+	To         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *ReadPosStockCardRequest) Reset() {
+	*x = ReadPosStockCardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inventory_history_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadPosStockCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadPosStockCardRequest) ProtoMessage() {}
+
+func (x *ReadPosStockCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_history_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadPosStockCardRequest.ProtoReflect.Descriptor instead.
+func (*ReadPosStockCardRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_history_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReadPosStockCardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ReadPosStockCardRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ReadPosStockCardRequest) GetJwtPayload() *JWTPayload {
+	if x != nil {
+		return x.JwtPayload
+	}
+	return nil
+}
+
+func (x *ReadPosStockCardRequest) GetJwtToken() string {
+	if x != nil {
+		return x.JwtToken
+	}
+	return ""
+}
+
+func (x *ReadPosStockCardRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ReadPosStockCardRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *ReadPosStockCardRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ReadPosStockCardRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ReadPosStockCardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries        []*PosStockCardEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	OpeningBalance int64                `protobuf:"varint,2,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"`
+	Limit          int32                `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page           int32                `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	MaxPage        int32                `protobuf:"varint,5,opt,name=max_page,json=maxPage,proto3" json:"max_page,omitempty"`
+	Count          int64                `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ReadPosStockCardResponse) Reset() {
+	*x = ReadPosStockCardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inventory_history_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadPosStockCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadPosStockCardResponse) ProtoMessage() {}
+
+func (x *ReadPosStockCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_history_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadPosStockCardResponse.ProtoReflect.Descriptor instead.
+func (*ReadPosStockCardResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_history_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReadPosStockCardResponse) GetEntries() []*PosStockCardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ReadPosStockCardResponse) GetOpeningBalance() int64 {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return 0
+}
+
+func (x *ReadPosStockCardResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ReadPosStockCardResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ReadPosStockCardResponse) GetMaxPage() int32 {
+	if x != nil {
+		return x.MaxPage
+	}
+	return 0
+}
+
+func (x *ReadPosStockCardResponse) GetCount() int64 {
 	if x != nil {
 		return x.Count
 	}
@@ -747,7 +1347,7 @@ var file_inventory_history_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x70, 0x6f, 0x73, 0x1a, 0x1f,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
 	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xae, 0x03,
+	0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8c, 0x07,
 	0x0a, 0x13, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69,
 	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
 	0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x76,
@@ -774,126 +1374,236 @@ var file_inventory_history_proto_rawDesc = []byte{
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
 	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
 	0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0xbf,
-	0x01, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65,
-	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x15, 0x70, 0x6f, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x6e,
-	0x74, 0x6f, 0x72, 0x79, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65,
-	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x13, 0x70, 0x6f,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x23,
+	0x0a, 0x0d, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x70,
+	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x74, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6f, 0x73, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a,
+	0x0e, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x5f, 0x6f, 0x66, 0x5f, 0x69, 0x64, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x4f,
+	0x66, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e,
+	0x69, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x75, 0x6e, 0x69, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x61, 0x6c, 0x65, 0x49, 0x64, 0x22, 0xbf, 0x01, 0x0a,
+	0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74,
+	0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x4c, 0x0a, 0x15, 0x70, 0x6f, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
+	0x72, 0x79, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74,
+	0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x13, 0x70, 0x6f, 0x73, 0x49,
+	0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8b,
+	0x01, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65,
+	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x15, 0x70, 0x6f, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65,
+	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x13, 0x70,
+	0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x92, 0x01, 0x0a,
+	0x1e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72,
+	0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
+	0x49, 0x64, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57,
+	0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x6f, 0x0a, 0x1f, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65,
+	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x15, 0x70, 0x6f, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65,
+	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x13, 0x70,
+	0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x22, 0xa9, 0x01, 0x0a, 0x21, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x6f,
 	0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54,
-	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0x71, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76,
-	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x15, 0x70, 0x6f, 0x73, 0x5f, 0x69, 0x6e, 0x76,
-	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x49, 0x6e,
-	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x13,
-	0x70, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74,
-	0x6f, 0x72, 0x79, 0x22, 0x92, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x49,
-	0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74,
-	0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e,
-	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74,
-	0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52,
-	0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a,
-	0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6f, 0x0a, 0x1f, 0x52, 0x65, 0x61, 0x64,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x65,
+	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12,
+	0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x72,
+	0x0a, 0x22, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65,
+	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x15, 0x70, 0x6f, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65,
+	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x13, 0x70,
+	0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x22, 0xdf, 0x03, 0x0a, 0x23, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f,
+	0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e,
+	0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x33, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x06, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x17, 0x0a, 0x07,
+	0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x22, 0xd3, 0x01, 0x0a, 0x24, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c,
 	0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x15, 0x70,
-	0x6f, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x68, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x73,
-	0x2e, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x52, 0x13, 0x70, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
-	0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xbf, 0x01, 0x0a, 0x20, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
-	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c,
-	0x0a, 0x15, 0x70, 0x6f, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f,
-	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
-	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x13, 0x70, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65,
-	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x30, 0x0a, 0x0b,
-	0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a,
+	0x17, 0x70, 0x6f, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72,
+	0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x15, 0x70, 0x6f, 0x73, 0x49, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78,
+	0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa0, 0x01, 0x0a, 0x0c, 0x50,
+	0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x41, 0x73, 0x4f, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xf9, 0x01,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x41, 0x73, 0x4f, 0x66, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2f,
+	0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x22, 0xcd, 0x01, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x41, 0x73, 0x4f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63,
+	0x6b, 0x41, 0x73, 0x4f, 0x66, 0x52, 0x06, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x2f, 0x0a,
+	0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x50,
+	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x11, 0x50, 0x6f,
+	0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x43, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x4c, 0x0a, 0x15, 0x70, 0x6f, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72,
+	0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x13, 0x70, 0x6f, 0x73, 0x49, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xa8, 0x02, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x50,
+	0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x0b,
+	0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f,
 	0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x71, 0x0a, 0x21, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72,
-	0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x4c, 0x0a, 0x15, 0x70, 0x6f, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72,
-	0x79, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
-	0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x13, 0x70, 0x6f, 0x73, 0x49, 0x6e,
-	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x94,
-	0x01, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65,
-	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x65, 0x6e,
-	0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f,
-	0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77,
-	0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3d, 0x0a, 0x21, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x23, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c,
-	0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74,
-	0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f,
-	0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77,
-	0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xd3, 0x01, 0x0a, 0x24, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c,
-	0x6c, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50,
-	0x0a, 0x17, 0x70, 0x6f, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f,
-	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
-	0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x15, 0x70, 0x6f, 0x73, 0x49, 0x6e,
-	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61,
-	0x78, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61,
-	0x78, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0xbb, 0x04, 0x0a, 0x1a,
-	0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74,
-	0x6f, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6a, 0x0a, 0x19, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
-	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x25, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
-	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
-	0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x49, 0x6e,
-	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f,
-	0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x12, 0x23, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x49,
-	0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x61,
-	0x64, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x19,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
-	0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x25, 0x2e, 0x70, 0x6f, 0x73, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
-	0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74,
+	0x6f, 0x22, 0xd0, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
+	0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x43, 0x61,
+	0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x32, 0xe8, 0x04, 0x0a, 0x1a, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65,
+	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x6a, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
 	0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x25, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70,
-	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65,
+	0x12, 0x25, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x64, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74,
+	0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x70, 0x6f, 0x73,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72,
+	0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x1a, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x26, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x6f,
+	0x73, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65,
 	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x1c, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50,
 	0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f,
@@ -902,11 +1612,21 @@ var file_inventory_history_proto_rawDesc = []byte{
 	0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
 	0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x49,
 	0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x6e, 0x64, 0x72, 0x65, 0x77, 0x61, 0x6c,
-	0x69, 0x66, 0x62, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d, 0x70, 0x6f, 0x73, 0x2d, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x2d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2d, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x6f, 0x63, 0x6b, 0x41, 0x73, 0x4f, 0x66, 0x12, 0x18, 0x2e, 0x70, 0x6f, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x41, 0x73, 0x4f, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x41, 0x73, 0x4f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f,
+	0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x43, 0x61,
+	0x72, 0x64, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73,
+	0x53, 0x74, 0x6f, 0x63, 0x6b, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x53, 0x74,
+	0x6f, 0x63, 0x6b, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x6e,
+	0x64, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x69, 0x66, 0x62, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d,
+	0x70, 0x6f, 0x73, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2d, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -921,52 +1641,68 @@ func file_inventory_history_proto_rawDescGZIP() []byte {
 	return file_inventory_history_proto_rawDescData
 }
 
-var file_inventory_history_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_inventory_history_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_inventory_history_proto_goTypes = []interface{}{
 	(*PosInventoryHistory)(nil),                  // 0: pos.PosInventoryHistory
 	(*CreatePosInventoryHistoryRequest)(nil),     // 1: pos.CreatePosInventoryHistoryRequest
 	(*CreatePosInventoryHistoryResponse)(nil),    // 2: pos.CreatePosInventoryHistoryResponse
 	(*ReadPosInventoryHistoryRequest)(nil),       // 3: pos.ReadPosInventoryHistoryRequest
 	(*ReadPosInventoryHistoryResponse)(nil),      // 4: pos.ReadPosInventoryHistoryResponse
-	(*UpdatePosInventoryHistoryRequest)(nil),     // 5: pos.UpdatePosInventoryHistoryRequest
-	(*UpdatePosInventoryHistoryResponse)(nil),    // 6: pos.UpdatePosInventoryHistoryResponse
-	(*DeletePosInventoryHistoryRequest)(nil),     // 7: pos.DeletePosInventoryHistoryRequest
-	(*DeletePosInventoryHistoryResponse)(nil),    // 8: pos.DeletePosInventoryHistoryResponse
-	(*ReadAllPosInventoryHistoriesRequest)(nil),  // 9: pos.ReadAllPosInventoryHistoriesRequest
-	(*ReadAllPosInventoryHistoriesResponse)(nil), // 10: pos.ReadAllPosInventoryHistoriesResponse
-	(*timestamppb.Timestamp)(nil),                // 11: google.protobuf.Timestamp
-	(*JWTPayload)(nil),                           // 12: pos.JWTPayload
+	(*ReversePosInventoryHistoryRequest)(nil),    // 5: pos.ReversePosInventoryHistoryRequest
+	(*ReversePosInventoryHistoryResponse)(nil),   // 6: pos.ReversePosInventoryHistoryResponse
+	(*ReadAllPosInventoryHistoriesRequest)(nil),  // 7: pos.ReadAllPosInventoryHistoriesRequest
+	(*ReadAllPosInventoryHistoriesResponse)(nil), // 8: pos.ReadAllPosInventoryHistoriesResponse
+	(*PosStockAsOf)(nil),                         // 9: pos.PosStockAsOf
+	(*GetStockAsOfRequest)(nil),                  // 10: pos.GetStockAsOfRequest
+	(*GetStockAsOfResponse)(nil),                 // 11: pos.GetStockAsOfResponse
+	(*PosStockCardEntry)(nil),                    // 12: pos.PosStockCardEntry
+	(*ReadPosStockCardRequest)(nil),              // 13: pos.ReadPosStockCardRequest
+	(*ReadPosStockCardResponse)(nil),             // 14: pos.ReadPosStockCardResponse
+	(*timestamppb.Timestamp)(nil),                // 15: google.protobuf.Timestamp
+	(*JWTPayload)(nil),                           // 16: pos.JWTPayload
 }
 var file_inventory_history_proto_depIdxs = []int32{
-	11, // 0: pos.PosInventoryHistory.date:type_name -> google.protobuf.Timestamp
-	11, // 1: pos.PosInventoryHistory.created_at:type_name -> google.protobuf.Timestamp
-	11, // 2: pos.PosInventoryHistory.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: pos.CreatePosInventoryHistoryRequest.pos_inventory_history:type_name -> pos.PosInventoryHistory
-	12, // 4: pos.CreatePosInventoryHistoryRequest.jwt_payload:type_name -> pos.JWTPayload
-	0,  // 5: pos.CreatePosInventoryHistoryResponse.pos_inventory_history:type_name -> pos.PosInventoryHistory
-	12, // 6: pos.ReadPosInventoryHistoryRequest.jwt_payload:type_name -> pos.JWTPayload
-	0,  // 7: pos.ReadPosInventoryHistoryResponse.pos_inventory_history:type_name -> pos.PosInventoryHistory
-	0,  // 8: pos.UpdatePosInventoryHistoryRequest.pos_inventory_history:type_name -> pos.PosInventoryHistory
-	12, // 9: pos.UpdatePosInventoryHistoryRequest.jwt_payload:type_name -> pos.JWTPayload
-	0,  // 10: pos.UpdatePosInventoryHistoryResponse.pos_inventory_history:type_name -> pos.PosInventoryHistory
-	12, // 11: pos.DeletePosInventoryHistoryRequest.jwt_payload:type_name -> pos.JWTPayload
-	12, // 12: pos.ReadAllPosInventoryHistoriesRequest.jwt_payload:type_name -> pos.JWTPayload
-	0,  // 13: pos.ReadAllPosInventoryHistoriesResponse.pos_inventory_histories:type_name -> pos.PosInventoryHistory
-	1,  // 14: pos.PosInventoryHistoryService.CreatePosInventoryHistory:input_type -> pos.CreatePosInventoryHistoryRequest
-	3,  // 15: pos.PosInventoryHistoryService.ReadPosInventoryHistory:input_type -> pos.ReadPosInventoryHistoryRequest
-	5,  // 16: pos.PosInventoryHistoryService.UpdatePosInventoryHistory:input_type -> pos.UpdatePosInventoryHistoryRequest
-	7,  // 17: pos.PosInventoryHistoryService.DeletePosInventoryHistory:input_type -> pos.DeletePosInventoryHistoryRequest
-	9,  // 18: pos.PosInventoryHistoryService.ReadAllPosInventoryHistories:input_type -> pos.ReadAllPosInventoryHistoriesRequest
-	2,  // 19: pos.PosInventoryHistoryService.CreatePosInventoryHistory:output_type -> pos.CreatePosInventoryHistoryResponse
-	4,  // 20: pos.PosInventoryHistoryService.ReadPosInventoryHistory:output_type -> pos.ReadPosInventoryHistoryResponse
-	6,  // 21: pos.PosInventoryHistoryService.UpdatePosInventoryHistory:output_type -> pos.UpdatePosInventoryHistoryResponse
-	8,  // 22: pos.PosInventoryHistoryService.DeletePosInventoryHistory:output_type -> pos.DeletePosInventoryHistoryResponse
-	10, // 23: pos.PosInventoryHistoryService.ReadAllPosInventoryHistories:output_type -> pos.ReadAllPosInventoryHistoriesResponse
-	19, // [19:24] is the sub-list for method output_type
-	14, // [14:19] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	15, // 0: pos.PosInventoryHistory.date:type_name -> google.protobuf.Timestamp
+	15, // 1: pos.PosInventoryHistory.created_at:type_name -> google.protobuf.Timestamp
+	15, // 2: pos.PosInventoryHistory.updated_at:type_name -> google.protobuf.Timestamp
+	15, // 3: pos.PosInventoryHistory.expiry_date:type_name -> google.protobuf.Timestamp
+	0,  // 4: pos.CreatePosInventoryHistoryRequest.pos_inventory_history:type_name -> pos.PosInventoryHistory
+	16, // 5: pos.CreatePosInventoryHistoryRequest.jwt_payload:type_name -> pos.JWTPayload
+	0,  // 6: pos.CreatePosInventoryHistoryResponse.pos_inventory_history:type_name -> pos.PosInventoryHistory
+	16, // 7: pos.ReadPosInventoryHistoryRequest.jwt_payload:type_name -> pos.JWTPayload
+	0,  // 8: pos.ReadPosInventoryHistoryResponse.pos_inventory_history:type_name -> pos.PosInventoryHistory
+	16, // 9: pos.ReversePosInventoryHistoryRequest.jwt_payload:type_name -> pos.JWTPayload
+	0,  // 10: pos.ReversePosInventoryHistoryResponse.pos_inventory_history:type_name -> pos.PosInventoryHistory
+	16, // 11: pos.ReadAllPosInventoryHistoriesRequest.jwt_payload:type_name -> pos.JWTPayload
+	15, // 12: pos.ReadAllPosInventoryHistoriesRequest.date_from:type_name -> google.protobuf.Timestamp
+	15, // 13: pos.ReadAllPosInventoryHistoriesRequest.date_to:type_name -> google.protobuf.Timestamp
+	0,  // 14: pos.ReadAllPosInventoryHistoriesResponse.pos_inventory_histories:type_name -> pos.PosInventoryHistory
+	16, // 15: pos.GetStockAsOfRequest.jwt_payload:type_name -> pos.JWTPayload
+	15, // 16: pos.GetStockAsOfRequest.as_of:type_name -> google.protobuf.Timestamp
+	9,  // 17: pos.GetStockAsOfResponse.stocks:type_name -> pos.PosStockAsOf
+	15, // 18: pos.GetStockAsOfResponse.as_of:type_name -> google.protobuf.Timestamp
+	0,  // 19: pos.PosStockCardEntry.pos_inventory_history:type_name -> pos.PosInventoryHistory
+	16, // 20: pos.ReadPosStockCardRequest.jwt_payload:type_name -> pos.JWTPayload
+	15, // 21: pos.ReadPosStockCardRequest.from:type_name -> google.protobuf.Timestamp
+	15, // 22: pos.ReadPosStockCardRequest.to:type_name -> google.protobuf.Timestamp
+	12, // 23: pos.ReadPosStockCardResponse.entries:type_name -> pos.PosStockCardEntry
+	1,  // 24: pos.PosInventoryHistoryService.CreatePosInventoryHistory:input_type -> pos.CreatePosInventoryHistoryRequest
+	3,  // 25: pos.PosInventoryHistoryService.ReadPosInventoryHistory:input_type -> pos.ReadPosInventoryHistoryRequest
+	5,  // 26: pos.PosInventoryHistoryService.ReversePosInventoryHistory:input_type -> pos.ReversePosInventoryHistoryRequest
+	7,  // 27: pos.PosInventoryHistoryService.ReadAllPosInventoryHistories:input_type -> pos.ReadAllPosInventoryHistoriesRequest
+	10, // 28: pos.PosInventoryHistoryService.GetStockAsOf:input_type -> pos.GetStockAsOfRequest
+	13, // 29: pos.PosInventoryHistoryService.ReadPosStockCard:input_type -> pos.ReadPosStockCardRequest
+	2,  // 30: pos.PosInventoryHistoryService.CreatePosInventoryHistory:output_type -> pos.CreatePosInventoryHistoryResponse
+	4,  // 31: pos.PosInventoryHistoryService.ReadPosInventoryHistory:output_type -> pos.ReadPosInventoryHistoryResponse
+	6,  // 32: pos.PosInventoryHistoryService.ReversePosInventoryHistory:output_type -> pos.ReversePosInventoryHistoryResponse
+	8,  // 33: pos.PosInventoryHistoryService.ReadAllPosInventoryHistories:output_type -> pos.ReadAllPosInventoryHistoriesResponse
+	11, // 34: pos.PosInventoryHistoryService.GetStockAsOf:output_type -> pos.GetStockAsOfResponse
+	14, // 35: pos.PosInventoryHistoryService.ReadPosStockCard:output_type -> pos.ReadPosStockCardResponse
+	30, // [30:36] is the sub-list for method output_type
+	24, // [24:30] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_inventory_history_proto_init() }
@@ -1037,7 +1773,7 @@ func file_inventory_history_proto_init() {
 			}
 		}
 		file_inventory_history_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePosInventoryHistoryRequest); i {
+			switch v := v.(*ReversePosInventoryHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1049,7 +1785,7 @@ func file_inventory_history_proto_init() {
 			}
 		}
 		file_inventory_history_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePosInventoryHistoryResponse); i {
+			switch v := v.(*ReversePosInventoryHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1061,7 +1797,7 @@ func file_inventory_history_proto_init() {
 			}
 		}
 		file_inventory_history_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePosInventoryHistoryRequest); i {
+			switch v := v.(*ReadAllPosInventoryHistoriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1073,7 +1809,7 @@ func file_inventory_history_proto_init() {
 			}
 		}
 		file_inventory_history_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePosInventoryHistoryResponse); i {
+			switch v := v.(*ReadAllPosInventoryHistoriesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1085,7 +1821,7 @@ func file_inventory_history_proto_init() {
 			}
 		}
 		file_inventory_history_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadAllPosInventoryHistoriesRequest); i {
+			switch v := v.(*PosStockAsOf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1097,7 +1833,55 @@ func file_inventory_history_proto_init() {
 			}
 		}
 		file_inventory_history_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadAllPosInventoryHistoriesResponse); i {
+			switch v := v.(*GetStockAsOfRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inventory_history_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStockAsOfResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inventory_history_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PosStockCardEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inventory_history_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadPosStockCardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inventory_history_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadPosStockCardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1115,7 +1899,7 @@ func file_inventory_history_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_inventory_history_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,9 +24,10 @@ const _ = grpc.SupportPackageIsVersion7
 type PosInventoryHistoryServiceClient interface {
 	CreatePosInventoryHistory(ctx context.Context, in *CreatePosInventoryHistoryRequest, opts ...grpc.CallOption) (*CreatePosInventoryHistoryResponse, error)
 	ReadPosInventoryHistory(ctx context.Context, in *ReadPosInventoryHistoryRequest, opts ...grpc.CallOption) (*ReadPosInventoryHistoryResponse, error)
-	UpdatePosInventoryHistory(ctx context.Context, in *UpdatePosInventoryHistoryRequest, opts ...grpc.CallOption) (*UpdatePosInventoryHistoryResponse, error)
-	DeletePosInventoryHistory(ctx context.Context, in *DeletePosInventoryHistoryRequest, opts ...grpc.CallOption) (*DeletePosInventoryHistoryResponse, error)
+	ReversePosInventoryHistory(ctx context.Context, in *ReversePosInventoryHistoryRequest, opts ...grpc.CallOption) (*ReversePosInventoryHistoryResponse, error)
 	ReadAllPosInventoryHistories(ctx context.Context, in *ReadAllPosInventoryHistoriesRequest, opts ...grpc.CallOption) (*ReadAllPosInventoryHistoriesResponse, error)
+	GetStockAsOf(ctx context.Context, in *GetStockAsOfRequest, opts ...grpc.CallOption) (*GetStockAsOfResponse, error)
+	ReadPosStockCard(ctx context.Context, in *ReadPosStockCardRequest, opts ...grpc.CallOption) (*ReadPosStockCardResponse, error)
 }
 
 type posInventoryHistoryServiceClient struct {
@@ -55,27 +56,36 @@ func (c *posInventoryHistoryServiceClient) ReadPosInventoryHistory(ctx context.C
 	return out, nil
 }
 
-func (c *posInventoryHistoryServiceClient) UpdatePosInventoryHistory(ctx context.Context, in *UpdatePosInventoryHistoryRequest, opts ...grpc.CallOption) (*UpdatePosInventoryHistoryResponse, error) {
-	out := new(UpdatePosInventoryHistoryResponse)
-	err := c.cc.Invoke(ctx, "/pos.PosInventoryHistoryService/UpdatePosInventoryHistory", in, out, opts...)
+func (c *posInventoryHistoryServiceClient) ReversePosInventoryHistory(ctx context.Context, in *ReversePosInventoryHistoryRequest, opts ...grpc.CallOption) (*ReversePosInventoryHistoryResponse, error) {
+	out := new(ReversePosInventoryHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosInventoryHistoryService/ReversePosInventoryHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *posInventoryHistoryServiceClient) DeletePosInventoryHistory(ctx context.Context, in *DeletePosInventoryHistoryRequest, opts ...grpc.CallOption) (*DeletePosInventoryHistoryResponse, error) {
-	out := new(DeletePosInventoryHistoryResponse)
-	err := c.cc.Invoke(ctx, "/pos.PosInventoryHistoryService/DeletePosInventoryHistory", in, out, opts...)
+func (c *posInventoryHistoryServiceClient) ReadAllPosInventoryHistories(ctx context.Context, in *ReadAllPosInventoryHistoriesRequest, opts ...grpc.CallOption) (*ReadAllPosInventoryHistoriesResponse, error) {
+	out := new(ReadAllPosInventoryHistoriesResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosInventoryHistoryService/ReadAllPosInventoryHistories", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *posInventoryHistoryServiceClient) ReadAllPosInventoryHistories(ctx context.Context, in *ReadAllPosInventoryHistoriesRequest, opts ...grpc.CallOption) (*ReadAllPosInventoryHistoriesResponse, error) {
-	out := new(ReadAllPosInventoryHistoriesResponse)
-	err := c.cc.Invoke(ctx, "/pos.PosInventoryHistoryService/ReadAllPosInventoryHistories", in, out, opts...)
+func (c *posInventoryHistoryServiceClient) GetStockAsOf(ctx context.Context, in *GetStockAsOfRequest, opts ...grpc.CallOption) (*GetStockAsOfResponse, error) {
+	out := new(GetStockAsOfResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosInventoryHistoryService/GetStockAsOf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posInventoryHistoryServiceClient) ReadPosStockCard(ctx context.Context, in *ReadPosStockCardRequest, opts ...grpc.CallOption) (*ReadPosStockCardResponse, error) {
+	out := new(ReadPosStockCardResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosInventoryHistoryService/ReadPosStockCard", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -88,9 +98,10 @@ func (c *posInventoryHistoryServiceClient) ReadAllPosInventoryHistories(ctx cont
 type PosInventoryHistoryServiceServer interface {
 	CreatePosInventoryHistory(context.Context, *CreatePosInventoryHistoryRequest) (*CreatePosInventoryHistoryResponse, error)
 	ReadPosInventoryHistory(context.Context, *ReadPosInventoryHistoryRequest) (*ReadPosInventoryHistoryResponse, error)
-	UpdatePosInventoryHistory(context.Context, *UpdatePosInventoryHistoryRequest) (*UpdatePosInventoryHistoryResponse, error)
-	DeletePosInventoryHistory(context.Context, *DeletePosInventoryHistoryRequest) (*DeletePosInventoryHistoryResponse, error)
+	ReversePosInventoryHistory(context.Context, *ReversePosInventoryHistoryRequest) (*ReversePosInventoryHistoryResponse, error)
 	ReadAllPosInventoryHistories(context.Context, *ReadAllPosInventoryHistoriesRequest) (*ReadAllPosInventoryHistoriesResponse, error)
+	GetStockAsOf(context.Context, *GetStockAsOfRequest) (*GetStockAsOfResponse, error)
+	ReadPosStockCard(context.Context, *ReadPosStockCardRequest) (*ReadPosStockCardResponse, error)
 	mustEmbedUnimplementedPosInventoryHistoryServiceServer()
 }
 
@@ -104,15 +115,18 @@ func (UnimplementedPosInventoryHistoryServiceServer) CreatePosInventoryHistory(c
 func (UnimplementedPosInventoryHistoryServiceServer) ReadPosInventoryHistory(context.Context, *ReadPosInventoryHistoryRequest) (*ReadPosInventoryHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadPosInventoryHistory not implemented")
 }
-func (UnimplementedPosInventoryHistoryServiceServer) UpdatePosInventoryHistory(context.Context, *UpdatePosInventoryHistoryRequest) (*UpdatePosInventoryHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePosInventoryHistory not implemented")
-}
-func (UnimplementedPosInventoryHistoryServiceServer) DeletePosInventoryHistory(context.Context, *DeletePosInventoryHistoryRequest) (*DeletePosInventoryHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePosInventoryHistory not implemented")
+func (UnimplementedPosInventoryHistoryServiceServer) ReversePosInventoryHistory(context.Context, *ReversePosInventoryHistoryRequest) (*ReversePosInventoryHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReversePosInventoryHistory not implemented")
 }
 func (UnimplementedPosInventoryHistoryServiceServer) ReadAllPosInventoryHistories(context.Context, *ReadAllPosInventoryHistoriesRequest) (*ReadAllPosInventoryHistoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadAllPosInventoryHistories not implemented")
 }
+func (UnimplementedPosInventoryHistoryServiceServer) GetStockAsOf(context.Context, *GetStockAsOfRequest) (*GetStockAsOfResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockAsOf not implemented")
+}
+func (UnimplementedPosInventoryHistoryServiceServer) ReadPosStockCard(context.Context, *ReadPosStockCardRequest) (*ReadPosStockCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadPosStockCard not implemented")
+}
 func (UnimplementedPosInventoryHistoryServiceServer) mustEmbedUnimplementedPosInventoryHistoryServiceServer() {
 }
 
@@ -163,56 +177,74 @@ func _PosInventoryHistoryService_ReadPosInventoryHistory_Handler(srv interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PosInventoryHistoryService_UpdatePosInventoryHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePosInventoryHistoryRequest)
+func _PosInventoryHistoryService_ReversePosInventoryHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReversePosInventoryHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PosInventoryHistoryServiceServer).UpdatePosInventoryHistory(ctx, in)
+		return srv.(PosInventoryHistoryServiceServer).ReversePosInventoryHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pos.PosInventoryHistoryService/UpdatePosInventoryHistory",
+		FullMethod: "/pos.PosInventoryHistoryService/ReversePosInventoryHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PosInventoryHistoryServiceServer).UpdatePosInventoryHistory(ctx, req.(*UpdatePosInventoryHistoryRequest))
+		return srv.(PosInventoryHistoryServiceServer).ReversePosInventoryHistory(ctx, req.(*ReversePosInventoryHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PosInventoryHistoryService_DeletePosInventoryHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePosInventoryHistoryRequest)
+func _PosInventoryHistoryService_ReadAllPosInventoryHistories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAllPosInventoryHistoriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PosInventoryHistoryServiceServer).DeletePosInventoryHistory(ctx, in)
+		return srv.(PosInventoryHistoryServiceServer).ReadAllPosInventoryHistories(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pos.PosInventoryHistoryService/DeletePosInventoryHistory",
+		FullMethod: "/pos.PosInventoryHistoryService/ReadAllPosInventoryHistories",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PosInventoryHistoryServiceServer).DeletePosInventoryHistory(ctx, req.(*DeletePosInventoryHistoryRequest))
+		return srv.(PosInventoryHistoryServiceServer).ReadAllPosInventoryHistories(ctx, req.(*ReadAllPosInventoryHistoriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PosInventoryHistoryService_ReadAllPosInventoryHistories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadAllPosInventoryHistoriesRequest)
+func _PosInventoryHistoryService_GetStockAsOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStockAsOfRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PosInventoryHistoryServiceServer).ReadAllPosInventoryHistories(ctx, in)
+		return srv.(PosInventoryHistoryServiceServer).GetStockAsOf(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pos.PosInventoryHistoryService/ReadAllPosInventoryHistories",
+		FullMethod: "/pos.PosInventoryHistoryService/GetStockAsOf",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PosInventoryHistoryServiceServer).ReadAllPosInventoryHistories(ctx, req.(*ReadAllPosInventoryHistoriesRequest))
+		return srv.(PosInventoryHistoryServiceServer).GetStockAsOf(ctx, req.(*GetStockAsOfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosInventoryHistoryService_ReadPosStockCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPosStockCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosInventoryHistoryServiceServer).ReadPosStockCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosInventoryHistoryService/ReadPosStockCard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosInventoryHistoryServiceServer).ReadPosStockCard(ctx, req.(*ReadPosStockCardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -233,17 +265,21 @@ var PosInventoryHistoryService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _PosInventoryHistoryService_ReadPosInventoryHistory_Handler,
 		},
 		{
-			MethodName: "UpdatePosInventoryHistory",
-			Handler:    _PosInventoryHistoryService_UpdatePosInventoryHistory_Handler,
-		},
-		{
-			MethodName: "DeletePosInventoryHistory",
-			Handler:    _PosInventoryHistoryService_DeletePosInventoryHistory_Handler,
+			MethodName: "ReversePosInventoryHistory",
+			Handler:    _PosInventoryHistoryService_ReversePosInventoryHistory_Handler,
 		},
 		{
 			MethodName: "ReadAllPosInventoryHistories",
 			Handler:    _PosInventoryHistoryService_ReadAllPosInventoryHistories_Handler,
 		},
+		{
+			MethodName: "GetStockAsOf",
+			Handler:    _PosInventoryHistoryService_GetStockAsOf_Handler,
+		},
+		{
+			MethodName: "ReadPosStockCard",
+			Handler:    _PosInventoryHistoryService_ReadPosStockCard_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inventory_history.proto",
@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: low_stock.proto
+
+package alpha_pos_system_product_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosLowStockServiceClient is the client API for PosLowStockService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosLowStockServiceClient interface {
+	ListLowStockProducts(ctx context.Context, in *ListLowStockProductsRequest, opts ...grpc.CallOption) (*ListLowStockProductsResponse, error)
+	AcknowledgePosLowStockAlert(ctx context.Context, in *AcknowledgePosLowStockAlertRequest, opts ...grpc.CallOption) (*AcknowledgePosLowStockAlertResponse, error)
+	ReadAllPosLowStockAlerts(ctx context.Context, in *ReadAllPosLowStockAlertsRequest, opts ...grpc.CallOption) (*ReadAllPosLowStockAlertsResponse, error)
+}
+
+type posLowStockServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosLowStockServiceClient(cc grpc.ClientConnInterface) PosLowStockServiceClient {
+	return &posLowStockServiceClient{cc}
+}
+
+func (c *posLowStockServiceClient) ListLowStockProducts(ctx context.Context, in *ListLowStockProductsRequest, opts ...grpc.CallOption) (*ListLowStockProductsResponse, error) {
+	out := new(ListLowStockProductsResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosLowStockService/ListLowStockProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posLowStockServiceClient) AcknowledgePosLowStockAlert(ctx context.Context, in *AcknowledgePosLowStockAlertRequest, opts ...grpc.CallOption) (*AcknowledgePosLowStockAlertResponse, error) {
+	out := new(AcknowledgePosLowStockAlertResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosLowStockService/AcknowledgePosLowStockAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posLowStockServiceClient) ReadAllPosLowStockAlerts(ctx context.Context, in *ReadAllPosLowStockAlertsRequest, opts ...grpc.CallOption) (*ReadAllPosLowStockAlertsResponse, error) {
+	out := new(ReadAllPosLowStockAlertsResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosLowStockService/ReadAllPosLowStockAlerts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosLowStockServiceServer is the server API for PosLowStockService service.
+// All implementations must embed UnimplementedPosLowStockServiceServer
+// for forward compatibility
+type PosLowStockServiceServer interface {
+	ListLowStockProducts(context.Context, *ListLowStockProductsRequest) (*ListLowStockProductsResponse, error)
+	AcknowledgePosLowStockAlert(context.Context, *AcknowledgePosLowStockAlertRequest) (*AcknowledgePosLowStockAlertResponse, error)
+	ReadAllPosLowStockAlerts(context.Context, *ReadAllPosLowStockAlertsRequest) (*ReadAllPosLowStockAlertsResponse, error)
+	mustEmbedUnimplementedPosLowStockServiceServer()
+}
+
+// UnimplementedPosLowStockServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosLowStockServiceServer struct {
+}
+
+func (UnimplementedPosLowStockServiceServer) ListLowStockProducts(context.Context, *ListLowStockProductsRequest) (*ListLowStockProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLowStockProducts not implemented")
+}
+func (UnimplementedPosLowStockServiceServer) AcknowledgePosLowStockAlert(context.Context, *AcknowledgePosLowStockAlertRequest) (*AcknowledgePosLowStockAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgePosLowStockAlert not implemented")
+}
+func (UnimplementedPosLowStockServiceServer) ReadAllPosLowStockAlerts(context.Context, *ReadAllPosLowStockAlertsRequest) (*ReadAllPosLowStockAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAllPosLowStockAlerts not implemented")
+}
+func (UnimplementedPosLowStockServiceServer) mustEmbedUnimplementedPosLowStockServiceServer() {}
+
+// UnsafePosLowStockServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosLowStockServiceServer will
+// result in compilation errors.
+type UnsafePosLowStockServiceServer interface {
+	mustEmbedUnimplementedPosLowStockServiceServer()
+}
+
+func RegisterPosLowStockServiceServer(s grpc.ServiceRegistrar, srv PosLowStockServiceServer) {
+	s.RegisterService(&PosLowStockService_ServiceDesc, srv)
+}
+
+func _PosLowStockService_ListLowStockProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLowStockProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosLowStockServiceServer).ListLowStockProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosLowStockService/ListLowStockProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosLowStockServiceServer).ListLowStockProducts(ctx, req.(*ListLowStockProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosLowStockService_AcknowledgePosLowStockAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgePosLowStockAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosLowStockServiceServer).AcknowledgePosLowStockAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosLowStockService/AcknowledgePosLowStockAlert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosLowStockServiceServer).AcknowledgePosLowStockAlert(ctx, req.(*AcknowledgePosLowStockAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosLowStockService_ReadAllPosLowStockAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAllPosLowStockAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosLowStockServiceServer).ReadAllPosLowStockAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosLowStockService/ReadAllPosLowStockAlerts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosLowStockServiceServer).ReadAllPosLowStockAlerts(ctx, req.(*ReadAllPosLowStockAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosLowStockService_ServiceDesc is the grpc.ServiceDesc for PosLowStockService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosLowStockService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosLowStockService",
+	HandlerType: (*PosLowStockServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListLowStockProducts",
+			Handler:    _PosLowStockService_ListLowStockProducts_Handler,
+		},
+		{
+			MethodName: "AcknowledgePosLowStockAlert",
+			Handler:    _PosLowStockService_AcknowledgePosLowStockAlert_Handler,
+		},
+		{
+			MethodName: "ReadAllPosLowStockAlerts",
+			Handler:    _PosLowStockService_ReadAllPosLowStockAlerts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "low_stock.proto",
+}
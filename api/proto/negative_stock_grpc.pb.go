@@ -0,0 +1,286 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: negative_stock.proto
+
+package alpha_pos_system_product_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosNegativeStockServiceClient is the client API for PosNegativeStockService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosNegativeStockServiceClient interface {
+	ReadPosNegativeStockSettings(ctx context.Context, in *ReadPosNegativeStockSettingsRequest, opts ...grpc.CallOption) (*ReadPosNegativeStockSettingsResponse, error)
+	ResolvePosNegativeStockPolicy(ctx context.Context, in *ResolvePosNegativeStockPolicyRequest, opts ...grpc.CallOption) (*ResolvePosNegativeStockPolicyResponse, error)
+	UpdatePosNegativeStockSetting(ctx context.Context, in *UpdatePosNegativeStockSettingRequest, opts ...grpc.CallOption) (*UpdatePosNegativeStockSettingResponse, error)
+	DeletePosNegativeStockSetting(ctx context.Context, in *DeletePosNegativeStockSettingRequest, opts ...grpc.CallOption) (*DeletePosNegativeStockSettingResponse, error)
+	ReadAllPosNegativeStockViolations(ctx context.Context, in *ReadAllPosNegativeStockViolationsRequest, opts ...grpc.CallOption) (*ReadAllPosNegativeStockViolationsResponse, error)
+	ReviewPosNegativeStockViolation(ctx context.Context, in *ReviewPosNegativeStockViolationRequest, opts ...grpc.CallOption) (*ReviewPosNegativeStockViolationResponse, error)
+}
+
+type posNegativeStockServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosNegativeStockServiceClient(cc grpc.ClientConnInterface) PosNegativeStockServiceClient {
+	return &posNegativeStockServiceClient{cc}
+}
+
+func (c *posNegativeStockServiceClient) ReadPosNegativeStockSettings(ctx context.Context, in *ReadPosNegativeStockSettingsRequest, opts ...grpc.CallOption) (*ReadPosNegativeStockSettingsResponse, error) {
+	out := new(ReadPosNegativeStockSettingsResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosNegativeStockService/ReadPosNegativeStockSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posNegativeStockServiceClient) ResolvePosNegativeStockPolicy(ctx context.Context, in *ResolvePosNegativeStockPolicyRequest, opts ...grpc.CallOption) (*ResolvePosNegativeStockPolicyResponse, error) {
+	out := new(ResolvePosNegativeStockPolicyResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosNegativeStockService/ResolvePosNegativeStockPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posNegativeStockServiceClient) UpdatePosNegativeStockSetting(ctx context.Context, in *UpdatePosNegativeStockSettingRequest, opts ...grpc.CallOption) (*UpdatePosNegativeStockSettingResponse, error) {
+	out := new(UpdatePosNegativeStockSettingResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosNegativeStockService/UpdatePosNegativeStockSetting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posNegativeStockServiceClient) DeletePosNegativeStockSetting(ctx context.Context, in *DeletePosNegativeStockSettingRequest, opts ...grpc.CallOption) (*DeletePosNegativeStockSettingResponse, error) {
+	out := new(DeletePosNegativeStockSettingResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosNegativeStockService/DeletePosNegativeStockSetting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posNegativeStockServiceClient) ReadAllPosNegativeStockViolations(ctx context.Context, in *ReadAllPosNegativeStockViolationsRequest, opts ...grpc.CallOption) (*ReadAllPosNegativeStockViolationsResponse, error) {
+	out := new(ReadAllPosNegativeStockViolationsResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosNegativeStockService/ReadAllPosNegativeStockViolations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posNegativeStockServiceClient) ReviewPosNegativeStockViolation(ctx context.Context, in *ReviewPosNegativeStockViolationRequest, opts ...grpc.CallOption) (*ReviewPosNegativeStockViolationResponse, error) {
+	out := new(ReviewPosNegativeStockViolationResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosNegativeStockService/ReviewPosNegativeStockViolation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosNegativeStockServiceServer is the server API for PosNegativeStockService service.
+// All implementations must embed UnimplementedPosNegativeStockServiceServer
+// for forward compatibility
+type PosNegativeStockServiceServer interface {
+	ReadPosNegativeStockSettings(context.Context, *ReadPosNegativeStockSettingsRequest) (*ReadPosNegativeStockSettingsResponse, error)
+	ResolvePosNegativeStockPolicy(context.Context, *ResolvePosNegativeStockPolicyRequest) (*ResolvePosNegativeStockPolicyResponse, error)
+	UpdatePosNegativeStockSetting(context.Context, *UpdatePosNegativeStockSettingRequest) (*UpdatePosNegativeStockSettingResponse, error)
+	DeletePosNegativeStockSetting(context.Context, *DeletePosNegativeStockSettingRequest) (*DeletePosNegativeStockSettingResponse, error)
+	ReadAllPosNegativeStockViolations(context.Context, *ReadAllPosNegativeStockViolationsRequest) (*ReadAllPosNegativeStockViolationsResponse, error)
+	ReviewPosNegativeStockViolation(context.Context, *ReviewPosNegativeStockViolationRequest) (*ReviewPosNegativeStockViolationResponse, error)
+	mustEmbedUnimplementedPosNegativeStockServiceServer()
+}
+
+// UnimplementedPosNegativeStockServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosNegativeStockServiceServer struct {
+}
+
+func (UnimplementedPosNegativeStockServiceServer) ReadPosNegativeStockSettings(context.Context, *ReadPosNegativeStockSettingsRequest) (*ReadPosNegativeStockSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadPosNegativeStockSettings not implemented")
+}
+func (UnimplementedPosNegativeStockServiceServer) ResolvePosNegativeStockPolicy(context.Context, *ResolvePosNegativeStockPolicyRequest) (*ResolvePosNegativeStockPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolvePosNegativeStockPolicy not implemented")
+}
+func (UnimplementedPosNegativeStockServiceServer) UpdatePosNegativeStockSetting(context.Context, *UpdatePosNegativeStockSettingRequest) (*UpdatePosNegativeStockSettingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePosNegativeStockSetting not implemented")
+}
+func (UnimplementedPosNegativeStockServiceServer) DeletePosNegativeStockSetting(context.Context, *DeletePosNegativeStockSettingRequest) (*DeletePosNegativeStockSettingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePosNegativeStockSetting not implemented")
+}
+func (UnimplementedPosNegativeStockServiceServer) ReadAllPosNegativeStockViolations(context.Context, *ReadAllPosNegativeStockViolationsRequest) (*ReadAllPosNegativeStockViolationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAllPosNegativeStockViolations not implemented")
+}
+func (UnimplementedPosNegativeStockServiceServer) ReviewPosNegativeStockViolation(context.Context, *ReviewPosNegativeStockViolationRequest) (*ReviewPosNegativeStockViolationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewPosNegativeStockViolation not implemented")
+}
+func (UnimplementedPosNegativeStockServiceServer) mustEmbedUnimplementedPosNegativeStockServiceServer() {
+}
+
+// UnsafePosNegativeStockServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosNegativeStockServiceServer will
+// result in compilation errors.
+type UnsafePosNegativeStockServiceServer interface {
+	mustEmbedUnimplementedPosNegativeStockServiceServer()
+}
+
+func RegisterPosNegativeStockServiceServer(s grpc.ServiceRegistrar, srv PosNegativeStockServiceServer) {
+	s.RegisterService(&PosNegativeStockService_ServiceDesc, srv)
+}
+
+func _PosNegativeStockService_ReadPosNegativeStockSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPosNegativeStockSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosNegativeStockServiceServer).ReadPosNegativeStockSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosNegativeStockService/ReadPosNegativeStockSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosNegativeStockServiceServer).ReadPosNegativeStockSettings(ctx, req.(*ReadPosNegativeStockSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosNegativeStockService_ResolvePosNegativeStockPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolvePosNegativeStockPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosNegativeStockServiceServer).ResolvePosNegativeStockPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosNegativeStockService/ResolvePosNegativeStockPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosNegativeStockServiceServer).ResolvePosNegativeStockPolicy(ctx, req.(*ResolvePosNegativeStockPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosNegativeStockService_UpdatePosNegativeStockSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePosNegativeStockSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosNegativeStockServiceServer).UpdatePosNegativeStockSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosNegativeStockService/UpdatePosNegativeStockSetting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosNegativeStockServiceServer).UpdatePosNegativeStockSetting(ctx, req.(*UpdatePosNegativeStockSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosNegativeStockService_DeletePosNegativeStockSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePosNegativeStockSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosNegativeStockServiceServer).DeletePosNegativeStockSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosNegativeStockService/DeletePosNegativeStockSetting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosNegativeStockServiceServer).DeletePosNegativeStockSetting(ctx, req.(*DeletePosNegativeStockSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosNegativeStockService_ReadAllPosNegativeStockViolations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAllPosNegativeStockViolationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosNegativeStockServiceServer).ReadAllPosNegativeStockViolations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosNegativeStockService/ReadAllPosNegativeStockViolations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosNegativeStockServiceServer).ReadAllPosNegativeStockViolations(ctx, req.(*ReadAllPosNegativeStockViolationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosNegativeStockService_ReviewPosNegativeStockViolation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewPosNegativeStockViolationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosNegativeStockServiceServer).ReviewPosNegativeStockViolation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosNegativeStockService/ReviewPosNegativeStockViolation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosNegativeStockServiceServer).ReviewPosNegativeStockViolation(ctx, req.(*ReviewPosNegativeStockViolationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosNegativeStockService_ServiceDesc is the grpc.ServiceDesc for PosNegativeStockService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosNegativeStockService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosNegativeStockService",
+	HandlerType: (*PosNegativeStockServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReadPosNegativeStockSettings",
+			Handler:    _PosNegativeStockService_ReadPosNegativeStockSettings_Handler,
+		},
+		{
+			MethodName: "ResolvePosNegativeStockPolicy",
+			Handler:    _PosNegativeStockService_ResolvePosNegativeStockPolicy_Handler,
+		},
+		{
+			MethodName: "UpdatePosNegativeStockSetting",
+			Handler:    _PosNegativeStockService_UpdatePosNegativeStockSetting_Handler,
+		},
+		{
+			MethodName: "DeletePosNegativeStockSetting",
+			Handler:    _PosNegativeStockService_DeletePosNegativeStockSetting_Handler,
+		},
+		{
+			MethodName: "ReadAllPosNegativeStockViolations",
+			Handler:    _PosNegativeStockService_ReadAllPosNegativeStockViolations_Handler,
+		},
+		{
+			MethodName: "ReviewPosNegativeStockViolation",
+			Handler:    _PosNegativeStockService_ReviewPosNegativeStockViolation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "negative_stock.proto",
+}
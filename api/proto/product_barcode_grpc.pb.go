@@ -0,0 +1,214 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: product_barcode.proto
+
+package alpha_pos_system_product_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosProductBarcodeServiceClient is the client API for PosProductBarcodeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosProductBarcodeServiceClient interface {
+	CreatePosProductBarcode(ctx context.Context, in *CreatePosProductBarcodeRequest, opts ...grpc.CallOption) (*CreatePosProductBarcodeResponse, error)
+	DeletePosProductBarcode(ctx context.Context, in *DeletePosProductBarcodeRequest, opts ...grpc.CallOption) (*DeletePosProductBarcodeResponse, error)
+	ReadAllPosProductBarcodes(ctx context.Context, in *ReadAllPosProductBarcodesRequest, opts ...grpc.CallOption) (*ReadAllPosProductBarcodesResponse, error)
+	GenerateInternalBarcode(ctx context.Context, in *GenerateInternalBarcodeRequest, opts ...grpc.CallOption) (*GenerateInternalBarcodeResponse, error)
+}
+
+type posProductBarcodeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosProductBarcodeServiceClient(cc grpc.ClientConnInterface) PosProductBarcodeServiceClient {
+	return &posProductBarcodeServiceClient{cc}
+}
+
+func (c *posProductBarcodeServiceClient) CreatePosProductBarcode(ctx context.Context, in *CreatePosProductBarcodeRequest, opts ...grpc.CallOption) (*CreatePosProductBarcodeResponse, error) {
+	out := new(CreatePosProductBarcodeResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosProductBarcodeService/CreatePosProductBarcode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posProductBarcodeServiceClient) DeletePosProductBarcode(ctx context.Context, in *DeletePosProductBarcodeRequest, opts ...grpc.CallOption) (*DeletePosProductBarcodeResponse, error) {
+	out := new(DeletePosProductBarcodeResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosProductBarcodeService/DeletePosProductBarcode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posProductBarcodeServiceClient) ReadAllPosProductBarcodes(ctx context.Context, in *ReadAllPosProductBarcodesRequest, opts ...grpc.CallOption) (*ReadAllPosProductBarcodesResponse, error) {
+	out := new(ReadAllPosProductBarcodesResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosProductBarcodeService/ReadAllPosProductBarcodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posProductBarcodeServiceClient) GenerateInternalBarcode(ctx context.Context, in *GenerateInternalBarcodeRequest, opts ...grpc.CallOption) (*GenerateInternalBarcodeResponse, error) {
+	out := new(GenerateInternalBarcodeResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosProductBarcodeService/GenerateInternalBarcode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosProductBarcodeServiceServer is the server API for PosProductBarcodeService service.
+// All implementations must embed UnimplementedPosProductBarcodeServiceServer
+// for forward compatibility
+type PosProductBarcodeServiceServer interface {
+	CreatePosProductBarcode(context.Context, *CreatePosProductBarcodeRequest) (*CreatePosProductBarcodeResponse, error)
+	DeletePosProductBarcode(context.Context, *DeletePosProductBarcodeRequest) (*DeletePosProductBarcodeResponse, error)
+	ReadAllPosProductBarcodes(context.Context, *ReadAllPosProductBarcodesRequest) (*ReadAllPosProductBarcodesResponse, error)
+	GenerateInternalBarcode(context.Context, *GenerateInternalBarcodeRequest) (*GenerateInternalBarcodeResponse, error)
+	mustEmbedUnimplementedPosProductBarcodeServiceServer()
+}
+
+// UnimplementedPosProductBarcodeServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosProductBarcodeServiceServer struct {
+}
+
+func (UnimplementedPosProductBarcodeServiceServer) CreatePosProductBarcode(context.Context, *CreatePosProductBarcodeRequest) (*CreatePosProductBarcodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePosProductBarcode not implemented")
+}
+func (UnimplementedPosProductBarcodeServiceServer) DeletePosProductBarcode(context.Context, *DeletePosProductBarcodeRequest) (*DeletePosProductBarcodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePosProductBarcode not implemented")
+}
+func (UnimplementedPosProductBarcodeServiceServer) ReadAllPosProductBarcodes(context.Context, *ReadAllPosProductBarcodesRequest) (*ReadAllPosProductBarcodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAllPosProductBarcodes not implemented")
+}
+func (UnimplementedPosProductBarcodeServiceServer) GenerateInternalBarcode(context.Context, *GenerateInternalBarcodeRequest) (*GenerateInternalBarcodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateInternalBarcode not implemented")
+}
+func (UnimplementedPosProductBarcodeServiceServer) mustEmbedUnimplementedPosProductBarcodeServiceServer() {
+}
+
+// UnsafePosProductBarcodeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosProductBarcodeServiceServer will
+// result in compilation errors.
+type UnsafePosProductBarcodeServiceServer interface {
+	mustEmbedUnimplementedPosProductBarcodeServiceServer()
+}
+
+func RegisterPosProductBarcodeServiceServer(s grpc.ServiceRegistrar, srv PosProductBarcodeServiceServer) {
+	s.RegisterService(&PosProductBarcodeService_ServiceDesc, srv)
+}
+
+func _PosProductBarcodeService_CreatePosProductBarcode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePosProductBarcodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosProductBarcodeServiceServer).CreatePosProductBarcode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosProductBarcodeService/CreatePosProductBarcode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosProductBarcodeServiceServer).CreatePosProductBarcode(ctx, req.(*CreatePosProductBarcodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosProductBarcodeService_DeletePosProductBarcode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePosProductBarcodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosProductBarcodeServiceServer).DeletePosProductBarcode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosProductBarcodeService/DeletePosProductBarcode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosProductBarcodeServiceServer).DeletePosProductBarcode(ctx, req.(*DeletePosProductBarcodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosProductBarcodeService_ReadAllPosProductBarcodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAllPosProductBarcodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosProductBarcodeServiceServer).ReadAllPosProductBarcodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosProductBarcodeService/ReadAllPosProductBarcodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosProductBarcodeServiceServer).ReadAllPosProductBarcodes(ctx, req.(*ReadAllPosProductBarcodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosProductBarcodeService_GenerateInternalBarcode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateInternalBarcodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosProductBarcodeServiceServer).GenerateInternalBarcode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosProductBarcodeService/GenerateInternalBarcode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosProductBarcodeServiceServer).GenerateInternalBarcode(ctx, req.(*GenerateInternalBarcodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosProductBarcodeService_ServiceDesc is the grpc.ServiceDesc for PosProductBarcodeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosProductBarcodeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosProductBarcodeService",
+	HandlerType: (*PosProductBarcodeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePosProductBarcode",
+			Handler:    _PosProductBarcodeService_CreatePosProductBarcode_Handler,
+		},
+		{
+			MethodName: "DeletePosProductBarcode",
+			Handler:    _PosProductBarcodeService_DeletePosProductBarcode_Handler,
+		},
+		{
+			MethodName: "ReadAllPosProductBarcodes",
+			Handler:    _PosProductBarcodeService_ReadAllPosProductBarcodes_Handler,
+		},
+		{
+			MethodName: "GenerateInternalBarcode",
+			Handler:    _PosProductBarcodeService_GenerateInternalBarcode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "product_barcode.proto",
+}
@@ -0,0 +1,213 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: product_unit.proto
+
+package alpha_pos_system_product_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosProductUnitServiceClient is the client API for PosProductUnitService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosProductUnitServiceClient interface {
+	CreatePosProductUnit(ctx context.Context, in *CreatePosProductUnitRequest, opts ...grpc.CallOption) (*CreatePosProductUnitResponse, error)
+	UpdatePosProductUnit(ctx context.Context, in *UpdatePosProductUnitRequest, opts ...grpc.CallOption) (*UpdatePosProductUnitResponse, error)
+	DeletePosProductUnit(ctx context.Context, in *DeletePosProductUnitRequest, opts ...grpc.CallOption) (*DeletePosProductUnitResponse, error)
+	ReadAllPosProductUnits(ctx context.Context, in *ReadAllPosProductUnitsRequest, opts ...grpc.CallOption) (*ReadAllPosProductUnitsResponse, error)
+}
+
+type posProductUnitServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosProductUnitServiceClient(cc grpc.ClientConnInterface) PosProductUnitServiceClient {
+	return &posProductUnitServiceClient{cc}
+}
+
+func (c *posProductUnitServiceClient) CreatePosProductUnit(ctx context.Context, in *CreatePosProductUnitRequest, opts ...grpc.CallOption) (*CreatePosProductUnitResponse, error) {
+	out := new(CreatePosProductUnitResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosProductUnitService/CreatePosProductUnit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posProductUnitServiceClient) UpdatePosProductUnit(ctx context.Context, in *UpdatePosProductUnitRequest, opts ...grpc.CallOption) (*UpdatePosProductUnitResponse, error) {
+	out := new(UpdatePosProductUnitResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosProductUnitService/UpdatePosProductUnit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posProductUnitServiceClient) DeletePosProductUnit(ctx context.Context, in *DeletePosProductUnitRequest, opts ...grpc.CallOption) (*DeletePosProductUnitResponse, error) {
+	out := new(DeletePosProductUnitResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosProductUnitService/DeletePosProductUnit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posProductUnitServiceClient) ReadAllPosProductUnits(ctx context.Context, in *ReadAllPosProductUnitsRequest, opts ...grpc.CallOption) (*ReadAllPosProductUnitsResponse, error) {
+	out := new(ReadAllPosProductUnitsResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosProductUnitService/ReadAllPosProductUnits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosProductUnitServiceServer is the server API for PosProductUnitService service.
+// All implementations must embed UnimplementedPosProductUnitServiceServer
+// for forward compatibility
+type PosProductUnitServiceServer interface {
+	CreatePosProductUnit(context.Context, *CreatePosProductUnitRequest) (*CreatePosProductUnitResponse, error)
+	UpdatePosProductUnit(context.Context, *UpdatePosProductUnitRequest) (*UpdatePosProductUnitResponse, error)
+	DeletePosProductUnit(context.Context, *DeletePosProductUnitRequest) (*DeletePosProductUnitResponse, error)
+	ReadAllPosProductUnits(context.Context, *ReadAllPosProductUnitsRequest) (*ReadAllPosProductUnitsResponse, error)
+	mustEmbedUnimplementedPosProductUnitServiceServer()
+}
+
+// UnimplementedPosProductUnitServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosProductUnitServiceServer struct {
+}
+
+func (UnimplementedPosProductUnitServiceServer) CreatePosProductUnit(context.Context, *CreatePosProductUnitRequest) (*CreatePosProductUnitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePosProductUnit not implemented")
+}
+func (UnimplementedPosProductUnitServiceServer) UpdatePosProductUnit(context.Context, *UpdatePosProductUnitRequest) (*UpdatePosProductUnitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePosProductUnit not implemented")
+}
+func (UnimplementedPosProductUnitServiceServer) DeletePosProductUnit(context.Context, *DeletePosProductUnitRequest) (*DeletePosProductUnitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePosProductUnit not implemented")
+}
+func (UnimplementedPosProductUnitServiceServer) ReadAllPosProductUnits(context.Context, *ReadAllPosProductUnitsRequest) (*ReadAllPosProductUnitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAllPosProductUnits not implemented")
+}
+func (UnimplementedPosProductUnitServiceServer) mustEmbedUnimplementedPosProductUnitServiceServer() {}
+
+// UnsafePosProductUnitServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosProductUnitServiceServer will
+// result in compilation errors.
+type UnsafePosProductUnitServiceServer interface {
+	mustEmbedUnimplementedPosProductUnitServiceServer()
+}
+
+func RegisterPosProductUnitServiceServer(s grpc.ServiceRegistrar, srv PosProductUnitServiceServer) {
+	s.RegisterService(&PosProductUnitService_ServiceDesc, srv)
+}
+
+func _PosProductUnitService_CreatePosProductUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePosProductUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosProductUnitServiceServer).CreatePosProductUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosProductUnitService/CreatePosProductUnit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosProductUnitServiceServer).CreatePosProductUnit(ctx, req.(*CreatePosProductUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosProductUnitService_UpdatePosProductUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePosProductUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosProductUnitServiceServer).UpdatePosProductUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosProductUnitService/UpdatePosProductUnit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosProductUnitServiceServer).UpdatePosProductUnit(ctx, req.(*UpdatePosProductUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosProductUnitService_DeletePosProductUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePosProductUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosProductUnitServiceServer).DeletePosProductUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosProductUnitService/DeletePosProductUnit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosProductUnitServiceServer).DeletePosProductUnit(ctx, req.(*DeletePosProductUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosProductUnitService_ReadAllPosProductUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAllPosProductUnitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosProductUnitServiceServer).ReadAllPosProductUnits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosProductUnitService/ReadAllPosProductUnits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosProductUnitServiceServer).ReadAllPosProductUnits(ctx, req.(*ReadAllPosProductUnitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosProductUnitService_ServiceDesc is the grpc.ServiceDesc for PosProductUnitService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosProductUnitService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosProductUnitService",
+	HandlerType: (*PosProductUnitServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePosProductUnit",
+			Handler:    _PosProductUnitService_CreatePosProductUnit_Handler,
+		},
+		{
+			MethodName: "UpdatePosProductUnit",
+			Handler:    _PosProductUnitService_UpdatePosProductUnit_Handler,
+		},
+		{
+			MethodName: "DeletePosProductUnit",
+			Handler:    _PosProductUnitService_DeletePosProductUnit_Handler,
+		},
+		{
+			MethodName: "ReadAllPosProductUnits",
+			Handler:    _PosProductUnitService_ReadAllPosProductUnits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "product_unit.proto",
+}
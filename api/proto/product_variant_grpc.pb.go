@@ -0,0 +1,142 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: product_variant.proto
+
+package alpha_pos_system_product_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosProductVariantServiceClient is the client API for PosProductVariantService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosProductVariantServiceClient interface {
+	SetPosProductOptions(ctx context.Context, in *SetPosProductOptionsRequest, opts ...grpc.CallOption) (*SetPosProductOptionsResponse, error)
+	CreatePosProductVariant(ctx context.Context, in *CreatePosProductVariantRequest, opts ...grpc.CallOption) (*CreatePosProductVariantResponse, error)
+}
+
+type posProductVariantServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosProductVariantServiceClient(cc grpc.ClientConnInterface) PosProductVariantServiceClient {
+	return &posProductVariantServiceClient{cc}
+}
+
+func (c *posProductVariantServiceClient) SetPosProductOptions(ctx context.Context, in *SetPosProductOptionsRequest, opts ...grpc.CallOption) (*SetPosProductOptionsResponse, error) {
+	out := new(SetPosProductOptionsResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosProductVariantService/SetPosProductOptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posProductVariantServiceClient) CreatePosProductVariant(ctx context.Context, in *CreatePosProductVariantRequest, opts ...grpc.CallOption) (*CreatePosProductVariantResponse, error) {
+	out := new(CreatePosProductVariantResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosProductVariantService/CreatePosProductVariant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosProductVariantServiceServer is the server API for PosProductVariantService service.
+// All implementations must embed UnimplementedPosProductVariantServiceServer
+// for forward compatibility
+type PosProductVariantServiceServer interface {
+	SetPosProductOptions(context.Context, *SetPosProductOptionsRequest) (*SetPosProductOptionsResponse, error)
+	CreatePosProductVariant(context.Context, *CreatePosProductVariantRequest) (*CreatePosProductVariantResponse, error)
+	mustEmbedUnimplementedPosProductVariantServiceServer()
+}
+
+// UnimplementedPosProductVariantServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosProductVariantServiceServer struct {
+}
+
+func (UnimplementedPosProductVariantServiceServer) SetPosProductOptions(context.Context, *SetPosProductOptionsRequest) (*SetPosProductOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPosProductOptions not implemented")
+}
+func (UnimplementedPosProductVariantServiceServer) CreatePosProductVariant(context.Context, *CreatePosProductVariantRequest) (*CreatePosProductVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePosProductVariant not implemented")
+}
+func (UnimplementedPosProductVariantServiceServer) mustEmbedUnimplementedPosProductVariantServiceServer() {
+}
+
+// UnsafePosProductVariantServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosProductVariantServiceServer will
+// result in compilation errors.
+type UnsafePosProductVariantServiceServer interface {
+	mustEmbedUnimplementedPosProductVariantServiceServer()
+}
+
+func RegisterPosProductVariantServiceServer(s grpc.ServiceRegistrar, srv PosProductVariantServiceServer) {
+	s.RegisterService(&PosProductVariantService_ServiceDesc, srv)
+}
+
+func _PosProductVariantService_SetPosProductOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPosProductOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosProductVariantServiceServer).SetPosProductOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosProductVariantService/SetPosProductOptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosProductVariantServiceServer).SetPosProductOptions(ctx, req.(*SetPosProductOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosProductVariantService_CreatePosProductVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePosProductVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosProductVariantServiceServer).CreatePosProductVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosProductVariantService/CreatePosProductVariant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosProductVariantServiceServer).CreatePosProductVariant(ctx, req.(*CreatePosProductVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosProductVariantService_ServiceDesc is the grpc.ServiceDesc for PosProductVariantService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosProductVariantService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosProductVariantService",
+	HandlerType: (*PosProductVariantServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetPosProductOptions",
+			Handler:    _PosProductVariantService_SetPosProductOptions_Handler,
+		},
+		{
+			MethodName: "CreatePosProductVariant",
+			Handler:    _PosProductVariantService_CreatePosProductVariant_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "product_variant.proto",
+}
@@ -0,0 +1,358 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: purchase_order.proto
+
+package alpha_pos_system_product_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosPurchaseOrderServiceClient is the client API for PosPurchaseOrderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosPurchaseOrderServiceClient interface {
+	CreatePosPurchaseOrder(ctx context.Context, in *CreatePosPurchaseOrderRequest, opts ...grpc.CallOption) (*CreatePosPurchaseOrderResponse, error)
+	ReadPosPurchaseOrder(ctx context.Context, in *ReadPosPurchaseOrderRequest, opts ...grpc.CallOption) (*ReadPosPurchaseOrderResponse, error)
+	UpdatePosPurchaseOrder(ctx context.Context, in *UpdatePosPurchaseOrderRequest, opts ...grpc.CallOption) (*UpdatePosPurchaseOrderResponse, error)
+	SubmitPosPurchaseOrder(ctx context.Context, in *SubmitPosPurchaseOrderRequest, opts ...grpc.CallOption) (*SubmitPosPurchaseOrderResponse, error)
+	ReceivePosPurchaseOrder(ctx context.Context, in *ReceivePosPurchaseOrderRequest, opts ...grpc.CallOption) (*ReceivePosPurchaseOrderResponse, error)
+	CancelPosPurchaseOrder(ctx context.Context, in *CancelPosPurchaseOrderRequest, opts ...grpc.CallOption) (*CancelPosPurchaseOrderResponse, error)
+	ReadAllPosPurchaseOrders(ctx context.Context, in *ReadAllPosPurchaseOrdersRequest, opts ...grpc.CallOption) (*ReadAllPosPurchaseOrdersResponse, error)
+	GenerateReplenishmentPurchaseOrders(ctx context.Context, in *GenerateReplenishmentPurchaseOrdersRequest, opts ...grpc.CallOption) (*GenerateReplenishmentPurchaseOrdersResponse, error)
+}
+
+type posPurchaseOrderServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosPurchaseOrderServiceClient(cc grpc.ClientConnInterface) PosPurchaseOrderServiceClient {
+	return &posPurchaseOrderServiceClient{cc}
+}
+
+func (c *posPurchaseOrderServiceClient) CreatePosPurchaseOrder(ctx context.Context, in *CreatePosPurchaseOrderRequest, opts ...grpc.CallOption) (*CreatePosPurchaseOrderResponse, error) {
+	out := new(CreatePosPurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosPurchaseOrderService/CreatePosPurchaseOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posPurchaseOrderServiceClient) ReadPosPurchaseOrder(ctx context.Context, in *ReadPosPurchaseOrderRequest, opts ...grpc.CallOption) (*ReadPosPurchaseOrderResponse, error) {
+	out := new(ReadPosPurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosPurchaseOrderService/ReadPosPurchaseOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posPurchaseOrderServiceClient) UpdatePosPurchaseOrder(ctx context.Context, in *UpdatePosPurchaseOrderRequest, opts ...grpc.CallOption) (*UpdatePosPurchaseOrderResponse, error) {
+	out := new(UpdatePosPurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosPurchaseOrderService/UpdatePosPurchaseOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posPurchaseOrderServiceClient) SubmitPosPurchaseOrder(ctx context.Context, in *SubmitPosPurchaseOrderRequest, opts ...grpc.CallOption) (*SubmitPosPurchaseOrderResponse, error) {
+	out := new(SubmitPosPurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosPurchaseOrderService/SubmitPosPurchaseOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posPurchaseOrderServiceClient) ReceivePosPurchaseOrder(ctx context.Context, in *ReceivePosPurchaseOrderRequest, opts ...grpc.CallOption) (*ReceivePosPurchaseOrderResponse, error) {
+	out := new(ReceivePosPurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosPurchaseOrderService/ReceivePosPurchaseOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posPurchaseOrderServiceClient) CancelPosPurchaseOrder(ctx context.Context, in *CancelPosPurchaseOrderRequest, opts ...grpc.CallOption) (*CancelPosPurchaseOrderResponse, error) {
+	out := new(CancelPosPurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosPurchaseOrderService/CancelPosPurchaseOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posPurchaseOrderServiceClient) ReadAllPosPurchaseOrders(ctx context.Context, in *ReadAllPosPurchaseOrdersRequest, opts ...grpc.CallOption) (*ReadAllPosPurchaseOrdersResponse, error) {
+	out := new(ReadAllPosPurchaseOrdersResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosPurchaseOrderService/ReadAllPosPurchaseOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posPurchaseOrderServiceClient) GenerateReplenishmentPurchaseOrders(ctx context.Context, in *GenerateReplenishmentPurchaseOrdersRequest, opts ...grpc.CallOption) (*GenerateReplenishmentPurchaseOrdersResponse, error) {
+	out := new(GenerateReplenishmentPurchaseOrdersResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosPurchaseOrderService/GenerateReplenishmentPurchaseOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosPurchaseOrderServiceServer is the server API for PosPurchaseOrderService service.
+// All implementations must embed UnimplementedPosPurchaseOrderServiceServer
+// for forward compatibility
+type PosPurchaseOrderServiceServer interface {
+	CreatePosPurchaseOrder(context.Context, *CreatePosPurchaseOrderRequest) (*CreatePosPurchaseOrderResponse, error)
+	ReadPosPurchaseOrder(context.Context, *ReadPosPurchaseOrderRequest) (*ReadPosPurchaseOrderResponse, error)
+	UpdatePosPurchaseOrder(context.Context, *UpdatePosPurchaseOrderRequest) (*UpdatePosPurchaseOrderResponse, error)
+	SubmitPosPurchaseOrder(context.Context, *SubmitPosPurchaseOrderRequest) (*SubmitPosPurchaseOrderResponse, error)
+	ReceivePosPurchaseOrder(context.Context, *ReceivePosPurchaseOrderRequest) (*ReceivePosPurchaseOrderResponse, error)
+	CancelPosPurchaseOrder(context.Context, *CancelPosPurchaseOrderRequest) (*CancelPosPurchaseOrderResponse, error)
+	ReadAllPosPurchaseOrders(context.Context, *ReadAllPosPurchaseOrdersRequest) (*ReadAllPosPurchaseOrdersResponse, error)
+	GenerateReplenishmentPurchaseOrders(context.Context, *GenerateReplenishmentPurchaseOrdersRequest) (*GenerateReplenishmentPurchaseOrdersResponse, error)
+	mustEmbedUnimplementedPosPurchaseOrderServiceServer()
+}
+
+// UnimplementedPosPurchaseOrderServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosPurchaseOrderServiceServer struct {
+}
+
+func (UnimplementedPosPurchaseOrderServiceServer) CreatePosPurchaseOrder(context.Context, *CreatePosPurchaseOrderRequest) (*CreatePosPurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePosPurchaseOrder not implemented")
+}
+func (UnimplementedPosPurchaseOrderServiceServer) ReadPosPurchaseOrder(context.Context, *ReadPosPurchaseOrderRequest) (*ReadPosPurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadPosPurchaseOrder not implemented")
+}
+func (UnimplementedPosPurchaseOrderServiceServer) UpdatePosPurchaseOrder(context.Context, *UpdatePosPurchaseOrderRequest) (*UpdatePosPurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePosPurchaseOrder not implemented")
+}
+func (UnimplementedPosPurchaseOrderServiceServer) SubmitPosPurchaseOrder(context.Context, *SubmitPosPurchaseOrderRequest) (*SubmitPosPurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPosPurchaseOrder not implemented")
+}
+func (UnimplementedPosPurchaseOrderServiceServer) ReceivePosPurchaseOrder(context.Context, *ReceivePosPurchaseOrderRequest) (*ReceivePosPurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceivePosPurchaseOrder not implemented")
+}
+func (UnimplementedPosPurchaseOrderServiceServer) CancelPosPurchaseOrder(context.Context, *CancelPosPurchaseOrderRequest) (*CancelPosPurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPosPurchaseOrder not implemented")
+}
+func (UnimplementedPosPurchaseOrderServiceServer) ReadAllPosPurchaseOrders(context.Context, *ReadAllPosPurchaseOrdersRequest) (*ReadAllPosPurchaseOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAllPosPurchaseOrders not implemented")
+}
+func (UnimplementedPosPurchaseOrderServiceServer) GenerateReplenishmentPurchaseOrders(context.Context, *GenerateReplenishmentPurchaseOrdersRequest) (*GenerateReplenishmentPurchaseOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateReplenishmentPurchaseOrders not implemented")
+}
+func (UnimplementedPosPurchaseOrderServiceServer) mustEmbedUnimplementedPosPurchaseOrderServiceServer() {
+}
+
+// UnsafePosPurchaseOrderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosPurchaseOrderServiceServer will
+// result in compilation errors.
+type UnsafePosPurchaseOrderServiceServer interface {
+	mustEmbedUnimplementedPosPurchaseOrderServiceServer()
+}
+
+func RegisterPosPurchaseOrderServiceServer(s grpc.ServiceRegistrar, srv PosPurchaseOrderServiceServer) {
+	s.RegisterService(&PosPurchaseOrderService_ServiceDesc, srv)
+}
+
+func _PosPurchaseOrderService_CreatePosPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePosPurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosPurchaseOrderServiceServer).CreatePosPurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosPurchaseOrderService/CreatePosPurchaseOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosPurchaseOrderServiceServer).CreatePosPurchaseOrder(ctx, req.(*CreatePosPurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosPurchaseOrderService_ReadPosPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPosPurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosPurchaseOrderServiceServer).ReadPosPurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosPurchaseOrderService/ReadPosPurchaseOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosPurchaseOrderServiceServer).ReadPosPurchaseOrder(ctx, req.(*ReadPosPurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosPurchaseOrderService_UpdatePosPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePosPurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosPurchaseOrderServiceServer).UpdatePosPurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosPurchaseOrderService/UpdatePosPurchaseOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosPurchaseOrderServiceServer).UpdatePosPurchaseOrder(ctx, req.(*UpdatePosPurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosPurchaseOrderService_SubmitPosPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitPosPurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosPurchaseOrderServiceServer).SubmitPosPurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosPurchaseOrderService/SubmitPosPurchaseOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosPurchaseOrderServiceServer).SubmitPosPurchaseOrder(ctx, req.(*SubmitPosPurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosPurchaseOrderService_ReceivePosPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceivePosPurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosPurchaseOrderServiceServer).ReceivePosPurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosPurchaseOrderService/ReceivePosPurchaseOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosPurchaseOrderServiceServer).ReceivePosPurchaseOrder(ctx, req.(*ReceivePosPurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosPurchaseOrderService_CancelPosPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPosPurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosPurchaseOrderServiceServer).CancelPosPurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosPurchaseOrderService/CancelPosPurchaseOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosPurchaseOrderServiceServer).CancelPosPurchaseOrder(ctx, req.(*CancelPosPurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosPurchaseOrderService_ReadAllPosPurchaseOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAllPosPurchaseOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosPurchaseOrderServiceServer).ReadAllPosPurchaseOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosPurchaseOrderService/ReadAllPosPurchaseOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosPurchaseOrderServiceServer).ReadAllPosPurchaseOrders(ctx, req.(*ReadAllPosPurchaseOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosPurchaseOrderService_GenerateReplenishmentPurchaseOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateReplenishmentPurchaseOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosPurchaseOrderServiceServer).GenerateReplenishmentPurchaseOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosPurchaseOrderService/GenerateReplenishmentPurchaseOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosPurchaseOrderServiceServer).GenerateReplenishmentPurchaseOrders(ctx, req.(*GenerateReplenishmentPurchaseOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosPurchaseOrderService_ServiceDesc is the grpc.ServiceDesc for PosPurchaseOrderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosPurchaseOrderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosPurchaseOrderService",
+	HandlerType: (*PosPurchaseOrderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePosPurchaseOrder",
+			Handler:    _PosPurchaseOrderService_CreatePosPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "ReadPosPurchaseOrder",
+			Handler:    _PosPurchaseOrderService_ReadPosPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "UpdatePosPurchaseOrder",
+			Handler:    _PosPurchaseOrderService_UpdatePosPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "SubmitPosPurchaseOrder",
+			Handler:    _PosPurchaseOrderService_SubmitPosPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "ReceivePosPurchaseOrder",
+			Handler:    _PosPurchaseOrderService_ReceivePosPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "CancelPosPurchaseOrder",
+			Handler:    _PosPurchaseOrderService_CancelPosPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "ReadAllPosPurchaseOrders",
+			Handler:    _PosPurchaseOrderService_ReadAllPosPurchaseOrders_Handler,
+		},
+		{
+			MethodName: "GenerateReplenishmentPurchaseOrders",
+			Handler:    _PosPurchaseOrderService_GenerateReplenishmentPurchaseOrders_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "purchase_order.proto",
+}
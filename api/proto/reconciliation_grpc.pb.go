@@ -0,0 +1,106 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: reconciliation.proto
+
+package alpha_pos_system_product_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosInventoryReconciliationServiceClient is the client API for PosInventoryReconciliationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosInventoryReconciliationServiceClient interface {
+	ReconcilePosInventory(ctx context.Context, in *ReconcilePosInventoryRequest, opts ...grpc.CallOption) (*ReconcilePosInventoryResponse, error)
+}
+
+type posInventoryReconciliationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosInventoryReconciliationServiceClient(cc grpc.ClientConnInterface) PosInventoryReconciliationServiceClient {
+	return &posInventoryReconciliationServiceClient{cc}
+}
+
+func (c *posInventoryReconciliationServiceClient) ReconcilePosInventory(ctx context.Context, in *ReconcilePosInventoryRequest, opts ...grpc.CallOption) (*ReconcilePosInventoryResponse, error) {
+	out := new(ReconcilePosInventoryResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosInventoryReconciliationService/ReconcilePosInventory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosInventoryReconciliationServiceServer is the server API for PosInventoryReconciliationService service.
+// All implementations must embed UnimplementedPosInventoryReconciliationServiceServer
+// for forward compatibility
+type PosInventoryReconciliationServiceServer interface {
+	ReconcilePosInventory(context.Context, *ReconcilePosInventoryRequest) (*ReconcilePosInventoryResponse, error)
+	mustEmbedUnimplementedPosInventoryReconciliationServiceServer()
+}
+
+// UnimplementedPosInventoryReconciliationServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosInventoryReconciliationServiceServer struct {
+}
+
+func (UnimplementedPosInventoryReconciliationServiceServer) ReconcilePosInventory(context.Context, *ReconcilePosInventoryRequest) (*ReconcilePosInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcilePosInventory not implemented")
+}
+func (UnimplementedPosInventoryReconciliationServiceServer) mustEmbedUnimplementedPosInventoryReconciliationServiceServer() {
+}
+
+// UnsafePosInventoryReconciliationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosInventoryReconciliationServiceServer will
+// result in compilation errors.
+type UnsafePosInventoryReconciliationServiceServer interface {
+	mustEmbedUnimplementedPosInventoryReconciliationServiceServer()
+}
+
+func RegisterPosInventoryReconciliationServiceServer(s grpc.ServiceRegistrar, srv PosInventoryReconciliationServiceServer) {
+	s.RegisterService(&PosInventoryReconciliationService_ServiceDesc, srv)
+}
+
+func _PosInventoryReconciliationService_ReconcilePosInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcilePosInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosInventoryReconciliationServiceServer).ReconcilePosInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosInventoryReconciliationService/ReconcilePosInventory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosInventoryReconciliationServiceServer).ReconcilePosInventory(ctx, req.(*ReconcilePosInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosInventoryReconciliationService_ServiceDesc is the grpc.ServiceDesc for PosInventoryReconciliationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosInventoryReconciliationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosInventoryReconciliationService",
+	HandlerType: (*PosInventoryReconciliationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReconcilePosInventory",
+			Handler:    _PosInventoryReconciliationService_ReconcilePosInventory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "reconciliation.proto",
+}
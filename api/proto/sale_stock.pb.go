@@ -0,0 +1,769 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v5.26.1
+// source: sale_stock.proto
+
+package alpha_pos_system_product_service
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PosSaleStockLine is a line of a sale basket, the product is given by product_id or by barcode.
+// quantity is always positive, in the base unit of the product, or in cases for a case barcode.
+type PosSaleStockLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductId     string   `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Barcode       string   `protobuf:"bytes,2,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	Quantity      int32    `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	SerialNumbers []string `protobuf:"bytes,4,rep,name=serial_numbers,json=serialNumbers,proto3" json:"serial_numbers,omitempty"`
+	LotCode       string   `protobuf:"bytes,5,opt,name=lot_code,json=lotCode,proto3" json:"lot_code,omitempty"`
+}
+
+func (x *PosSaleStockLine) Reset() {
+	*x = PosSaleStockLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sale_stock_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PosSaleStockLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PosSaleStockLine) ProtoMessage() {}
+
+func (x *PosSaleStockLine) ProtoReflect() protoreflect.Message {
+	mi := &file_sale_stock_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PosSaleStockLine.ProtoReflect.Descriptor instead.
+func (*PosSaleStockLine) Descriptor() ([]byte, []int) {
+	return file_sale_stock_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PosSaleStockLine) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PosSaleStockLine) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *PosSaleStockLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PosSaleStockLine) GetSerialNumbers() []string {
+	if x != nil {
+		return x.SerialNumbers
+	}
+	return nil
+}
+
+func (x *PosSaleStockLine) GetLotCode() string {
+	if x != nil {
+		return x.LotCode
+	}
+	return ""
+}
+
+// PosSaleStockLineResult is the outcome of a basket line, in the order of the request lines.
+// quantity is in the base unit of the product. A rejected line carries the error and no ledger
+// entry.
+type PosSaleStockLineResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LineIndex           int32                `protobuf:"varint,1,opt,name=line_index,json=lineIndex,proto3" json:"line_index,omitempty"`
+	ProductId           string               `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity            int32                `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Accepted            bool                 `protobuf:"varint,4,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Error               string               `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Warning             string               `protobuf:"bytes,6,opt,name=warning,proto3" json:"warning,omitempty"`
+	PosInventoryHistory *PosInventoryHistory `protobuf:"bytes,7,opt,name=pos_inventory_history,json=posInventoryHistory,proto3" json:"pos_inventory_history,omitempty"`
+}
+
+func (x *PosSaleStockLineResult) Reset() {
+	*x = PosSaleStockLineResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sale_stock_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PosSaleStockLineResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PosSaleStockLineResult) ProtoMessage() {}
+
+func (x *PosSaleStockLineResult) ProtoReflect() protoreflect.Message {
+	mi := &file_sale_stock_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PosSaleStockLineResult.ProtoReflect.Descriptor instead.
+func (*PosSaleStockLineResult) Descriptor() ([]byte, []int) {
+	return file_sale_stock_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PosSaleStockLineResult) GetLineIndex() int32 {
+	if x != nil {
+		return x.LineIndex
+	}
+	return 0
+}
+
+func (x *PosSaleStockLineResult) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PosSaleStockLineResult) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PosSaleStockLineResult) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *PosSaleStockLineResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PosSaleStockLineResult) GetWarning() string {
+	if x != nil {
+		return x.Warning
+	}
+	return ""
+}
+
+func (x *PosSaleStockLineResult) GetPosInventoryHistory() *PosInventoryHistory {
+	if x != nil {
+		return x.PosInventoryHistory
+	}
+	return nil
+}
+
+// DeductStockForSaleRequest, failure_policy is all_or_nothing (default) to fail the whole basket
+// when a line can not be deducted, or partial to deduct the other lines and reject that one
+type DeductStockForSaleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SaleId        string              `protobuf:"bytes,1,opt,name=sale_id,json=saleId,proto3" json:"sale_id,omitempty"`
+	BranchId      string              `protobuf:"bytes,2,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`
+	StoreId       string              `protobuf:"bytes,3,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	Lines         []*PosSaleStockLine `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+	FailurePolicy string              `protobuf:"bytes,5,opt,name=failure_policy,json=failurePolicy,proto3" json:"failure_policy,omitempty"`
+	ReferenceNo   string              `protobuf:"bytes,6,opt,name=reference_no,json=referenceNo,proto3" json:"reference_no,omitempty"`
+	JwtPayload    *JWTPayload         `protobuf:"bytes,7,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken      string              `protobuf:"bytes,8,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+}
+
+func (x *DeductStockForSaleRequest) Reset() {
+	*x = DeductStockForSaleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sale_stock_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeductStockForSaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeductStockForSaleRequest) ProtoMessage() {}
+
+func (x *DeductStockForSaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sale_stock_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeductStockForSaleRequest.ProtoReflect.Descriptor instead.
+func (*DeductStockForSaleRequest) Descriptor() ([]byte, []int) {
+	return file_sale_stock_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeductStockForSaleRequest) GetSaleId() string {
+	if x != nil {
+		return x.SaleId
+	}
+	return ""
+}
+
+func (x *DeductStockForSaleRequest) GetBranchId() string {
+	if x != nil {
+		return x.BranchId
+	}
+	return ""
+}
+
+func (x *DeductStockForSaleRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *DeductStockForSaleRequest) GetLines() []*PosSaleStockLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *DeductStockForSaleRequest) GetFailurePolicy() string {
+	if x != nil {
+		return x.FailurePolicy
+	}
+	return ""
+}
+
+func (x *DeductStockForSaleRequest) GetReferenceNo() string {
+	if x != nil {
+		return x.ReferenceNo
+	}
+	return ""
+}
+
+func (x *DeductStockForSaleRequest) GetJwtPayload() *JWTPayload {
+	if x != nil {
+		return x.JwtPayload
+	}
+	return nil
+}
+
+func (x *DeductStockForSaleRequest) GetJwtToken() string {
+	if x != nil {
+		return x.JwtToken
+	}
+	return ""
+}
+
+type DeductStockForSaleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SaleId        string                    `protobuf:"bytes,1,opt,name=sale_id,json=saleId,proto3" json:"sale_id,omitempty"`
+	Results       []*PosSaleStockLineResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	AcceptedCount int32                     `protobuf:"varint,3,opt,name=accepted_count,json=acceptedCount,proto3" json:"accepted_count,omitempty"`
+	RejectedCount int32                     `protobuf:"varint,4,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
+}
+
+func (x *DeductStockForSaleResponse) Reset() {
+	*x = DeductStockForSaleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sale_stock_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeductStockForSaleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeductStockForSaleResponse) ProtoMessage() {}
+
+func (x *DeductStockForSaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sale_stock_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeductStockForSaleResponse.ProtoReflect.Descriptor instead.
+func (*DeductStockForSaleResponse) Descriptor() ([]byte, []int) {
+	return file_sale_stock_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeductStockForSaleResponse) GetSaleId() string {
+	if x != nil {
+		return x.SaleId
+	}
+	return ""
+}
+
+func (x *DeductStockForSaleResponse) GetResults() []*PosSaleStockLineResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *DeductStockForSaleResponse) GetAcceptedCount() int32 {
+	if x != nil {
+		return x.AcceptedCount
+	}
+	return 0
+}
+
+func (x *DeductStockForSaleResponse) GetRejectedCount() int32 {
+	if x != nil {
+		return x.RejectedCount
+	}
+	return 0
+}
+
+// ReturnStockForSaleRequest restocks lines of a sale deducted by DeductStockForSale, into the
+// store they were sold from. A product can not be returned beyond the quantity sold.
+type ReturnStockForSaleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SaleId      string              `protobuf:"bytes,1,opt,name=sale_id,json=saleId,proto3" json:"sale_id,omitempty"`
+	Lines       []*PosSaleStockLine `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	Note        string              `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	ReferenceNo string              `protobuf:"bytes,4,opt,name=reference_no,json=referenceNo,proto3" json:"reference_no,omitempty"`
+	JwtPayload  *JWTPayload         `protobuf:"bytes,5,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken    string              `protobuf:"bytes,6,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+}
+
+func (x *ReturnStockForSaleRequest) Reset() {
+	*x = ReturnStockForSaleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sale_stock_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReturnStockForSaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnStockForSaleRequest) ProtoMessage() {}
+
+func (x *ReturnStockForSaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sale_stock_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnStockForSaleRequest.ProtoReflect.Descriptor instead.
+func (*ReturnStockForSaleRequest) Descriptor() ([]byte, []int) {
+	return file_sale_stock_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReturnStockForSaleRequest) GetSaleId() string {
+	if x != nil {
+		return x.SaleId
+	}
+	return ""
+}
+
+func (x *ReturnStockForSaleRequest) GetLines() []*PosSaleStockLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *ReturnStockForSaleRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ReturnStockForSaleRequest) GetReferenceNo() string {
+	if x != nil {
+		return x.ReferenceNo
+	}
+	return ""
+}
+
+func (x *ReturnStockForSaleRequest) GetJwtPayload() *JWTPayload {
+	if x != nil {
+		return x.JwtPayload
+	}
+	return nil
+}
+
+func (x *ReturnStockForSaleRequest) GetJwtToken() string {
+	if x != nil {
+		return x.JwtToken
+	}
+	return ""
+}
+
+type ReturnStockForSaleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SaleId  string                    `protobuf:"bytes,1,opt,name=sale_id,json=saleId,proto3" json:"sale_id,omitempty"`
+	Results []*PosSaleStockLineResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ReturnStockForSaleResponse) Reset() {
+	*x = ReturnStockForSaleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sale_stock_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReturnStockForSaleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnStockForSaleResponse) ProtoMessage() {}
+
+func (x *ReturnStockForSaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sale_stock_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnStockForSaleResponse.ProtoReflect.Descriptor instead.
+func (*ReturnStockForSaleResponse) Descriptor() ([]byte, []int) {
+	return file_sale_stock_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReturnStockForSaleResponse) GetSaleId() string {
+	if x != nil {
+		return x.SaleId
+	}
+	return ""
+}
+
+func (x *ReturnStockForSaleResponse) GetResults() []*PosSaleStockLineResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_sale_stock_proto protoreflect.FileDescriptor
+
+var file_sale_stock_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x03, 0x70, 0x6f, 0x73, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa9,
+	0x01, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4c,
+	0x69, 0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12,
+	0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6c, 0x6f, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x8c, 0x02, 0x0a, 0x16, 0x50,
+	0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6e, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x65, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x4c, 0x0a, 0x15, 0x70,
+	0x6f, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x73,
+	0x2e, 0x50, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x13, 0x70, 0x6f, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
+	0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xb2, 0x02, 0x0a, 0x19, 0x44, 0x65,
+	0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x46, 0x6f, 0x72, 0x53, 0x61, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x61, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x61, 0x6c, 0x65, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f,
+	0x73, 0x53, 0x61, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x6f, 0x12,
+	0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xba,
+	0x01, 0x0a, 0x1a, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x46, 0x6f,
+	0x72, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x61, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f,
+	0x73, 0x53, 0x61, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe7, 0x01, 0x0a, 0x19,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x46, 0x6f, 0x72, 0x53, 0x61,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x61, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x61, 0x6c, 0x65,
+	0x49, 0x64, 0x12, 0x2b, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x53,
+	0x74, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x6f, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x5f, 0x6e, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x4e, 0x6f, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f,
+	0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77,
+	0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6c, 0x0a, 0x1a, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53,
+	0x74, 0x6f, 0x63, 0x6b, 0x46, 0x6f, 0x72, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x61, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x63, 0x6b,
+	0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x32, 0xc3, 0x01, 0x0a, 0x13, 0x50, 0x6f, 0x73, 0x53, 0x61, 0x6c, 0x65, 0x53,
+	0x74, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x12, 0x44,
+	0x65, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x46, 0x6f, 0x72, 0x53, 0x61, 0x6c,
+	0x65, 0x12, 0x1e, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74,
+	0x6f, 0x63, 0x6b, 0x46, 0x6f, 0x72, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74,
+	0x6f, 0x63, 0x6b, 0x46, 0x6f, 0x72, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x55, 0x0a, 0x12, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x74, 0x6f, 0x63,
+	0x6b, 0x46, 0x6f, 0x72, 0x53, 0x61, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x46, 0x6f, 0x72, 0x53, 0x61, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x46, 0x6f, 0x72, 0x53, 0x61, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x6e, 0x64, 0x72, 0x65, 0x77, 0x61, 0x6c,
+	0x69, 0x66, 0x62, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d, 0x70, 0x6f, 0x73, 0x2d, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x2d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2d, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sale_stock_proto_rawDescOnce sync.Once
+	file_sale_stock_proto_rawDescData = file_sale_stock_proto_rawDesc
+)
+
+func file_sale_stock_proto_rawDescGZIP() []byte {
+	file_sale_stock_proto_rawDescOnce.Do(func() {
+		file_sale_stock_proto_rawDescData = protoimpl.X.CompressGZIP(file_sale_stock_proto_rawDescData)
+	})
+	return file_sale_stock_proto_rawDescData
+}
+
+var file_sale_stock_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_sale_stock_proto_goTypes = []interface{}{
+	(*PosSaleStockLine)(nil),           // 0: pos.PosSaleStockLine
+	(*PosSaleStockLineResult)(nil),     // 1: pos.PosSaleStockLineResult
+	(*DeductStockForSaleRequest)(nil),  // 2: pos.DeductStockForSaleRequest
+	(*DeductStockForSaleResponse)(nil), // 3: pos.DeductStockForSaleResponse
+	(*ReturnStockForSaleRequest)(nil),  // 4: pos.ReturnStockForSaleRequest
+	(*ReturnStockForSaleResponse)(nil), // 5: pos.ReturnStockForSaleResponse
+	(*PosInventoryHistory)(nil),        // 6: pos.PosInventoryHistory
+	(*JWTPayload)(nil),                 // 7: pos.JWTPayload
+}
+var file_sale_stock_proto_depIdxs = []int32{
+	6, // 0: pos.PosSaleStockLineResult.pos_inventory_history:type_name -> pos.PosInventoryHistory
+	0, // 1: pos.DeductStockForSaleRequest.lines:type_name -> pos.PosSaleStockLine
+	7, // 2: pos.DeductStockForSaleRequest.jwt_payload:type_name -> pos.JWTPayload
+	1, // 3: pos.DeductStockForSaleResponse.results:type_name -> pos.PosSaleStockLineResult
+	0, // 4: pos.ReturnStockForSaleRequest.lines:type_name -> pos.PosSaleStockLine
+	7, // 5: pos.ReturnStockForSaleRequest.jwt_payload:type_name -> pos.JWTPayload
+	1, // 6: pos.ReturnStockForSaleResponse.results:type_name -> pos.PosSaleStockLineResult
+	2, // 7: pos.PosSaleStockService.DeductStockForSale:input_type -> pos.DeductStockForSaleRequest
+	4, // 8: pos.PosSaleStockService.ReturnStockForSale:input_type -> pos.ReturnStockForSaleRequest
+	3, // 9: pos.PosSaleStockService.DeductStockForSale:output_type -> pos.DeductStockForSaleResponse
+	5, // 10: pos.PosSaleStockService.ReturnStockForSale:output_type -> pos.ReturnStockForSaleResponse
+	9, // [9:11] is the sub-list for method output_type
+	7, // [7:9] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_sale_stock_proto_init() }
+func file_sale_stock_proto_init() {
+	if File_sale_stock_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_inventory_history_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_sale_stock_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PosSaleStockLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sale_stock_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PosSaleStockLineResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sale_stock_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeductStockForSaleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sale_stock_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeductStockForSaleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sale_stock_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReturnStockForSaleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sale_stock_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReturnStockForSaleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_sale_stock_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sale_stock_proto_goTypes,
+		DependencyIndexes: file_sale_stock_proto_depIdxs,
+		MessageInfos:      file_sale_stock_proto_msgTypes,
+	}.Build()
+	File_sale_stock_proto = out.File
+	file_sale_stock_proto_rawDesc = nil
+	file_sale_stock_proto_goTypes = nil
+	file_sale_stock_proto_depIdxs = nil
+}
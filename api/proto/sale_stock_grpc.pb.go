@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: sale_stock.proto
+
+package alpha_pos_system_product_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosSaleStockServiceClient is the client API for PosSaleStockService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosSaleStockServiceClient interface {
+	DeductStockForSale(ctx context.Context, in *DeductStockForSaleRequest, opts ...grpc.CallOption) (*DeductStockForSaleResponse, error)
+	ReturnStockForSale(ctx context.Context, in *ReturnStockForSaleRequest, opts ...grpc.CallOption) (*ReturnStockForSaleResponse, error)
+}
+
+type posSaleStockServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosSaleStockServiceClient(cc grpc.ClientConnInterface) PosSaleStockServiceClient {
+	return &posSaleStockServiceClient{cc}
+}
+
+func (c *posSaleStockServiceClient) DeductStockForSale(ctx context.Context, in *DeductStockForSaleRequest, opts ...grpc.CallOption) (*DeductStockForSaleResponse, error) {
+	out := new(DeductStockForSaleResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosSaleStockService/DeductStockForSale", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posSaleStockServiceClient) ReturnStockForSale(ctx context.Context, in *ReturnStockForSaleRequest, opts ...grpc.CallOption) (*ReturnStockForSaleResponse, error) {
+	out := new(ReturnStockForSaleResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosSaleStockService/ReturnStockForSale", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosSaleStockServiceServer is the server API for PosSaleStockService service.
+// All implementations must embed UnimplementedPosSaleStockServiceServer
+// for forward compatibility
+type PosSaleStockServiceServer interface {
+	DeductStockForSale(context.Context, *DeductStockForSaleRequest) (*DeductStockForSaleResponse, error)
+	ReturnStockForSale(context.Context, *ReturnStockForSaleRequest) (*ReturnStockForSaleResponse, error)
+	mustEmbedUnimplementedPosSaleStockServiceServer()
+}
+
+// UnimplementedPosSaleStockServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosSaleStockServiceServer struct {
+}
+
+func (UnimplementedPosSaleStockServiceServer) DeductStockForSale(context.Context, *DeductStockForSaleRequest) (*DeductStockForSaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeductStockForSale not implemented")
+}
+func (UnimplementedPosSaleStockServiceServer) ReturnStockForSale(context.Context, *ReturnStockForSaleRequest) (*ReturnStockForSaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnStockForSale not implemented")
+}
+func (UnimplementedPosSaleStockServiceServer) mustEmbedUnimplementedPosSaleStockServiceServer() {}
+
+// UnsafePosSaleStockServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosSaleStockServiceServer will
+// result in compilation errors.
+type UnsafePosSaleStockServiceServer interface {
+	mustEmbedUnimplementedPosSaleStockServiceServer()
+}
+
+func RegisterPosSaleStockServiceServer(s grpc.ServiceRegistrar, srv PosSaleStockServiceServer) {
+	s.RegisterService(&PosSaleStockService_ServiceDesc, srv)
+}
+
+func _PosSaleStockService_DeductStockForSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeductStockForSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosSaleStockServiceServer).DeductStockForSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosSaleStockService/DeductStockForSale",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosSaleStockServiceServer).DeductStockForSale(ctx, req.(*DeductStockForSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosSaleStockService_ReturnStockForSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnStockForSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosSaleStockServiceServer).ReturnStockForSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosSaleStockService/ReturnStockForSale",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosSaleStockServiceServer).ReturnStockForSale(ctx, req.(*ReturnStockForSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosSaleStockService_ServiceDesc is the grpc.ServiceDesc for PosSaleStockService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosSaleStockService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosSaleStockService",
+	HandlerType: (*PosSaleStockServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DeductStockForSale",
+			Handler:    _PosSaleStockService_DeductStockForSale_Handler,
+		},
+		{
+			MethodName: "ReturnStockForSale",
+			Handler:    _PosSaleStockService_ReturnStockForSale_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sale_stock.proto",
+}
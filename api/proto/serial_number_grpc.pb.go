@@ -0,0 +1,106 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: serial_number.proto
+
+package alpha_pos_system_product_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosSerialNumberServiceClient is the client API for PosSerialNumberService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosSerialNumberServiceClient interface {
+	ReadPosSerialNumberHistory(ctx context.Context, in *ReadPosSerialNumberHistoryRequest, opts ...grpc.CallOption) (*ReadPosSerialNumberHistoryResponse, error)
+}
+
+type posSerialNumberServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosSerialNumberServiceClient(cc grpc.ClientConnInterface) PosSerialNumberServiceClient {
+	return &posSerialNumberServiceClient{cc}
+}
+
+func (c *posSerialNumberServiceClient) ReadPosSerialNumberHistory(ctx context.Context, in *ReadPosSerialNumberHistoryRequest, opts ...grpc.CallOption) (*ReadPosSerialNumberHistoryResponse, error) {
+	out := new(ReadPosSerialNumberHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosSerialNumberService/ReadPosSerialNumberHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosSerialNumberServiceServer is the server API for PosSerialNumberService service.
+// All implementations must embed UnimplementedPosSerialNumberServiceServer
+// for forward compatibility
+type PosSerialNumberServiceServer interface {
+	ReadPosSerialNumberHistory(context.Context, *ReadPosSerialNumberHistoryRequest) (*ReadPosSerialNumberHistoryResponse, error)
+	mustEmbedUnimplementedPosSerialNumberServiceServer()
+}
+
+// UnimplementedPosSerialNumberServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosSerialNumberServiceServer struct {
+}
+
+func (UnimplementedPosSerialNumberServiceServer) ReadPosSerialNumberHistory(context.Context, *ReadPosSerialNumberHistoryRequest) (*ReadPosSerialNumberHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadPosSerialNumberHistory not implemented")
+}
+func (UnimplementedPosSerialNumberServiceServer) mustEmbedUnimplementedPosSerialNumberServiceServer() {
+}
+
+// UnsafePosSerialNumberServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosSerialNumberServiceServer will
+// result in compilation errors.
+type UnsafePosSerialNumberServiceServer interface {
+	mustEmbedUnimplementedPosSerialNumberServiceServer()
+}
+
+func RegisterPosSerialNumberServiceServer(s grpc.ServiceRegistrar, srv PosSerialNumberServiceServer) {
+	s.RegisterService(&PosSerialNumberService_ServiceDesc, srv)
+}
+
+func _PosSerialNumberService_ReadPosSerialNumberHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPosSerialNumberHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosSerialNumberServiceServer).ReadPosSerialNumberHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosSerialNumberService/ReadPosSerialNumberHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosSerialNumberServiceServer).ReadPosSerialNumberHistory(ctx, req.(*ReadPosSerialNumberHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosSerialNumberService_ServiceDesc is the grpc.ServiceDesc for PosSerialNumberService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosSerialNumberService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosSerialNumberService",
+	HandlerType: (*PosSerialNumberServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReadPosSerialNumberHistory",
+			Handler:    _PosSerialNumberService_ReadPosSerialNumberHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "serial_number.proto",
+}
@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: stock_level.proto
+
+package alpha_pos_system_product_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosStockLevelServiceClient is the client API for PosStockLevelService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosStockLevelServiceClient interface {
+	ReadPosStockLevel(ctx context.Context, in *ReadPosStockLevelRequest, opts ...grpc.CallOption) (*ReadPosStockLevelResponse, error)
+	UpdatePosStockLevel(ctx context.Context, in *UpdatePosStockLevelRequest, opts ...grpc.CallOption) (*UpdatePosStockLevelResponse, error)
+	ReadAllPosStockLevels(ctx context.Context, in *ReadAllPosStockLevelsRequest, opts ...grpc.CallOption) (*ReadAllPosStockLevelsResponse, error)
+}
+
+type posStockLevelServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosStockLevelServiceClient(cc grpc.ClientConnInterface) PosStockLevelServiceClient {
+	return &posStockLevelServiceClient{cc}
+}
+
+func (c *posStockLevelServiceClient) ReadPosStockLevel(ctx context.Context, in *ReadPosStockLevelRequest, opts ...grpc.CallOption) (*ReadPosStockLevelResponse, error) {
+	out := new(ReadPosStockLevelResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStockLevelService/ReadPosStockLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posStockLevelServiceClient) UpdatePosStockLevel(ctx context.Context, in *UpdatePosStockLevelRequest, opts ...grpc.CallOption) (*UpdatePosStockLevelResponse, error) {
+	out := new(UpdatePosStockLevelResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStockLevelService/UpdatePosStockLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posStockLevelServiceClient) ReadAllPosStockLevels(ctx context.Context, in *ReadAllPosStockLevelsRequest, opts ...grpc.CallOption) (*ReadAllPosStockLevelsResponse, error) {
+	out := new(ReadAllPosStockLevelsResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStockLevelService/ReadAllPosStockLevels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosStockLevelServiceServer is the server API for PosStockLevelService service.
+// All implementations must embed UnimplementedPosStockLevelServiceServer
+// for forward compatibility
+type PosStockLevelServiceServer interface {
+	ReadPosStockLevel(context.Context, *ReadPosStockLevelRequest) (*ReadPosStockLevelResponse, error)
+	UpdatePosStockLevel(context.Context, *UpdatePosStockLevelRequest) (*UpdatePosStockLevelResponse, error)
+	ReadAllPosStockLevels(context.Context, *ReadAllPosStockLevelsRequest) (*ReadAllPosStockLevelsResponse, error)
+	mustEmbedUnimplementedPosStockLevelServiceServer()
+}
+
+// UnimplementedPosStockLevelServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosStockLevelServiceServer struct {
+}
+
+func (UnimplementedPosStockLevelServiceServer) ReadPosStockLevel(context.Context, *ReadPosStockLevelRequest) (*ReadPosStockLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadPosStockLevel not implemented")
+}
+func (UnimplementedPosStockLevelServiceServer) UpdatePosStockLevel(context.Context, *UpdatePosStockLevelRequest) (*UpdatePosStockLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePosStockLevel not implemented")
+}
+func (UnimplementedPosStockLevelServiceServer) ReadAllPosStockLevels(context.Context, *ReadAllPosStockLevelsRequest) (*ReadAllPosStockLevelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAllPosStockLevels not implemented")
+}
+func (UnimplementedPosStockLevelServiceServer) mustEmbedUnimplementedPosStockLevelServiceServer() {}
+
+// UnsafePosStockLevelServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosStockLevelServiceServer will
+// result in compilation errors.
+type UnsafePosStockLevelServiceServer interface {
+	mustEmbedUnimplementedPosStockLevelServiceServer()
+}
+
+func RegisterPosStockLevelServiceServer(s grpc.ServiceRegistrar, srv PosStockLevelServiceServer) {
+	s.RegisterService(&PosStockLevelService_ServiceDesc, srv)
+}
+
+func _PosStockLevelService_ReadPosStockLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPosStockLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStockLevelServiceServer).ReadPosStockLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStockLevelService/ReadPosStockLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStockLevelServiceServer).ReadPosStockLevel(ctx, req.(*ReadPosStockLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosStockLevelService_UpdatePosStockLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePosStockLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStockLevelServiceServer).UpdatePosStockLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStockLevelService/UpdatePosStockLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStockLevelServiceServer).UpdatePosStockLevel(ctx, req.(*UpdatePosStockLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosStockLevelService_ReadAllPosStockLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAllPosStockLevelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStockLevelServiceServer).ReadAllPosStockLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStockLevelService/ReadAllPosStockLevels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStockLevelServiceServer).ReadAllPosStockLevels(ctx, req.(*ReadAllPosStockLevelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosStockLevelService_ServiceDesc is the grpc.ServiceDesc for PosStockLevelService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosStockLevelService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosStockLevelService",
+	HandlerType: (*PosStockLevelServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReadPosStockLevel",
+			Handler:    _PosStockLevelService_ReadPosStockLevel_Handler,
+		},
+		{
+			MethodName: "UpdatePosStockLevel",
+			Handler:    _PosStockLevelService_UpdatePosStockLevel_Handler,
+		},
+		{
+			MethodName: "ReadAllPosStockLevels",
+			Handler:    _PosStockLevelService_ReadAllPosStockLevels_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stock_level.proto",
+}
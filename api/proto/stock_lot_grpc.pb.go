@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: stock_lot.proto
+
+package alpha_pos_system_product_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosStockLotServiceClient is the client API for PosStockLotService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosStockLotServiceClient interface {
+	ReadAllPosStockLots(ctx context.Context, in *ReadAllPosStockLotsRequest, opts ...grpc.CallOption) (*ReadAllPosStockLotsResponse, error)
+	ListExpiringPosStockLots(ctx context.Context, in *ListExpiringPosStockLotsRequest, opts ...grpc.CallOption) (*ListExpiringPosStockLotsResponse, error)
+}
+
+type posStockLotServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosStockLotServiceClient(cc grpc.ClientConnInterface) PosStockLotServiceClient {
+	return &posStockLotServiceClient{cc}
+}
+
+func (c *posStockLotServiceClient) ReadAllPosStockLots(ctx context.Context, in *ReadAllPosStockLotsRequest, opts ...grpc.CallOption) (*ReadAllPosStockLotsResponse, error) {
+	out := new(ReadAllPosStockLotsResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStockLotService/ReadAllPosStockLots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posStockLotServiceClient) ListExpiringPosStockLots(ctx context.Context, in *ListExpiringPosStockLotsRequest, opts ...grpc.CallOption) (*ListExpiringPosStockLotsResponse, error) {
+	out := new(ListExpiringPosStockLotsResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStockLotService/ListExpiringPosStockLots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosStockLotServiceServer is the server API for PosStockLotService service.
+// All implementations must embed UnimplementedPosStockLotServiceServer
+// for forward compatibility
+type PosStockLotServiceServer interface {
+	ReadAllPosStockLots(context.Context, *ReadAllPosStockLotsRequest) (*ReadAllPosStockLotsResponse, error)
+	ListExpiringPosStockLots(context.Context, *ListExpiringPosStockLotsRequest) (*ListExpiringPosStockLotsResponse, error)
+	mustEmbedUnimplementedPosStockLotServiceServer()
+}
+
+// UnimplementedPosStockLotServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosStockLotServiceServer struct {
+}
+
+func (UnimplementedPosStockLotServiceServer) ReadAllPosStockLots(context.Context, *ReadAllPosStockLotsRequest) (*ReadAllPosStockLotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAllPosStockLots not implemented")
+}
+func (UnimplementedPosStockLotServiceServer) ListExpiringPosStockLots(context.Context, *ListExpiringPosStockLotsRequest) (*ListExpiringPosStockLotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpiringPosStockLots not implemented")
+}
+func (UnimplementedPosStockLotServiceServer) mustEmbedUnimplementedPosStockLotServiceServer() {}
+
+// UnsafePosStockLotServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosStockLotServiceServer will
+// result in compilation errors.
+type UnsafePosStockLotServiceServer interface {
+	mustEmbedUnimplementedPosStockLotServiceServer()
+}
+
+func RegisterPosStockLotServiceServer(s grpc.ServiceRegistrar, srv PosStockLotServiceServer) {
+	s.RegisterService(&PosStockLotService_ServiceDesc, srv)
+}
+
+func _PosStockLotService_ReadAllPosStockLots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAllPosStockLotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStockLotServiceServer).ReadAllPosStockLots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStockLotService/ReadAllPosStockLots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStockLotServiceServer).ReadAllPosStockLots(ctx, req.(*ReadAllPosStockLotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosStockLotService_ListExpiringPosStockLots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpiringPosStockLotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStockLotServiceServer).ListExpiringPosStockLots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStockLotService/ListExpiringPosStockLots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStockLotServiceServer).ListExpiringPosStockLots(ctx, req.(*ListExpiringPosStockLotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosStockLotService_ServiceDesc is the grpc.ServiceDesc for PosStockLotService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosStockLotService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosStockLotService",
+	HandlerType: (*PosStockLotServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReadAllPosStockLots",
+			Handler:    _PosStockLotService_ReadAllPosStockLots_Handler,
+		},
+		{
+			MethodName: "ListExpiringPosStockLots",
+			Handler:    _PosStockLotService_ListExpiringPosStockLots_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stock_lot.proto",
+}
@@ -0,0 +1,178 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: stock_reservation.proto
+
+package alpha_pos_system_product_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosStockReservationServiceClient is the client API for PosStockReservationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosStockReservationServiceClient interface {
+	ReservePosStock(ctx context.Context, in *ReservePosStockRequest, opts ...grpc.CallOption) (*ReservePosStockResponse, error)
+	CommitPosStockReservation(ctx context.Context, in *CommitPosStockReservationRequest, opts ...grpc.CallOption) (*CommitPosStockReservationResponse, error)
+	ReleasePosStockReservation(ctx context.Context, in *ReleasePosStockReservationRequest, opts ...grpc.CallOption) (*ReleasePosStockReservationResponse, error)
+}
+
+type posStockReservationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosStockReservationServiceClient(cc grpc.ClientConnInterface) PosStockReservationServiceClient {
+	return &posStockReservationServiceClient{cc}
+}
+
+func (c *posStockReservationServiceClient) ReservePosStock(ctx context.Context, in *ReservePosStockRequest, opts ...grpc.CallOption) (*ReservePosStockResponse, error) {
+	out := new(ReservePosStockResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStockReservationService/ReservePosStock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posStockReservationServiceClient) CommitPosStockReservation(ctx context.Context, in *CommitPosStockReservationRequest, opts ...grpc.CallOption) (*CommitPosStockReservationResponse, error) {
+	out := new(CommitPosStockReservationResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStockReservationService/CommitPosStockReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posStockReservationServiceClient) ReleasePosStockReservation(ctx context.Context, in *ReleasePosStockReservationRequest, opts ...grpc.CallOption) (*ReleasePosStockReservationResponse, error) {
+	out := new(ReleasePosStockReservationResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStockReservationService/ReleasePosStockReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosStockReservationServiceServer is the server API for PosStockReservationService service.
+// All implementations must embed UnimplementedPosStockReservationServiceServer
+// for forward compatibility
+type PosStockReservationServiceServer interface {
+	ReservePosStock(context.Context, *ReservePosStockRequest) (*ReservePosStockResponse, error)
+	CommitPosStockReservation(context.Context, *CommitPosStockReservationRequest) (*CommitPosStockReservationResponse, error)
+	ReleasePosStockReservation(context.Context, *ReleasePosStockReservationRequest) (*ReleasePosStockReservationResponse, error)
+	mustEmbedUnimplementedPosStockReservationServiceServer()
+}
+
+// UnimplementedPosStockReservationServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosStockReservationServiceServer struct {
+}
+
+func (UnimplementedPosStockReservationServiceServer) ReservePosStock(context.Context, *ReservePosStockRequest) (*ReservePosStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReservePosStock not implemented")
+}
+func (UnimplementedPosStockReservationServiceServer) CommitPosStockReservation(context.Context, *CommitPosStockReservationRequest) (*CommitPosStockReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitPosStockReservation not implemented")
+}
+func (UnimplementedPosStockReservationServiceServer) ReleasePosStockReservation(context.Context, *ReleasePosStockReservationRequest) (*ReleasePosStockReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleasePosStockReservation not implemented")
+}
+func (UnimplementedPosStockReservationServiceServer) mustEmbedUnimplementedPosStockReservationServiceServer() {
+}
+
+// UnsafePosStockReservationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosStockReservationServiceServer will
+// result in compilation errors.
+type UnsafePosStockReservationServiceServer interface {
+	mustEmbedUnimplementedPosStockReservationServiceServer()
+}
+
+func RegisterPosStockReservationServiceServer(s grpc.ServiceRegistrar, srv PosStockReservationServiceServer) {
+	s.RegisterService(&PosStockReservationService_ServiceDesc, srv)
+}
+
+func _PosStockReservationService_ReservePosStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReservePosStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStockReservationServiceServer).ReservePosStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStockReservationService/ReservePosStock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStockReservationServiceServer).ReservePosStock(ctx, req.(*ReservePosStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosStockReservationService_CommitPosStockReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitPosStockReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStockReservationServiceServer).CommitPosStockReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStockReservationService/CommitPosStockReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStockReservationServiceServer).CommitPosStockReservation(ctx, req.(*CommitPosStockReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosStockReservationService_ReleasePosStockReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleasePosStockReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStockReservationServiceServer).ReleasePosStockReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStockReservationService/ReleasePosStockReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStockReservationServiceServer).ReleasePosStockReservation(ctx, req.(*ReleasePosStockReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosStockReservationService_ServiceDesc is the grpc.ServiceDesc for PosStockReservationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosStockReservationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosStockReservationService",
+	HandlerType: (*PosStockReservationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReservePosStock",
+			Handler:    _PosStockReservationService_ReservePosStock_Handler,
+		},
+		{
+			MethodName: "CommitPosStockReservation",
+			Handler:    _PosStockReservationService_CommitPosStockReservation_Handler,
+		},
+		{
+			MethodName: "ReleasePosStockReservation",
+			Handler:    _PosStockReservationService_ReleasePosStockReservation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stock_reservation.proto",
+}
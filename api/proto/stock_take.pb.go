@@ -0,0 +1,1902 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v5.26.1
+// source: stock_take.proto
+
+package alpha_pos_system_product_service
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PosStockTakeItem
+type PosStockTakeItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StockTakeItemId  string                 `protobuf:"bytes,1,opt,name=stock_take_item_id,json=stockTakeItemId,proto3" json:"stock_take_item_id,omitempty"`
+	StockTakeId      string                 `protobuf:"bytes,2,opt,name=stock_take_id,json=stockTakeId,proto3" json:"stock_take_id,omitempty"`
+	ProductId        string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ExpectedQuantity int32                  `protobuf:"varint,4,opt,name=expected_quantity,json=expectedQuantity,proto3" json:"expected_quantity,omitempty"`
+	CountedQuantity  int32                  `protobuf:"varint,5,opt,name=counted_quantity,json=countedQuantity,proto3" json:"counted_quantity,omitempty"`
+	Counted          bool                   `protobuf:"varint,6,opt,name=counted,proto3" json:"counted,omitempty"`
+	CountedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=counted_at,json=countedAt,proto3" json:"counted_at,omitempty"`
+	CountedBy        string                 `protobuf:"bytes,8,opt,name=counted_by,json=countedBy,proto3" json:"counted_by,omitempty"`
+}
+
+func (x *PosStockTakeItem) Reset() {
+	*x = PosStockTakeItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stock_take_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PosStockTakeItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PosStockTakeItem) ProtoMessage() {}
+
+func (x *PosStockTakeItem) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_take_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PosStockTakeItem.ProtoReflect.Descriptor instead.
+func (*PosStockTakeItem) Descriptor() ([]byte, []int) {
+	return file_stock_take_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PosStockTakeItem) GetStockTakeItemId() string {
+	if x != nil {
+		return x.StockTakeItemId
+	}
+	return ""
+}
+
+func (x *PosStockTakeItem) GetStockTakeId() string {
+	if x != nil {
+		return x.StockTakeId
+	}
+	return ""
+}
+
+func (x *PosStockTakeItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PosStockTakeItem) GetExpectedQuantity() int32 {
+	if x != nil {
+		return x.ExpectedQuantity
+	}
+	return 0
+}
+
+func (x *PosStockTakeItem) GetCountedQuantity() int32 {
+	if x != nil {
+		return x.CountedQuantity
+	}
+	return 0
+}
+
+func (x *PosStockTakeItem) GetCounted() bool {
+	if x != nil {
+		return x.Counted
+	}
+	return false
+}
+
+func (x *PosStockTakeItem) GetCountedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CountedAt
+	}
+	return nil
+}
+
+func (x *PosStockTakeItem) GetCountedBy() string {
+	if x != nil {
+		return x.CountedBy
+	}
+	return ""
+}
+
+// PosStockTake
+type PosStockTake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StockTakeId string                 `protobuf:"bytes,1,opt,name=stock_take_id,json=stockTakeId,proto3" json:"stock_take_id,omitempty"`
+	StoreId     string                 `protobuf:"bytes,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	BranchId    string                 `protobuf:"bytes,3,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`
+	Scope       string                 `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	CategoryId  string                 `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Status      string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Note        string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	Items       []*PosStockTakeItem    `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
+	ApprovedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	ApprovedBy  string                 `protobuf:"bytes,10,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	CompanyId   string                 `protobuf:"bytes,11,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy   string                 `protobuf:"bytes,13,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy   string                 `protobuf:"bytes,15,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+}
+
+func (x *PosStockTake) Reset() {
+	*x = PosStockTake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stock_take_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PosStockTake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PosStockTake) ProtoMessage() {}
+
+func (x *PosStockTake) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_take_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PosStockTake.ProtoReflect.Descriptor instead.
+func (*PosStockTake) Descriptor() ([]byte, []int) {
+	return file_stock_take_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PosStockTake) GetStockTakeId() string {
+	if x != nil {
+		return x.StockTakeId
+	}
+	return ""
+}
+
+func (x *PosStockTake) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *PosStockTake) GetBranchId() string {
+	if x != nil {
+		return x.BranchId
+	}
+	return ""
+}
+
+func (x *PosStockTake) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *PosStockTake) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *PosStockTake) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PosStockTake) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *PosStockTake) GetItems() []*PosStockTakeItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *PosStockTake) GetApprovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return nil
+}
+
+func (x *PosStockTake) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
+}
+
+func (x *PosStockTake) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *PosStockTake) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PosStockTake) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *PosStockTake) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PosStockTake) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+// PosStockTakeCount is a counted quantity submitted by product ID or barcode
+type PosStockTakeCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductId        string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductBarcodeId string `protobuf:"bytes,2,opt,name=product_barcode_id,json=productBarcodeId,proto3" json:"product_barcode_id,omitempty"`
+	CountedQuantity  int32  `protobuf:"varint,3,opt,name=counted_quantity,json=countedQuantity,proto3" json:"counted_quantity,omitempty"`
+}
+
+func (x *PosStockTakeCount) Reset() {
+	*x = PosStockTakeCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stock_take_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PosStockTakeCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PosStockTakeCount) ProtoMessage() {}
+
+func (x *PosStockTakeCount) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_take_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PosStockTakeCount.ProtoReflect.Descriptor instead.
+func (*PosStockTakeCount) Descriptor() ([]byte, []int) {
+	return file_stock_take_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PosStockTakeCount) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PosStockTakeCount) GetProductBarcodeId() string {
+	if x != nil {
+		return x.ProductBarcodeId
+	}
+	return ""
+}
+
+func (x *PosStockTakeCount) GetCountedQuantity() int32 {
+	if x != nil {
+		return x.CountedQuantity
+	}
+	return 0
+}
+
+// PosStockTakeVariance
+type PosStockTakeVariance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductId        string  `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName      string  `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	ExpectedQuantity int32   `protobuf:"varint,3,opt,name=expected_quantity,json=expectedQuantity,proto3" json:"expected_quantity,omitempty"`
+	CountedQuantity  int32   `protobuf:"varint,4,opt,name=counted_quantity,json=countedQuantity,proto3" json:"counted_quantity,omitempty"`
+	Counted          bool    `protobuf:"varint,5,opt,name=counted,proto3" json:"counted,omitempty"`
+	Variance         int32   `protobuf:"varint,6,opt,name=variance,proto3" json:"variance,omitempty"`
+	CostPrice        float64 `protobuf:"fixed64,7,opt,name=cost_price,json=costPrice,proto3" json:"cost_price,omitempty"`
+	VarianceCost     float64 `protobuf:"fixed64,8,opt,name=variance_cost,json=varianceCost,proto3" json:"variance_cost,omitempty"`
+	// serialized variances are not posted on approval, they need serial adjustments
+	Serialized bool `protobuf:"varint,9,opt,name=serialized,proto3" json:"serialized,omitempty"`
+}
+
+func (x *PosStockTakeVariance) Reset() {
+	*x = PosStockTakeVariance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stock_take_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PosStockTakeVariance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PosStockTakeVariance) ProtoMessage() {}
+
+func (x *PosStockTakeVariance) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_take_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PosStockTakeVariance.ProtoReflect.Descriptor instead.
+func (*PosStockTakeVariance) Descriptor() ([]byte, []int) {
+	return file_stock_take_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PosStockTakeVariance) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PosStockTakeVariance) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *PosStockTakeVariance) GetExpectedQuantity() int32 {
+	if x != nil {
+		return x.ExpectedQuantity
+	}
+	return 0
+}
+
+func (x *PosStockTakeVariance) GetCountedQuantity() int32 {
+	if x != nil {
+		return x.CountedQuantity
+	}
+	return 0
+}
+
+func (x *PosStockTakeVariance) GetCounted() bool {
+	if x != nil {
+		return x.Counted
+	}
+	return false
+}
+
+func (x *PosStockTakeVariance) GetVariance() int32 {
+	if x != nil {
+		return x.Variance
+	}
+	return 0
+}
+
+func (x *PosStockTakeVariance) GetCostPrice() float64 {
+	if x != nil {
+		return x.CostPrice
+	}
+	return 0
+}
+
+func (x *PosStockTakeVariance) GetVarianceCost() float64 {
+	if x != nil {
+		return x.VarianceCost
+	}
+	return 0
+}
+
+func (x *PosStockTakeVariance) GetSerialized() bool {
+	if x != nil {
+		return x.Serialized
+	}
+	return false
+}
+
+// Request and Response messages
+type CreatePosStockTakeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PosStockTake *PosStockTake `protobuf:"bytes,1,opt,name=pos_stock_take,json=posStockTake,proto3" json:"pos_stock_take,omitempty"`
+	JwtPayload   *JWTPayload   `protobuf:"bytes,2,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken     string        `protobuf:"bytes,3,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+}
+
+func (x *CreatePosStockTakeRequest) Reset() {
+	*x = CreatePosStockTakeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stock_take_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePosStockTakeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePosStockTakeRequest) ProtoMessage() {}
+
+func (x *CreatePosStockTakeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_take_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePosStockTakeRequest.ProtoReflect.Descriptor instead.
+func (*CreatePosStockTakeRequest) Descriptor() ([]byte, []int) {
+	return file_stock_take_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreatePosStockTakeRequest) GetPosStockTake() *PosStockTake {
+	if x != nil {
+		return x.PosStockTake
+	}
+	return nil
+}
+
+func (x *CreatePosStockTakeRequest) GetJwtPayload() *JWTPayload {
+	if x != nil {
+		return x.JwtPayload
+	}
+	return nil
+}
+
+func (x *CreatePosStockTakeRequest) GetJwtToken() string {
+	if x != nil {
+		return x.JwtToken
+	}
+	return ""
+}
+
+type CreatePosStockTakeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PosStockTake *PosStockTake `protobuf:"bytes,1,opt,name=pos_stock_take,json=posStockTake,proto3" json:"pos_stock_take,omitempty"`
+}
+
+func (x *CreatePosStockTakeResponse) Reset() {
+	*x = CreatePosStockTakeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stock_take_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePosStockTakeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePosStockTakeResponse) ProtoMessage() {}
+
+func (x *CreatePosStockTakeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_take_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePosStockTakeResponse.ProtoReflect.Descriptor instead.
+func (*CreatePosStockTakeResponse) Descriptor() ([]byte, []int) {
+	return file_stock_take_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreatePosStockTakeResponse) GetPosStockTake() *PosStockTake {
+	if x != nil {
+		return x.PosStockTake
+	}
+	return nil
+}
+
+type ReadPosStockTakeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StockTakeId string      `protobuf:"bytes,1,opt,name=stock_take_id,json=stockTakeId,proto3" json:"stock_take_id,omitempty"`
+	JwtPayload  *JWTPayload `protobuf:"bytes,2,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken    string      `protobuf:"bytes,3,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+}
+
+func (x *ReadPosStockTakeRequest) Reset() {
+	*x = ReadPosStockTakeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stock_take_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadPosStockTakeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadPosStockTakeRequest) ProtoMessage() {}
+
+func (x *ReadPosStockTakeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_take_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadPosStockTakeRequest.ProtoReflect.Descriptor instead.
+func (*ReadPosStockTakeRequest) Descriptor() ([]byte, []int) {
+	return file_stock_take_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReadPosStockTakeRequest) GetStockTakeId() string {
+	if x != nil {
+		return x.StockTakeId
+	}
+	return ""
+}
+
+func (x *ReadPosStockTakeRequest) GetJwtPayload() *JWTPayload {
+	if x != nil {
+		return x.JwtPayload
+	}
+	return nil
+}
+
+func (x *ReadPosStockTakeRequest) GetJwtToken() string {
+	if x != nil {
+		return x.JwtToken
+	}
+	return ""
+}
+
+type ReadPosStockTakeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PosStockTake *PosStockTake `protobuf:"bytes,1,opt,name=pos_stock_take,json=posStockTake,proto3" json:"pos_stock_take,omitempty"`
+}
+
+func (x *ReadPosStockTakeResponse) Reset() {
+	*x = ReadPosStockTakeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stock_take_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadPosStockTakeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadPosStockTakeResponse) ProtoMessage() {}
+
+func (x *ReadPosStockTakeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_take_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadPosStockTakeResponse.ProtoReflect.Descriptor instead.
+func (*ReadPosStockTakeResponse) Descriptor() ([]byte, []int) {
+	return file_stock_take_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReadPosStockTakeResponse) GetPosStockTake() *PosStockTake {
+	if x != nil {
+		return x.PosStockTake
+	}
+	return nil
+}
+
+type SubmitPosStockTakeCountsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StockTakeId string               `protobuf:"bytes,1,opt,name=stock_take_id,json=stockTakeId,proto3" json:"stock_take_id,omitempty"`
+	Counts      []*PosStockTakeCount `protobuf:"bytes,2,rep,name=counts,proto3" json:"counts,omitempty"`
+	Accumulate  bool                 `protobuf:"varint,3,opt,name=accumulate,proto3" json:"accumulate,omitempty"`
+	JwtPayload  *JWTPayload          `protobuf:"bytes,4,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken    string               `protobuf:"bytes,5,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+}
+
+func (x *SubmitPosStockTakeCountsRequest) Reset() {
+	*x = SubmitPosStockTakeCountsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stock_take_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitPosStockTakeCountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitPosStockTakeCountsRequest) ProtoMessage() {}
+
+func (x *SubmitPosStockTakeCountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_take_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitPosStockTakeCountsRequest.ProtoReflect.Descriptor instead.
+func (*SubmitPosStockTakeCountsRequest) Descriptor() ([]byte, []int) {
+	return file_stock_take_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SubmitPosStockTakeCountsRequest) GetStockTakeId() string {
+	if x != nil {
+		return x.StockTakeId
+	}
+	return ""
+}
+
+func (x *SubmitPosStockTakeCountsRequest) GetCounts() []*PosStockTakeCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *SubmitPosStockTakeCountsRequest) GetAccumulate() bool {
+	if x != nil {
+		return x.Accumulate
+	}
+	return false
+}
+
+func (x *SubmitPosStockTakeCountsRequest) GetJwtPayload() *JWTPayload {
+	if x != nil {
+		return x.JwtPayload
+	}
+	return nil
+}
+
+func (x *SubmitPosStockTakeCountsRequest) GetJwtToken() string {
+	if x != nil {
+		return x.JwtToken
+	}
+	return ""
+}
+
+type SubmitPosStockTakeCountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PosStockTake *PosStockTake `protobuf:"bytes,1,opt,name=pos_stock_take,json=posStockTake,proto3" json:"pos_stock_take,omitempty"`
+}
+
+func (x *SubmitPosStockTakeCountsResponse) Reset() {
+	*x = SubmitPosStockTakeCountsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stock_take_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitPosStockTakeCountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitPosStockTakeCountsResponse) ProtoMessage() {}
+
+func (x *SubmitPosStockTakeCountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_take_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitPosStockTakeCountsResponse.ProtoReflect.Descriptor instead.
+func (*SubmitPosStockTakeCountsResponse) Descriptor() ([]byte, []int) {
+	return file_stock_take_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SubmitPosStockTakeCountsResponse) GetPosStockTake() *PosStockTake {
+	if x != nil {
+		return x.PosStockTake
+	}
+	return nil
+}
+
+type ReadPosStockTakeVarianceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StockTakeId string      `protobuf:"bytes,1,opt,name=stock_take_id,json=stockTakeId,proto3" json:"stock_take_id,omitempty"`
+	JwtPayload  *JWTPayload `protobuf:"bytes,2,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken    string      `protobuf:"bytes,3,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+}
+
+func (x *ReadPosStockTakeVarianceRequest) Reset() {
+	*x = ReadPosStockTakeVarianceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stock_take_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadPosStockTakeVarianceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadPosStockTakeVarianceRequest) ProtoMessage() {}
+
+func (x *ReadPosStockTakeVarianceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_take_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadPosStockTakeVarianceRequest.ProtoReflect.Descriptor instead.
+func (*ReadPosStockTakeVarianceRequest) Descriptor() ([]byte, []int) {
+	return file_stock_take_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReadPosStockTakeVarianceRequest) GetStockTakeId() string {
+	if x != nil {
+		return x.StockTakeId
+	}
+	return ""
+}
+
+func (x *ReadPosStockTakeVarianceRequest) GetJwtPayload() *JWTPayload {
+	if x != nil {
+		return x.JwtPayload
+	}
+	return nil
+}
+
+func (x *ReadPosStockTakeVarianceRequest) GetJwtToken() string {
+	if x != nil {
+		return x.JwtToken
+	}
+	return ""
+}
+
+type ReadPosStockTakeVarianceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StockTakeId           string                  `protobuf:"bytes,1,opt,name=stock_take_id,json=stockTakeId,proto3" json:"stock_take_id,omitempty"`
+	Variances             []*PosStockTakeVariance `protobuf:"bytes,2,rep,name=variances,proto3" json:"variances,omitempty"`
+	TotalVarianceQuantity int32                   `protobuf:"varint,3,opt,name=total_variance_quantity,json=totalVarianceQuantity,proto3" json:"total_variance_quantity,omitempty"`
+	TotalVarianceCost     float64                 `protobuf:"fixed64,4,opt,name=total_variance_cost,json=totalVarianceCost,proto3" json:"total_variance_cost,omitempty"`
+}
+
+func (x *ReadPosStockTakeVarianceResponse) Reset() {
+	*x = ReadPosStockTakeVarianceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stock_take_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadPosStockTakeVarianceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadPosStockTakeVarianceResponse) ProtoMessage() {}
+
+func (x *ReadPosStockTakeVarianceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_take_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadPosStockTakeVarianceResponse.ProtoReflect.Descriptor instead.
+func (*ReadPosStockTakeVarianceResponse) Descriptor() ([]byte, []int) {
+	return file_stock_take_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReadPosStockTakeVarianceResponse) GetStockTakeId() string {
+	if x != nil {
+		return x.StockTakeId
+	}
+	return ""
+}
+
+func (x *ReadPosStockTakeVarianceResponse) GetVariances() []*PosStockTakeVariance {
+	if x != nil {
+		return x.Variances
+	}
+	return nil
+}
+
+func (x *ReadPosStockTakeVarianceResponse) GetTotalVarianceQuantity() int32 {
+	if x != nil {
+		return x.TotalVarianceQuantity
+	}
+	return 0
+}
+
+func (x *ReadPosStockTakeVarianceResponse) GetTotalVarianceCost() float64 {
+	if x != nil {
+		return x.TotalVarianceCost
+	}
+	return 0
+}
+
+type ApprovePosStockTakeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StockTakeId string      `protobuf:"bytes,1,opt,name=stock_take_id,json=stockTakeId,proto3" json:"stock_take_id,omitempty"`
+	JwtPayload  *JWTPayload `protobuf:"bytes,2,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken    string      `protobuf:"bytes,3,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+}
+
+func (x *ApprovePosStockTakeRequest) Reset() {
+	*x = ApprovePosStockTakeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stock_take_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApprovePosStockTakeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovePosStockTakeRequest) ProtoMessage() {}
+
+func (x *ApprovePosStockTakeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_take_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovePosStockTakeRequest.ProtoReflect.Descriptor instead.
+func (*ApprovePosStockTakeRequest) Descriptor() ([]byte, []int) {
+	return file_stock_take_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ApprovePosStockTakeRequest) GetStockTakeId() string {
+	if x != nil {
+		return x.StockTakeId
+	}
+	return ""
+}
+
+func (x *ApprovePosStockTakeRequest) GetJwtPayload() *JWTPayload {
+	if x != nil {
+		return x.JwtPayload
+	}
+	return nil
+}
+
+func (x *ApprovePosStockTakeRequest) GetJwtToken() string {
+	if x != nil {
+		return x.JwtToken
+	}
+	return ""
+}
+
+type ApprovePosStockTakeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PosStockTake *PosStockTake `protobuf:"bytes,1,opt,name=pos_stock_take,json=posStockTake,proto3" json:"pos_stock_take,omitempty"`
+}
+
+func (x *ApprovePosStockTakeResponse) Reset() {
+	*x = ApprovePosStockTakeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stock_take_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApprovePosStockTakeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovePosStockTakeResponse) ProtoMessage() {}
+
+func (x *ApprovePosStockTakeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_take_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovePosStockTakeResponse.ProtoReflect.Descriptor instead.
+func (*ApprovePosStockTakeResponse) Descriptor() ([]byte, []int) {
+	return file_stock_take_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ApprovePosStockTakeResponse) GetPosStockTake() *PosStockTake {
+	if x != nil {
+		return x.PosStockTake
+	}
+	return nil
+}
+
+type CancelPosStockTakeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StockTakeId string      `protobuf:"bytes,1,opt,name=stock_take_id,json=stockTakeId,proto3" json:"stock_take_id,omitempty"`
+	JwtPayload  *JWTPayload `protobuf:"bytes,2,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken    string      `protobuf:"bytes,3,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+}
+
+func (x *CancelPosStockTakeRequest) Reset() {
+	*x = CancelPosStockTakeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stock_take_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelPosStockTakeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPosStockTakeRequest) ProtoMessage() {}
+
+func (x *CancelPosStockTakeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_take_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPosStockTakeRequest.ProtoReflect.Descriptor instead.
+func (*CancelPosStockTakeRequest) Descriptor() ([]byte, []int) {
+	return file_stock_take_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CancelPosStockTakeRequest) GetStockTakeId() string {
+	if x != nil {
+		return x.StockTakeId
+	}
+	return ""
+}
+
+func (x *CancelPosStockTakeRequest) GetJwtPayload() *JWTPayload {
+	if x != nil {
+		return x.JwtPayload
+	}
+	return nil
+}
+
+func (x *CancelPosStockTakeRequest) GetJwtToken() string {
+	if x != nil {
+		return x.JwtToken
+	}
+	return ""
+}
+
+type CancelPosStockTakeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PosStockTake *PosStockTake `protobuf:"bytes,1,opt,name=pos_stock_take,json=posStockTake,proto3" json:"pos_stock_take,omitempty"`
+}
+
+func (x *CancelPosStockTakeResponse) Reset() {
+	*x = CancelPosStockTakeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stock_take_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelPosStockTakeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPosStockTakeResponse) ProtoMessage() {}
+
+func (x *CancelPosStockTakeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_take_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPosStockTakeResponse.ProtoReflect.Descriptor instead.
+func (*CancelPosStockTakeResponse) Descriptor() ([]byte, []int) {
+	return file_stock_take_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CancelPosStockTakeResponse) GetPosStockTake() *PosStockTake {
+	if x != nil {
+		return x.PosStockTake
+	}
+	return nil
+}
+
+type ReadAllPosStockTakesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit      int32       `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page       int32       `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	JwtPayload *JWTPayload `protobuf:"bytes,3,opt,name=jwt_payload,json=jwtPayload,proto3" json:"jwt_payload,omitempty"`
+	JwtToken   string      `protobuf:"bytes,4,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+}
+
+func (x *ReadAllPosStockTakesRequest) Reset() {
+	*x = ReadAllPosStockTakesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stock_take_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadAllPosStockTakesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAllPosStockTakesRequest) ProtoMessage() {}
+
+func (x *ReadAllPosStockTakesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_take_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAllPosStockTakesRequest.ProtoReflect.Descriptor instead.
+func (*ReadAllPosStockTakesRequest) Descriptor() ([]byte, []int) {
+	return file_stock_take_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReadAllPosStockTakesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ReadAllPosStockTakesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ReadAllPosStockTakesRequest) GetJwtPayload() *JWTPayload {
+	if x != nil {
+		return x.JwtPayload
+	}
+	return nil
+}
+
+func (x *ReadAllPosStockTakesRequest) GetJwtToken() string {
+	if x != nil {
+		return x.JwtToken
+	}
+	return ""
+}
+
+type ReadAllPosStockTakesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PosStockTakes []*PosStockTake `protobuf:"bytes,1,rep,name=pos_stock_takes,json=posStockTakes,proto3" json:"pos_stock_takes,omitempty"`
+	Limit         int32           `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32           `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	MaxPage       int32           `protobuf:"varint,4,opt,name=max_page,json=maxPage,proto3" json:"max_page,omitempty"`
+	Count         int64           `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ReadAllPosStockTakesResponse) Reset() {
+	*x = ReadAllPosStockTakesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stock_take_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadAllPosStockTakesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAllPosStockTakesResponse) ProtoMessage() {}
+
+func (x *ReadAllPosStockTakesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_take_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAllPosStockTakesResponse.ProtoReflect.Descriptor instead.
+func (*ReadAllPosStockTakesResponse) Descriptor() ([]byte, []int) {
+	return file_stock_take_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReadAllPosStockTakesResponse) GetPosStockTakes() []*PosStockTake {
+	if x != nil {
+		return x.PosStockTakes
+	}
+	return nil
+}
+
+func (x *ReadAllPosStockTakesResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ReadAllPosStockTakesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ReadAllPosStockTakesResponse) GetMaxPage() int32 {
+	if x != nil {
+		return x.MaxPage
+	}
+	return 0
+}
+
+func (x *ReadAllPosStockTakesResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_stock_take_proto protoreflect.FileDescriptor
+
+var file_stock_take_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x03, 0x70, 0x6f, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xce, 0x02, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x53, 0x74,
+	0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2b, 0x0a, 0x12, 0x73,
+	0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61,
+	0x6b, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x63,
+	0x6b, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0xab, 0x04, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x53,
+	0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x63,
+	0x6b, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73,
+	0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
+	0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49,
+	0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0x8b, 0x01, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x42,
+	0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x22, 0xca, 0x02, 0x0a, 0x14, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b,
+	0x54, 0x61, 0x6b, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b,
+	0x0a, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x51, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x63, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0c, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x73, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x22, 0xa3, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x74,
+	0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37,
+	0x0a, 0x0e, 0x70, 0x6f, 0x73, 0x5f, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x61, 0x6b, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73,
+	0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x52, 0x0c, 0x70, 0x6f, 0x73, 0x53, 0x74,
+	0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a,
+	0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x55, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0e, 0x70, 0x6f, 0x73, 0x5f, 0x73, 0x74, 0x6f, 0x63,
+	0x6b, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
+	0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x52,
+	0x0c, 0x70, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x22, 0x8c, 0x01,
+	0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61,
+	0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x74, 0x6f,
+	0x63, 0x6b, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x30, 0x0a,
+	0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x53, 0x0a, 0x18,
+	0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0e, 0x70, 0x6f, 0x73, 0x5f,
+	0x73, 0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54,
+	0x61, 0x6b, 0x65, 0x52, 0x0c, 0x70, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b,
+	0x65, 0x22, 0xe4, 0x01, 0x0a, 0x1f, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x53,
+	0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x74,
+	0x61, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74,
+	0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6f, 0x73, 0x2e,
+	0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x63,
+	0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61,
+	0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74,
+	0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52,
+	0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a,
+	0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5b, 0x0a, 0x20, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0e,
+	0x70, 0x6f, 0x73, 0x5f, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x53, 0x74,
+	0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x52, 0x0c, 0x70, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63,
+	0x6b, 0x54, 0x61, 0x6b, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x1f, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f,
+	0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x74, 0x6f,
+	0x63, 0x6b, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x30, 0x0a,
+	0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe7, 0x01, 0x0a,
+	0x20, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b,
+	0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x54,
+	0x61, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50,
+	0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x36,
+	0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x51, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x63, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x1a, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x74,
+	0x61, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74,
+	0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74,
+	0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52,
+	0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a,
+	0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x56, 0x0a, 0x1b, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0e, 0x70, 0x6f, 0x73, 0x5f, 0x73,
+	0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61,
+	0x6b, 0x65, 0x52, 0x0c, 0x70, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65,
+	0x22, 0x8e, 0x01, 0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x6f, 0x73, 0x53, 0x74,
+	0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22,
+	0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65,
+	0x49, 0x64, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57,
+	0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x55, 0x0a, 0x1a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x6f, 0x73, 0x53, 0x74,
+	0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x37, 0x0a, 0x0e, 0x70, 0x6f, 0x73, 0x5f, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x61, 0x6b,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x50, 0x6f,
+	0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x52, 0x0c, 0x70, 0x6f, 0x73, 0x53,
+	0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x61,
+	0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x4a, 0x57,
+	0x54, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0xb4, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73,
+	0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x39, 0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x5f, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x5f,
+	0x74, 0x61, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f,
+	0x73, 0x2e, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x52, 0x0d,
+	0x70, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x50, 0x61,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x9d, 0x05, 0x0a, 0x13, 0x50, 0x6f, 0x73,
+	0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x55, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x50,
+	0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x6f,
+	0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61,
+	0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x73, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x6f, 0x73,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54,
+	0x61, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x67, 0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63,
+	0x6b, 0x54, 0x61, 0x6b, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x2e,
+	0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b,
+	0x54, 0x61, 0x6b, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f,
+	0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x13, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b,
+	0x65, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x50,
+	0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x6f,
+	0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x6f, 0x73,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54,
+	0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x6f, 0x73,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54,
+	0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x14, 0x52,
+	0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61,
+	0x6b, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c,
+	0x6c, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x6b, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x6e, 0x64, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x69,
+	0x66, 0x62, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d, 0x70, 0x6f, 0x73, 0x2d, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x2d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_stock_take_proto_rawDescOnce sync.Once
+	file_stock_take_proto_rawDescData = file_stock_take_proto_rawDesc
+)
+
+func file_stock_take_proto_rawDescGZIP() []byte {
+	file_stock_take_proto_rawDescOnce.Do(func() {
+		file_stock_take_proto_rawDescData = protoimpl.X.CompressGZIP(file_stock_take_proto_rawDescData)
+	})
+	return file_stock_take_proto_rawDescData
+}
+
+var file_stock_take_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_stock_take_proto_goTypes = []interface{}{
+	(*PosStockTakeItem)(nil),                 // 0: pos.PosStockTakeItem
+	(*PosStockTake)(nil),                     // 1: pos.PosStockTake
+	(*PosStockTakeCount)(nil),                // 2: pos.PosStockTakeCount
+	(*PosStockTakeVariance)(nil),             // 3: pos.PosStockTakeVariance
+	(*CreatePosStockTakeRequest)(nil),        // 4: pos.CreatePosStockTakeRequest
+	(*CreatePosStockTakeResponse)(nil),       // 5: pos.CreatePosStockTakeResponse
+	(*ReadPosStockTakeRequest)(nil),          // 6: pos.ReadPosStockTakeRequest
+	(*ReadPosStockTakeResponse)(nil),         // 7: pos.ReadPosStockTakeResponse
+	(*SubmitPosStockTakeCountsRequest)(nil),  // 8: pos.SubmitPosStockTakeCountsRequest
+	(*SubmitPosStockTakeCountsResponse)(nil), // 9: pos.SubmitPosStockTakeCountsResponse
+	(*ReadPosStockTakeVarianceRequest)(nil),  // 10: pos.ReadPosStockTakeVarianceRequest
+	(*ReadPosStockTakeVarianceResponse)(nil), // 11: pos.ReadPosStockTakeVarianceResponse
+	(*ApprovePosStockTakeRequest)(nil),       // 12: pos.ApprovePosStockTakeRequest
+	(*ApprovePosStockTakeResponse)(nil),      // 13: pos.ApprovePosStockTakeResponse
+	(*CancelPosStockTakeRequest)(nil),        // 14: pos.CancelPosStockTakeRequest
+	(*CancelPosStockTakeResponse)(nil),       // 15: pos.CancelPosStockTakeResponse
+	(*ReadAllPosStockTakesRequest)(nil),      // 16: pos.ReadAllPosStockTakesRequest
+	(*ReadAllPosStockTakesResponse)(nil),     // 17: pos.ReadAllPosStockTakesResponse
+	(*timestamppb.Timestamp)(nil),            // 18: google.protobuf.Timestamp
+	(*JWTPayload)(nil),                       // 19: pos.JWTPayload
+}
+var file_stock_take_proto_depIdxs = []int32{
+	18, // 0: pos.PosStockTakeItem.counted_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: pos.PosStockTake.items:type_name -> pos.PosStockTakeItem
+	18, // 2: pos.PosStockTake.approved_at:type_name -> google.protobuf.Timestamp
+	18, // 3: pos.PosStockTake.created_at:type_name -> google.protobuf.Timestamp
+	18, // 4: pos.PosStockTake.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 5: pos.CreatePosStockTakeRequest.pos_stock_take:type_name -> pos.PosStockTake
+	19, // 6: pos.CreatePosStockTakeRequest.jwt_payload:type_name -> pos.JWTPayload
+	1,  // 7: pos.CreatePosStockTakeResponse.pos_stock_take:type_name -> pos.PosStockTake
+	19, // 8: pos.ReadPosStockTakeRequest.jwt_payload:type_name -> pos.JWTPayload
+	1,  // 9: pos.ReadPosStockTakeResponse.pos_stock_take:type_name -> pos.PosStockTake
+	2,  // 10: pos.SubmitPosStockTakeCountsRequest.counts:type_name -> pos.PosStockTakeCount
+	19, // 11: pos.SubmitPosStockTakeCountsRequest.jwt_payload:type_name -> pos.JWTPayload
+	1,  // 12: pos.SubmitPosStockTakeCountsResponse.pos_stock_take:type_name -> pos.PosStockTake
+	19, // 13: pos.ReadPosStockTakeVarianceRequest.jwt_payload:type_name -> pos.JWTPayload
+	3,  // 14: pos.ReadPosStockTakeVarianceResponse.variances:type_name -> pos.PosStockTakeVariance
+	19, // 15: pos.ApprovePosStockTakeRequest.jwt_payload:type_name -> pos.JWTPayload
+	1,  // 16: pos.ApprovePosStockTakeResponse.pos_stock_take:type_name -> pos.PosStockTake
+	19, // 17: pos.CancelPosStockTakeRequest.jwt_payload:type_name -> pos.JWTPayload
+	1,  // 18: pos.CancelPosStockTakeResponse.pos_stock_take:type_name -> pos.PosStockTake
+	19, // 19: pos.ReadAllPosStockTakesRequest.jwt_payload:type_name -> pos.JWTPayload
+	1,  // 20: pos.ReadAllPosStockTakesResponse.pos_stock_takes:type_name -> pos.PosStockTake
+	4,  // 21: pos.PosStockTakeService.CreatePosStockTake:input_type -> pos.CreatePosStockTakeRequest
+	6,  // 22: pos.PosStockTakeService.ReadPosStockTake:input_type -> pos.ReadPosStockTakeRequest
+	8,  // 23: pos.PosStockTakeService.SubmitPosStockTakeCounts:input_type -> pos.SubmitPosStockTakeCountsRequest
+	10, // 24: pos.PosStockTakeService.ReadPosStockTakeVariance:input_type -> pos.ReadPosStockTakeVarianceRequest
+	12, // 25: pos.PosStockTakeService.ApprovePosStockTake:input_type -> pos.ApprovePosStockTakeRequest
+	14, // 26: pos.PosStockTakeService.CancelPosStockTake:input_type -> pos.CancelPosStockTakeRequest
+	16, // 27: pos.PosStockTakeService.ReadAllPosStockTakes:input_type -> pos.ReadAllPosStockTakesRequest
+	5,  // 28: pos.PosStockTakeService.CreatePosStockTake:output_type -> pos.CreatePosStockTakeResponse
+	7,  // 29: pos.PosStockTakeService.ReadPosStockTake:output_type -> pos.ReadPosStockTakeResponse
+	9,  // 30: pos.PosStockTakeService.SubmitPosStockTakeCounts:output_type -> pos.SubmitPosStockTakeCountsResponse
+	11, // 31: pos.PosStockTakeService.ReadPosStockTakeVariance:output_type -> pos.ReadPosStockTakeVarianceResponse
+	13, // 32: pos.PosStockTakeService.ApprovePosStockTake:output_type -> pos.ApprovePosStockTakeResponse
+	15, // 33: pos.PosStockTakeService.CancelPosStockTake:output_type -> pos.CancelPosStockTakeResponse
+	17, // 34: pos.PosStockTakeService.ReadAllPosStockTakes:output_type -> pos.ReadAllPosStockTakesResponse
+	28, // [28:35] is the sub-list for method output_type
+	21, // [21:28] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_stock_take_proto_init() }
+func file_stock_take_proto_init() {
+	if File_stock_take_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_stock_take_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PosStockTakeItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stock_take_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PosStockTake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stock_take_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PosStockTakeCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stock_take_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PosStockTakeVariance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stock_take_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePosStockTakeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stock_take_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePosStockTakeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stock_take_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadPosStockTakeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stock_take_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadPosStockTakeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stock_take_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitPosStockTakeCountsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stock_take_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitPosStockTakeCountsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stock_take_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadPosStockTakeVarianceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stock_take_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadPosStockTakeVarianceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stock_take_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApprovePosStockTakeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stock_take_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApprovePosStockTakeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stock_take_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelPosStockTakeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stock_take_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelPosStockTakeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stock_take_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadAllPosStockTakesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stock_take_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadAllPosStockTakesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_stock_take_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_stock_take_proto_goTypes,
+		DependencyIndexes: file_stock_take_proto_depIdxs,
+		MessageInfos:      file_stock_take_proto_msgTypes,
+	}.Build()
+	File_stock_take_proto = out.File
+	file_stock_take_proto_rawDesc = nil
+	file_stock_take_proto_goTypes = nil
+	file_stock_take_proto_depIdxs = nil
+}
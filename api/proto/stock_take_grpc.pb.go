@@ -0,0 +1,321 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: stock_take.proto
+
+package alpha_pos_system_product_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosStockTakeServiceClient is the client API for PosStockTakeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosStockTakeServiceClient interface {
+	CreatePosStockTake(ctx context.Context, in *CreatePosStockTakeRequest, opts ...grpc.CallOption) (*CreatePosStockTakeResponse, error)
+	ReadPosStockTake(ctx context.Context, in *ReadPosStockTakeRequest, opts ...grpc.CallOption) (*ReadPosStockTakeResponse, error)
+	SubmitPosStockTakeCounts(ctx context.Context, in *SubmitPosStockTakeCountsRequest, opts ...grpc.CallOption) (*SubmitPosStockTakeCountsResponse, error)
+	ReadPosStockTakeVariance(ctx context.Context, in *ReadPosStockTakeVarianceRequest, opts ...grpc.CallOption) (*ReadPosStockTakeVarianceResponse, error)
+	ApprovePosStockTake(ctx context.Context, in *ApprovePosStockTakeRequest, opts ...grpc.CallOption) (*ApprovePosStockTakeResponse, error)
+	CancelPosStockTake(ctx context.Context, in *CancelPosStockTakeRequest, opts ...grpc.CallOption) (*CancelPosStockTakeResponse, error)
+	ReadAllPosStockTakes(ctx context.Context, in *ReadAllPosStockTakesRequest, opts ...grpc.CallOption) (*ReadAllPosStockTakesResponse, error)
+}
+
+type posStockTakeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosStockTakeServiceClient(cc grpc.ClientConnInterface) PosStockTakeServiceClient {
+	return &posStockTakeServiceClient{cc}
+}
+
+func (c *posStockTakeServiceClient) CreatePosStockTake(ctx context.Context, in *CreatePosStockTakeRequest, opts ...grpc.CallOption) (*CreatePosStockTakeResponse, error) {
+	out := new(CreatePosStockTakeResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStockTakeService/CreatePosStockTake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posStockTakeServiceClient) ReadPosStockTake(ctx context.Context, in *ReadPosStockTakeRequest, opts ...grpc.CallOption) (*ReadPosStockTakeResponse, error) {
+	out := new(ReadPosStockTakeResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStockTakeService/ReadPosStockTake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posStockTakeServiceClient) SubmitPosStockTakeCounts(ctx context.Context, in *SubmitPosStockTakeCountsRequest, opts ...grpc.CallOption) (*SubmitPosStockTakeCountsResponse, error) {
+	out := new(SubmitPosStockTakeCountsResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStockTakeService/SubmitPosStockTakeCounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posStockTakeServiceClient) ReadPosStockTakeVariance(ctx context.Context, in *ReadPosStockTakeVarianceRequest, opts ...grpc.CallOption) (*ReadPosStockTakeVarianceResponse, error) {
+	out := new(ReadPosStockTakeVarianceResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStockTakeService/ReadPosStockTakeVariance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posStockTakeServiceClient) ApprovePosStockTake(ctx context.Context, in *ApprovePosStockTakeRequest, opts ...grpc.CallOption) (*ApprovePosStockTakeResponse, error) {
+	out := new(ApprovePosStockTakeResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStockTakeService/ApprovePosStockTake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posStockTakeServiceClient) CancelPosStockTake(ctx context.Context, in *CancelPosStockTakeRequest, opts ...grpc.CallOption) (*CancelPosStockTakeResponse, error) {
+	out := new(CancelPosStockTakeResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStockTakeService/CancelPosStockTake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posStockTakeServiceClient) ReadAllPosStockTakes(ctx context.Context, in *ReadAllPosStockTakesRequest, opts ...grpc.CallOption) (*ReadAllPosStockTakesResponse, error) {
+	out := new(ReadAllPosStockTakesResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStockTakeService/ReadAllPosStockTakes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosStockTakeServiceServer is the server API for PosStockTakeService service.
+// All implementations must embed UnimplementedPosStockTakeServiceServer
+// for forward compatibility
+type PosStockTakeServiceServer interface {
+	CreatePosStockTake(context.Context, *CreatePosStockTakeRequest) (*CreatePosStockTakeResponse, error)
+	ReadPosStockTake(context.Context, *ReadPosStockTakeRequest) (*ReadPosStockTakeResponse, error)
+	SubmitPosStockTakeCounts(context.Context, *SubmitPosStockTakeCountsRequest) (*SubmitPosStockTakeCountsResponse, error)
+	ReadPosStockTakeVariance(context.Context, *ReadPosStockTakeVarianceRequest) (*ReadPosStockTakeVarianceResponse, error)
+	ApprovePosStockTake(context.Context, *ApprovePosStockTakeRequest) (*ApprovePosStockTakeResponse, error)
+	CancelPosStockTake(context.Context, *CancelPosStockTakeRequest) (*CancelPosStockTakeResponse, error)
+	ReadAllPosStockTakes(context.Context, *ReadAllPosStockTakesRequest) (*ReadAllPosStockTakesResponse, error)
+	mustEmbedUnimplementedPosStockTakeServiceServer()
+}
+
+// UnimplementedPosStockTakeServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosStockTakeServiceServer struct {
+}
+
+func (UnimplementedPosStockTakeServiceServer) CreatePosStockTake(context.Context, *CreatePosStockTakeRequest) (*CreatePosStockTakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePosStockTake not implemented")
+}
+func (UnimplementedPosStockTakeServiceServer) ReadPosStockTake(context.Context, *ReadPosStockTakeRequest) (*ReadPosStockTakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadPosStockTake not implemented")
+}
+func (UnimplementedPosStockTakeServiceServer) SubmitPosStockTakeCounts(context.Context, *SubmitPosStockTakeCountsRequest) (*SubmitPosStockTakeCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPosStockTakeCounts not implemented")
+}
+func (UnimplementedPosStockTakeServiceServer) ReadPosStockTakeVariance(context.Context, *ReadPosStockTakeVarianceRequest) (*ReadPosStockTakeVarianceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadPosStockTakeVariance not implemented")
+}
+func (UnimplementedPosStockTakeServiceServer) ApprovePosStockTake(context.Context, *ApprovePosStockTakeRequest) (*ApprovePosStockTakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovePosStockTake not implemented")
+}
+func (UnimplementedPosStockTakeServiceServer) CancelPosStockTake(context.Context, *CancelPosStockTakeRequest) (*CancelPosStockTakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPosStockTake not implemented")
+}
+func (UnimplementedPosStockTakeServiceServer) ReadAllPosStockTakes(context.Context, *ReadAllPosStockTakesRequest) (*ReadAllPosStockTakesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAllPosStockTakes not implemented")
+}
+func (UnimplementedPosStockTakeServiceServer) mustEmbedUnimplementedPosStockTakeServiceServer() {}
+
+// UnsafePosStockTakeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosStockTakeServiceServer will
+// result in compilation errors.
+type UnsafePosStockTakeServiceServer interface {
+	mustEmbedUnimplementedPosStockTakeServiceServer()
+}
+
+func RegisterPosStockTakeServiceServer(s grpc.ServiceRegistrar, srv PosStockTakeServiceServer) {
+	s.RegisterService(&PosStockTakeService_ServiceDesc, srv)
+}
+
+func _PosStockTakeService_CreatePosStockTake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePosStockTakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStockTakeServiceServer).CreatePosStockTake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStockTakeService/CreatePosStockTake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStockTakeServiceServer).CreatePosStockTake(ctx, req.(*CreatePosStockTakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosStockTakeService_ReadPosStockTake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPosStockTakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStockTakeServiceServer).ReadPosStockTake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStockTakeService/ReadPosStockTake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStockTakeServiceServer).ReadPosStockTake(ctx, req.(*ReadPosStockTakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosStockTakeService_SubmitPosStockTakeCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitPosStockTakeCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStockTakeServiceServer).SubmitPosStockTakeCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStockTakeService/SubmitPosStockTakeCounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStockTakeServiceServer).SubmitPosStockTakeCounts(ctx, req.(*SubmitPosStockTakeCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosStockTakeService_ReadPosStockTakeVariance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPosStockTakeVarianceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStockTakeServiceServer).ReadPosStockTakeVariance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStockTakeService/ReadPosStockTakeVariance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStockTakeServiceServer).ReadPosStockTakeVariance(ctx, req.(*ReadPosStockTakeVarianceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosStockTakeService_ApprovePosStockTake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApprovePosStockTakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStockTakeServiceServer).ApprovePosStockTake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStockTakeService/ApprovePosStockTake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStockTakeServiceServer).ApprovePosStockTake(ctx, req.(*ApprovePosStockTakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosStockTakeService_CancelPosStockTake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPosStockTakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStockTakeServiceServer).CancelPosStockTake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStockTakeService/CancelPosStockTake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStockTakeServiceServer).CancelPosStockTake(ctx, req.(*CancelPosStockTakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosStockTakeService_ReadAllPosStockTakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAllPosStockTakesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStockTakeServiceServer).ReadAllPosStockTakes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStockTakeService/ReadAllPosStockTakes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStockTakeServiceServer).ReadAllPosStockTakes(ctx, req.(*ReadAllPosStockTakesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosStockTakeService_ServiceDesc is the grpc.ServiceDesc for PosStockTakeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosStockTakeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosStockTakeService",
+	HandlerType: (*PosStockTakeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePosStockTake",
+			Handler:    _PosStockTakeService_CreatePosStockTake_Handler,
+		},
+		{
+			MethodName: "ReadPosStockTake",
+			Handler:    _PosStockTakeService_ReadPosStockTake_Handler,
+		},
+		{
+			MethodName: "SubmitPosStockTakeCounts",
+			Handler:    _PosStockTakeService_SubmitPosStockTakeCounts_Handler,
+		},
+		{
+			MethodName: "ReadPosStockTakeVariance",
+			Handler:    _PosStockTakeService_ReadPosStockTakeVariance_Handler,
+		},
+		{
+			MethodName: "ApprovePosStockTake",
+			Handler:    _PosStockTakeService_ApprovePosStockTake_Handler,
+		},
+		{
+			MethodName: "CancelPosStockTake",
+			Handler:    _PosStockTakeService_CancelPosStockTake_Handler,
+		},
+		{
+			MethodName: "ReadAllPosStockTakes",
+			Handler:    _PosStockTakeService_ReadAllPosStockTakes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stock_take.proto",
+}
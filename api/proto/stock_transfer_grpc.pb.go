@@ -0,0 +1,286 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: stock_transfer.proto
+
+package alpha_pos_system_product_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosStockTransferServiceClient is the client API for PosStockTransferService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosStockTransferServiceClient interface {
+	CreatePosStockTransfer(ctx context.Context, in *CreatePosStockTransferRequest, opts ...grpc.CallOption) (*CreatePosStockTransferResponse, error)
+	ReadPosStockTransfer(ctx context.Context, in *ReadPosStockTransferRequest, opts ...grpc.CallOption) (*ReadPosStockTransferResponse, error)
+	DispatchPosStockTransfer(ctx context.Context, in *DispatchPosStockTransferRequest, opts ...grpc.CallOption) (*DispatchPosStockTransferResponse, error)
+	ReceivePosStockTransfer(ctx context.Context, in *ReceivePosStockTransferRequest, opts ...grpc.CallOption) (*ReceivePosStockTransferResponse, error)
+	CancelPosStockTransfer(ctx context.Context, in *CancelPosStockTransferRequest, opts ...grpc.CallOption) (*CancelPosStockTransferResponse, error)
+	ReadAllPosStockTransfers(ctx context.Context, in *ReadAllPosStockTransfersRequest, opts ...grpc.CallOption) (*ReadAllPosStockTransfersResponse, error)
+}
+
+type posStockTransferServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosStockTransferServiceClient(cc grpc.ClientConnInterface) PosStockTransferServiceClient {
+	return &posStockTransferServiceClient{cc}
+}
+
+func (c *posStockTransferServiceClient) CreatePosStockTransfer(ctx context.Context, in *CreatePosStockTransferRequest, opts ...grpc.CallOption) (*CreatePosStockTransferResponse, error) {
+	out := new(CreatePosStockTransferResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStockTransferService/CreatePosStockTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posStockTransferServiceClient) ReadPosStockTransfer(ctx context.Context, in *ReadPosStockTransferRequest, opts ...grpc.CallOption) (*ReadPosStockTransferResponse, error) {
+	out := new(ReadPosStockTransferResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStockTransferService/ReadPosStockTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posStockTransferServiceClient) DispatchPosStockTransfer(ctx context.Context, in *DispatchPosStockTransferRequest, opts ...grpc.CallOption) (*DispatchPosStockTransferResponse, error) {
+	out := new(DispatchPosStockTransferResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStockTransferService/DispatchPosStockTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posStockTransferServiceClient) ReceivePosStockTransfer(ctx context.Context, in *ReceivePosStockTransferRequest, opts ...grpc.CallOption) (*ReceivePosStockTransferResponse, error) {
+	out := new(ReceivePosStockTransferResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStockTransferService/ReceivePosStockTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posStockTransferServiceClient) CancelPosStockTransfer(ctx context.Context, in *CancelPosStockTransferRequest, opts ...grpc.CallOption) (*CancelPosStockTransferResponse, error) {
+	out := new(CancelPosStockTransferResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStockTransferService/CancelPosStockTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *posStockTransferServiceClient) ReadAllPosStockTransfers(ctx context.Context, in *ReadAllPosStockTransfersRequest, opts ...grpc.CallOption) (*ReadAllPosStockTransfersResponse, error) {
+	out := new(ReadAllPosStockTransfersResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStockTransferService/ReadAllPosStockTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosStockTransferServiceServer is the server API for PosStockTransferService service.
+// All implementations must embed UnimplementedPosStockTransferServiceServer
+// for forward compatibility
+type PosStockTransferServiceServer interface {
+	CreatePosStockTransfer(context.Context, *CreatePosStockTransferRequest) (*CreatePosStockTransferResponse, error)
+	ReadPosStockTransfer(context.Context, *ReadPosStockTransferRequest) (*ReadPosStockTransferResponse, error)
+	DispatchPosStockTransfer(context.Context, *DispatchPosStockTransferRequest) (*DispatchPosStockTransferResponse, error)
+	ReceivePosStockTransfer(context.Context, *ReceivePosStockTransferRequest) (*ReceivePosStockTransferResponse, error)
+	CancelPosStockTransfer(context.Context, *CancelPosStockTransferRequest) (*CancelPosStockTransferResponse, error)
+	ReadAllPosStockTransfers(context.Context, *ReadAllPosStockTransfersRequest) (*ReadAllPosStockTransfersResponse, error)
+	mustEmbedUnimplementedPosStockTransferServiceServer()
+}
+
+// UnimplementedPosStockTransferServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosStockTransferServiceServer struct {
+}
+
+func (UnimplementedPosStockTransferServiceServer) CreatePosStockTransfer(context.Context, *CreatePosStockTransferRequest) (*CreatePosStockTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePosStockTransfer not implemented")
+}
+func (UnimplementedPosStockTransferServiceServer) ReadPosStockTransfer(context.Context, *ReadPosStockTransferRequest) (*ReadPosStockTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadPosStockTransfer not implemented")
+}
+func (UnimplementedPosStockTransferServiceServer) DispatchPosStockTransfer(context.Context, *DispatchPosStockTransferRequest) (*DispatchPosStockTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DispatchPosStockTransfer not implemented")
+}
+func (UnimplementedPosStockTransferServiceServer) ReceivePosStockTransfer(context.Context, *ReceivePosStockTransferRequest) (*ReceivePosStockTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceivePosStockTransfer not implemented")
+}
+func (UnimplementedPosStockTransferServiceServer) CancelPosStockTransfer(context.Context, *CancelPosStockTransferRequest) (*CancelPosStockTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPosStockTransfer not implemented")
+}
+func (UnimplementedPosStockTransferServiceServer) ReadAllPosStockTransfers(context.Context, *ReadAllPosStockTransfersRequest) (*ReadAllPosStockTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAllPosStockTransfers not implemented")
+}
+func (UnimplementedPosStockTransferServiceServer) mustEmbedUnimplementedPosStockTransferServiceServer() {
+}
+
+// UnsafePosStockTransferServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosStockTransferServiceServer will
+// result in compilation errors.
+type UnsafePosStockTransferServiceServer interface {
+	mustEmbedUnimplementedPosStockTransferServiceServer()
+}
+
+func RegisterPosStockTransferServiceServer(s grpc.ServiceRegistrar, srv PosStockTransferServiceServer) {
+	s.RegisterService(&PosStockTransferService_ServiceDesc, srv)
+}
+
+func _PosStockTransferService_CreatePosStockTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePosStockTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStockTransferServiceServer).CreatePosStockTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStockTransferService/CreatePosStockTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStockTransferServiceServer).CreatePosStockTransfer(ctx, req.(*CreatePosStockTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosStockTransferService_ReadPosStockTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPosStockTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStockTransferServiceServer).ReadPosStockTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStockTransferService/ReadPosStockTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStockTransferServiceServer).ReadPosStockTransfer(ctx, req.(*ReadPosStockTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosStockTransferService_DispatchPosStockTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DispatchPosStockTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStockTransferServiceServer).DispatchPosStockTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStockTransferService/DispatchPosStockTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStockTransferServiceServer).DispatchPosStockTransfer(ctx, req.(*DispatchPosStockTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosStockTransferService_ReceivePosStockTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceivePosStockTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStockTransferServiceServer).ReceivePosStockTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStockTransferService/ReceivePosStockTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStockTransferServiceServer).ReceivePosStockTransfer(ctx, req.(*ReceivePosStockTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosStockTransferService_CancelPosStockTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPosStockTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStockTransferServiceServer).CancelPosStockTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStockTransferService/CancelPosStockTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStockTransferServiceServer).CancelPosStockTransfer(ctx, req.(*CancelPosStockTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PosStockTransferService_ReadAllPosStockTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAllPosStockTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStockTransferServiceServer).ReadAllPosStockTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStockTransferService/ReadAllPosStockTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStockTransferServiceServer).ReadAllPosStockTransfers(ctx, req.(*ReadAllPosStockTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosStockTransferService_ServiceDesc is the grpc.ServiceDesc for PosStockTransferService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosStockTransferService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosStockTransferService",
+	HandlerType: (*PosStockTransferServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePosStockTransfer",
+			Handler:    _PosStockTransferService_CreatePosStockTransfer_Handler,
+		},
+		{
+			MethodName: "ReadPosStockTransfer",
+			Handler:    _PosStockTransferService_ReadPosStockTransfer_Handler,
+		},
+		{
+			MethodName: "DispatchPosStockTransfer",
+			Handler:    _PosStockTransferService_DispatchPosStockTransfer_Handler,
+		},
+		{
+			MethodName: "ReceivePosStockTransfer",
+			Handler:    _PosStockTransferService_ReceivePosStockTransfer_Handler,
+		},
+		{
+			MethodName: "CancelPosStockTransfer",
+			Handler:    _PosStockTransferService_CancelPosStockTransfer_Handler,
+		},
+		{
+			MethodName: "ReadAllPosStockTransfers",
+			Handler:    _PosStockTransferService_ReadAllPosStockTransfers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stock_transfer.proto",
+}
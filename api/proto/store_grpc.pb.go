@@ -0,0 +1,105 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: store.proto
+
+package alpha_pos_system_product_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosStoreServiceClient is the client API for PosStoreService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosStoreServiceClient interface {
+	ReadPosStore(ctx context.Context, in *ReadPosStoreRequest, opts ...grpc.CallOption) (*ReadPosStoreResponse, error)
+}
+
+type posStoreServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosStoreServiceClient(cc grpc.ClientConnInterface) PosStoreServiceClient {
+	return &posStoreServiceClient{cc}
+}
+
+func (c *posStoreServiceClient) ReadPosStore(ctx context.Context, in *ReadPosStoreRequest, opts ...grpc.CallOption) (*ReadPosStoreResponse, error) {
+	out := new(ReadPosStoreResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosStoreService/ReadPosStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosStoreServiceServer is the server API for PosStoreService service.
+// All implementations must embed UnimplementedPosStoreServiceServer
+// for forward compatibility
+type PosStoreServiceServer interface {
+	ReadPosStore(context.Context, *ReadPosStoreRequest) (*ReadPosStoreResponse, error)
+	mustEmbedUnimplementedPosStoreServiceServer()
+}
+
+// UnimplementedPosStoreServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosStoreServiceServer struct {
+}
+
+func (UnimplementedPosStoreServiceServer) ReadPosStore(context.Context, *ReadPosStoreRequest) (*ReadPosStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadPosStore not implemented")
+}
+func (UnimplementedPosStoreServiceServer) mustEmbedUnimplementedPosStoreServiceServer() {}
+
+// UnsafePosStoreServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosStoreServiceServer will
+// result in compilation errors.
+type UnsafePosStoreServiceServer interface {
+	mustEmbedUnimplementedPosStoreServiceServer()
+}
+
+func RegisterPosStoreServiceServer(s grpc.ServiceRegistrar, srv PosStoreServiceServer) {
+	s.RegisterService(&PosStoreService_ServiceDesc, srv)
+}
+
+func _PosStoreService_ReadPosStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPosStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosStoreServiceServer).ReadPosStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosStoreService/ReadPosStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosStoreServiceServer).ReadPosStore(ctx, req.(*ReadPosStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosStoreService_ServiceDesc is the grpc.ServiceDesc for PosStoreService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosStoreService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosStoreService",
+	HandlerType: (*PosStoreServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReadPosStore",
+			Handler:    _PosStoreService_ReadPosStore_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "store.proto",
+}
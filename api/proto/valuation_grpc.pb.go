@@ -0,0 +1,106 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: valuation.proto
+
+package alpha_pos_system_product_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PosInventoryValuationServiceClient is the client API for PosInventoryValuationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PosInventoryValuationServiceClient interface {
+	GetPosInventoryValuation(ctx context.Context, in *GetPosInventoryValuationRequest, opts ...grpc.CallOption) (*GetPosInventoryValuationResponse, error)
+}
+
+type posInventoryValuationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPosInventoryValuationServiceClient(cc grpc.ClientConnInterface) PosInventoryValuationServiceClient {
+	return &posInventoryValuationServiceClient{cc}
+}
+
+func (c *posInventoryValuationServiceClient) GetPosInventoryValuation(ctx context.Context, in *GetPosInventoryValuationRequest, opts ...grpc.CallOption) (*GetPosInventoryValuationResponse, error) {
+	out := new(GetPosInventoryValuationResponse)
+	err := c.cc.Invoke(ctx, "/pos.PosInventoryValuationService/GetPosInventoryValuation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PosInventoryValuationServiceServer is the server API for PosInventoryValuationService service.
+// All implementations must embed UnimplementedPosInventoryValuationServiceServer
+// for forward compatibility
+type PosInventoryValuationServiceServer interface {
+	GetPosInventoryValuation(context.Context, *GetPosInventoryValuationRequest) (*GetPosInventoryValuationResponse, error)
+	mustEmbedUnimplementedPosInventoryValuationServiceServer()
+}
+
+// UnimplementedPosInventoryValuationServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPosInventoryValuationServiceServer struct {
+}
+
+func (UnimplementedPosInventoryValuationServiceServer) GetPosInventoryValuation(context.Context, *GetPosInventoryValuationRequest) (*GetPosInventoryValuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPosInventoryValuation not implemented")
+}
+func (UnimplementedPosInventoryValuationServiceServer) mustEmbedUnimplementedPosInventoryValuationServiceServer() {
+}
+
+// UnsafePosInventoryValuationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PosInventoryValuationServiceServer will
+// result in compilation errors.
+type UnsafePosInventoryValuationServiceServer interface {
+	mustEmbedUnimplementedPosInventoryValuationServiceServer()
+}
+
+func RegisterPosInventoryValuationServiceServer(s grpc.ServiceRegistrar, srv PosInventoryValuationServiceServer) {
+	s.RegisterService(&PosInventoryValuationService_ServiceDesc, srv)
+}
+
+func _PosInventoryValuationService_GetPosInventoryValuation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPosInventoryValuationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PosInventoryValuationServiceServer).GetPosInventoryValuation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.PosInventoryValuationService/GetPosInventoryValuation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PosInventoryValuationServiceServer).GetPosInventoryValuation(ctx, req.(*GetPosInventoryValuationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PosInventoryValuationService_ServiceDesc is the grpc.ServiceDesc for PosInventoryValuationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PosInventoryValuationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.PosInventoryValuationService",
+	HandlerType: (*PosInventoryValuationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPosInventoryValuation",
+			Handler:    _PosInventoryValuationService_GetPosInventoryValuation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "valuation.proto",
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 
 	"github.com/Andrewalifb/alpha-pos-system-product-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/midlleware"
 	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
 	"github.com/Andrewalifb/alpha-pos-system-product-service/routes"
 
@@ -38,6 +39,23 @@ func main() {
 	promotionClient := pb.NewPosPromotionServiceClient(conn)
 	productSubCategoryClient := pb.NewPosProductSubCategoryServiceClient(conn)
 	supplierClient := pb.NewPosSupplierServiceClient(conn)
+	stockTransferClient := pb.NewPosStockTransferServiceClient(conn)
+	stockLevelClient := pb.NewPosStockLevelServiceClient(conn)
+	stockTakeClient := pb.NewPosStockTakeServiceClient(conn)
+	lowStockClient := pb.NewPosLowStockServiceClient(conn)
+	purchaseOrderClient := pb.NewPosPurchaseOrderServiceClient(conn)
+	stockLotClient := pb.NewPosStockLotServiceClient(conn)
+	costingSettingClient := pb.NewPosCostingSettingServiceClient(conn)
+	inventoryValuationClient := pb.NewPosInventoryValuationServiceClient(conn)
+	stockReservationClient := pb.NewPosStockReservationServiceClient(conn)
+	serialNumberClient := pb.NewPosSerialNumberServiceClient(conn)
+	productUnitClient := pb.NewPosProductUnitServiceClient(conn)
+	negativeStockClient := pb.NewPosNegativeStockServiceClient(conn)
+	inventoryReconciliationClient := pb.NewPosInventoryReconciliationServiceClient(conn)
+	saleStockClient := pb.NewPosSaleStockServiceClient(conn)
+	productVariantClient := pb.NewPosProductVariantServiceClient(conn)
+	productBarcodeClient := pb.NewPosProductBarcodeServiceClient(conn)
+	embeddedBarcodeRuleClient := pb.NewPosEmbeddedBarcodeRuleServiceClient(conn)
 
 	// Initialize the controllers with the gRPC clients
 	productCategoryCtrl := controller.NewPosProductCategoryController(productCategoryClient)
@@ -46,13 +64,50 @@ func main() {
 	promotionCtrl := controller.NewPosPromotionController(promotionClient)
 	productSubCategoryCtrl := controller.NewPosProductSubCategoryController(productSubCategoryClient)
 	supplierCtrl := controller.NewPosSupplierController(supplierClient)
+	stockTransferCtrl := controller.NewPosStockTransferController(stockTransferClient)
+	stockLevelCtrl := controller.NewPosStockLevelController(stockLevelClient)
+	stockTakeCtrl := controller.NewPosStockTakeController(stockTakeClient)
+	lowStockCtrl := controller.NewPosLowStockController(lowStockClient)
+	purchaseOrderCtrl := controller.NewPosPurchaseOrderController(purchaseOrderClient)
+	stockLotCtrl := controller.NewPosStockLotController(stockLotClient)
+	costingSettingCtrl := controller.NewPosCostingSettingController(costingSettingClient)
+	inventoryValuationCtrl := controller.NewPosInventoryValuationController(inventoryValuationClient)
+	stockReservationCtrl := controller.NewPosStockReservationController(stockReservationClient)
+	serialNumberCtrl := controller.NewPosSerialNumberController(serialNumberClient)
+	productUnitCtrl := controller.NewPosProductUnitController(productUnitClient)
+	negativeStockCtrl := controller.NewPosNegativeStockController(negativeStockClient)
+	inventoryReconciliationCtrl := controller.NewPosInventoryReconciliationController(inventoryReconciliationClient)
+	saleStockCtrl := controller.NewPosSaleStockController(saleStockClient)
+	productVariantCtrl := controller.NewPosProductVariantController(productVariantClient)
+	productBarcodeCtrl := controller.NewPosProductBarcodeController(productBarcodeClient)
+	embeddedBarcodeRuleCtrl := controller.NewPosEmbeddedBarcodeRuleController(embeddedBarcodeRuleClient)
 
 	// Create a new router
 	r := gin.Default()
 
+	// Pass the Idempotency-Key header of every request to the gRPC server
+	r.Use(midlleware.IdempotencyKeyMiddleware())
+
 	// Define your routes
 	routes.PosProductCategoryRoutes(r, productCategoryCtrl)
 	routes.PosInventoryHistoryRoutes(r, inventoryHistoryCtrl)
+	routes.PosStockTransferRoutes(r, stockTransferCtrl)
+	routes.PosStockLevelRoutes(r, stockLevelCtrl)
+	routes.PosStockTakeRoutes(r, stockTakeCtrl)
+	routes.PosLowStockRoutes(r, lowStockCtrl)
+	routes.PosPurchaseOrderRoutes(r, purchaseOrderCtrl)
+	routes.PosStockLotRoutes(r, stockLotCtrl)
+	routes.PosCostingSettingRoutes(r, costingSettingCtrl)
+	routes.PosInventoryValuationRoutes(r, inventoryValuationCtrl)
+	routes.PosStockReservationRoutes(r, stockReservationCtrl)
+	routes.PosSerialNumberRoutes(r, serialNumberCtrl)
+	routes.PosProductUnitRoutes(r, productUnitCtrl)
+	routes.PosNegativeStockRoutes(r, negativeStockCtrl)
+	routes.PosInventoryReconciliationRoutes(r, inventoryReconciliationCtrl)
+	routes.PosSaleStockRoutes(r, saleStockCtrl)
+	routes.PosProductVariantRoutes(r, productVariantCtrl)
+	routes.PosProductBarcodeRoutes(r, productBarcodeCtrl)
+	routes.PosEmbeddedBarcodeRuleRoutes(r, embeddedBarcodeRuleCtrl)
 	routes.PosProductRoutes(r, productCtrl)
 	routes.PosPromotionRoutes(r, promotionCtrl)
 	routes.PosProductSubCategoryRoutes(r, productSubCategoryCtrl)
@@ -5,9 +5,12 @@ import (
 	"log"
 	"net"
 	"os"
+	"strconv"
+	"time"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
 	"github.com/Andrewalifb/alpha-pos-system-product-service/config"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
 	"github.com/Andrewalifb/alpha-pos-system-product-service/pkg/repository"
 	"github.com/Andrewalifb/alpha-pos-system-product-service/pkg/service"
 
@@ -32,17 +35,52 @@ func main() {
 	promotionRepo := repository.NewPosPromotionRepository(dbConfig.SQLDB, dbConfig.RedisDB)
 	productSubCategoryRepo := repository.NewPosProductSubCategoryRepository(dbConfig.SQLDB, dbConfig.RedisDB)
 	supplierRepo := repository.NewPosSupplierRepository(dbConfig.SQLDB, dbConfig.RedisDB)
+	stockTransferRepo := repository.NewPosStockTransferRepository(dbConfig.SQLDB, dbConfig.RedisDB)
+	stockLevelRepo := repository.NewPosStockLevelRepository(dbConfig.SQLDB, dbConfig.RedisDB)
+	stockTakeRepo := repository.NewPosStockTakeRepository(dbConfig.SQLDB, dbConfig.RedisDB)
+	lowStockRepo := repository.NewPosLowStockRepository(dbConfig.SQLDB, dbConfig.RedisDB)
+	purchaseOrderRepo := repository.NewPosPurchaseOrderRepository(dbConfig.SQLDB, dbConfig.RedisDB)
+	stockLotRepo := repository.NewPosStockLotRepository(dbConfig.SQLDB, dbConfig.RedisDB)
+	costingSettingRepo := repository.NewPosCostingSettingRepository(dbConfig.SQLDB, dbConfig.RedisDB)
+	inventoryValuationRepo := repository.NewPosInventoryValuationRepository(dbConfig.SQLDB, dbConfig.RedisDB)
+	stockReservationRepo := repository.NewPosStockReservationRepository(dbConfig.SQLDB, dbConfig.RedisDB)
+	serialNumberRepo := repository.NewPosSerialNumberRepository(dbConfig.SQLDB, dbConfig.RedisDB)
+	productUnitRepo := repository.NewPosProductUnitRepository(dbConfig.SQLDB, dbConfig.RedisDB)
+	negativeStockRepo := repository.NewPosNegativeStockRepository(dbConfig.SQLDB, dbConfig.RedisDB)
+	inventoryReconciliationRepo := repository.NewPosInventoryReconciliationRepository(dbConfig.SQLDB, dbConfig.RedisDB)
+	idempotencyRepo := repository.NewPosIdempotencyRepository(dbConfig.SQLDB, dbConfig.RedisDB)
+	saleStockRepo := repository.NewPosSaleStockRepository(dbConfig.SQLDB, dbConfig.RedisDB)
+	productVariantRepo := repository.NewPosProductVariantRepository(dbConfig.SQLDB, dbConfig.RedisDB)
+	productBarcodeRepo := repository.NewPosProductBarcodeRepository(dbConfig.SQLDB, dbConfig.RedisDB)
+	embeddedBarcodeRuleRepo := repository.NewPosEmbeddedBarcodeRuleRepository(dbConfig.SQLDB, dbConfig.RedisDB)
 
 	// Initialize the services
 	productCategorySvc := service.NewPosProductCategoryService(productCategoryRepo, grpcConfig.CompanyServiceConn)
 	inventoryHistorySvc := service.NewPosInventoryHistoryService(inventoryHistoryRepo, productRepo, grpcConfig.CompanyServiceConn)
-	productSvc := service.NewPosProductService(productRepo, supplierRepo, productCategoryRepo, productSubCategoryRepo, grpcConfig.CompanyServiceConn)
+	productSvc := service.NewPosProductService(productRepo, supplierRepo, productCategoryRepo, productSubCategoryRepo, stockLevelRepo, stockReservationRepo, productVariantRepo, grpcConfig.CompanyServiceConn)
 	promotionSvc := service.NewPosPromotionService(promotionRepo, grpcConfig.CompanyServiceConn)
 	productSubCategorySvc := service.NewPosProductSubCategoryService(productSubCategoryRepo, productCategoryRepo, grpcConfig.CompanyServiceConn)
 	supplierSvc := service.NewPosSupplierService(supplierRepo, grpcConfig.CompanyServiceConn)
+	stockTransferSvc := service.NewPosStockTransferService(stockTransferRepo, productRepo, grpcConfig.CompanyServiceConn)
+	stockLevelSvc := service.NewPosStockLevelService(stockLevelRepo, productRepo, grpcConfig.CompanyServiceConn)
+	stockTakeSvc := service.NewPosStockTakeService(stockTakeRepo, productRepo, productCategoryRepo, grpcConfig.CompanyServiceConn)
+	lowStockSvc := service.NewPosLowStockService(lowStockRepo, grpcConfig.CompanyServiceConn)
+	purchaseOrderSvc := service.NewPosPurchaseOrderService(purchaseOrderRepo, productRepo, supplierRepo, grpcConfig.CompanyServiceConn)
+	stockLotSvc := service.NewPosStockLotService(stockLotRepo, grpcConfig.CompanyServiceConn)
+	costingSettingSvc := service.NewPosCostingSettingService(costingSettingRepo, grpcConfig.CompanyServiceConn)
+	inventoryValuationSvc := service.NewPosInventoryValuationService(inventoryValuationRepo, grpcConfig.CompanyServiceConn)
+	stockReservationSvc := service.NewPosStockReservationService(stockReservationRepo, productRepo, grpcConfig.CompanyServiceConn)
+	serialNumberSvc := service.NewPosSerialNumberService(serialNumberRepo, grpcConfig.CompanyServiceConn)
+	productUnitSvc := service.NewPosProductUnitService(productUnitRepo, productRepo, grpcConfig.CompanyServiceConn)
+	negativeStockSvc := service.NewPosNegativeStockService(negativeStockRepo, grpcConfig.CompanyServiceConn)
+	inventoryReconciliationSvc := service.NewPosInventoryReconciliationService(inventoryReconciliationRepo, grpcConfig.CompanyServiceConn)
+	saleStockSvc := service.NewPosSaleStockService(saleStockRepo, grpcConfig.CompanyServiceConn)
+	productVariantSvc := service.NewPosProductVariantService(productVariantRepo, productRepo, grpcConfig.CompanyServiceConn)
+	productBarcodeSvc := service.NewPosProductBarcodeService(productBarcodeRepo, productRepo, grpcConfig.CompanyServiceConn)
+	embeddedBarcodeRuleSvc := service.NewPosEmbeddedBarcodeRuleService(embeddedBarcodeRuleRepo, grpcConfig.CompanyServiceConn)
 
-	// Create a gRPC server
-	s := grpc.NewServer()
+	// Create a gRPC server, replaying the response of Create calls retried with the same idempotency key
+	s := grpc.NewServer(grpc.UnaryInterceptor(service.NewIdempotencyInterceptor(idempotencyRepo, envDuration("IDEMPOTENCY_KEY_TTL", defaultIdempotencyKeyTTL))))
 
 	// Register the services with the gRPC server
 	pb.RegisterPosProductCategoryServiceServer(s, productCategorySvc)
@@ -51,6 +89,32 @@ func main() {
 	pb.RegisterPosPromotionServiceServer(s, promotionSvc)
 	pb.RegisterPosProductSubCategoryServiceServer(s, productSubCategorySvc)
 	pb.RegisterPosSupplierServiceServer(s, supplierSvc)
+	pb.RegisterPosStockTransferServiceServer(s, stockTransferSvc)
+	pb.RegisterPosStockLevelServiceServer(s, stockLevelSvc)
+	pb.RegisterPosStockTakeServiceServer(s, stockTakeSvc)
+	pb.RegisterPosLowStockServiceServer(s, lowStockSvc)
+	pb.RegisterPosPurchaseOrderServiceServer(s, purchaseOrderSvc)
+	pb.RegisterPosStockLotServiceServer(s, stockLotSvc)
+	pb.RegisterPosCostingSettingServiceServer(s, costingSettingSvc)
+	pb.RegisterPosInventoryValuationServiceServer(s, inventoryValuationSvc)
+	pb.RegisterPosStockReservationServiceServer(s, stockReservationSvc)
+	pb.RegisterPosSerialNumberServiceServer(s, serialNumberSvc)
+	pb.RegisterPosProductUnitServiceServer(s, productUnitSvc)
+	pb.RegisterPosNegativeStockServiceServer(s, negativeStockSvc)
+	pb.RegisterPosInventoryReconciliationServiceServer(s, inventoryReconciliationSvc)
+	pb.RegisterPosSaleStockServiceServer(s, saleStockSvc)
+	pb.RegisterPosProductVariantServiceServer(s, productVariantSvc)
+	pb.RegisterPosProductBarcodeServiceServer(s, productBarcodeSvc)
+	pb.RegisterPosEmbeddedBarcodeRuleServiceServer(s, embeddedBarcodeRuleSvc)
+
+	// Start the background low stock checker
+	go runLowStockChecker(lowStockRepo)
+
+	// Start the background expiry of stock reservations
+	go runStockReservationExpirer(stockReservationRepo)
+
+	// Start the background reconciliation of the ledger with the stock
+	go runInventoryReconciler(inventoryReconciliationRepo)
 
 	// Start the gRPC server
 	serverPort := os.Getenv("SERVER_PORT")
@@ -62,3 +126,106 @@ func main() {
 		log.Fatalf("failed to serve: %v", err)
 	}
 }
+
+// defaultIdempotencyKeyTTL is how long the response of an idempotent request is kept, unless
+// IDEMPOTENCY_KEY_TTL is set
+const defaultIdempotencyKeyTTL = 24 * time.Hour
+
+// envDuration returns the duration set in the environment variable, or the fallback when it is
+// not set or not a positive duration
+func envDuration(name string, fallback time.Duration) time.Duration {
+	if value := os.Getenv(name); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			log.Printf("invalid %s %q, using %s", name, value, fallback)
+		} else {
+			return parsed
+		}
+	}
+
+	return fallback
+}
+
+// runEvery runs the job on every tick of the interval set in the environment variable, or of the
+// fallback interval
+func runEvery(name string, fallback time.Duration, job func()) {
+	ticker := time.NewTicker(envDuration(name, fallback))
+	defer ticker.Stop()
+
+	for range ticker.C {
+		job()
+	}
+}
+
+const defaultLowStockCheckInterval = time.Minute
+
+// runLowStockChecker records low stock alerts for stock levels that crossed their reorder level
+// and resolves the alerts of replenished stock levels, every LOW_STOCK_CHECK_INTERVAL. Movements
+// already raise and resolve the alerts, the sweep catches reorder levels changed without a
+// movement.
+func runLowStockChecker(lowStockRepo repository.PosLowStockRepository) {
+	runEvery("LOW_STOCK_CHECK_INTERVAL", defaultLowStockCheckInterval, func() {
+		created, resolved, err := lowStockRepo.CheckLowStockLevels()
+		if err != nil {
+			log.Printf("failed to check low stock levels: %v", err)
+			return
+		}
+		if created > 0 || resolved > 0 {
+			log.Printf("low stock check: %d alerts created, %d alerts resolved", created, resolved)
+		}
+	})
+}
+
+const defaultStockReservationExpiryInterval = time.Minute
+
+// runStockReservationExpirer releases the stock reservations past their TTL, every
+// STOCK_RESERVATION_EXPIRY_INTERVAL
+func runStockReservationExpirer(stockReservationRepo repository.PosStockReservationRepository) {
+	runEvery("STOCK_RESERVATION_EXPIRY_INTERVAL", defaultStockReservationExpiryInterval, func() {
+		expired, err := stockReservationRepo.ExpirePosStockReservations()
+		if err != nil {
+			log.Printf("failed to expire stock reservations: %v", err)
+			return
+		}
+		if expired > 0 {
+			log.Printf("stock reservation expiry: %d reservations expired", expired)
+		}
+	})
+}
+
+const defaultInventoryReconciliationInterval = 24 * time.Hour
+
+// runInventoryReconciler reports the discrepancies between the ledger and the stock of every
+// company, every INVENTORY_RECONCILIATION_INTERVAL, and posts the correcting entries when
+// INVENTORY_RECONCILIATION_APPLY is true
+func runInventoryReconciler(inventoryReconciliationRepo repository.PosInventoryReconciliationRepository) {
+	apply := false
+	if value := os.Getenv("INVENTORY_RECONCILIATION_APPLY"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			log.Printf("invalid INVENTORY_RECONCILIATION_APPLY %q, only reporting discrepancies", value)
+		} else {
+			apply = parsed
+		}
+	}
+
+	runEvery("INVENTORY_RECONCILIATION_INTERVAL", defaultInventoryReconciliationInterval, func() {
+		discrepancies, err := inventoryReconciliationRepo.ReconcilePosInventory("", "", "", "", apply, entity.SystemUserID)
+		if err != nil {
+			log.Printf("failed to reconcile inventory: %v", err)
+			return
+		}
+
+		corrected := 0
+		for _, discrepancy := range discrepancies {
+			if discrepancy.Corrected {
+				corrected++
+			} else {
+				log.Printf("inventory discrepancy: product %s store %s ledger %d stock %d", discrepancy.ProductId, discrepancy.StoreId, discrepancy.LedgerQuantity, discrepancy.StockQuantity)
+			}
+		}
+		if len(discrepancies) > 0 {
+			log.Printf("inventory reconciliation: %d discrepancies found, %d corrected", len(discrepancies), corrected)
+		}
+	})
+}
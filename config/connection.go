@@ -43,7 +43,7 @@ func connectPostgres() *gorm.DB {
 	} else {
 
 		fmt.Println("Successfully connected to PostgreSQL")
-		sqlDB.AutoMigrate(entity.PosProductCategory{}, entity.PosInventoryHistory{}, entity.PosProduct{}, entity.PosPromotion{}, entity.PosProductSubCategory{}, entity.PosSupplier{})
+		sqlDB.AutoMigrate(entity.PosProductCategory{}, entity.PosInventoryHistory{}, entity.PosProduct{}, entity.PosPromotion{}, entity.PosProductSubCategory{}, entity.PosSupplier{}, entity.PosStockTransfer{}, entity.PosStockTransferItem{}, entity.PosStockLevel{}, entity.PosStockTake{}, entity.PosStockTakeItem{}, entity.PosLowStockAlert{}, entity.PosPurchaseOrder{}, entity.PosPurchaseOrderItem{}, entity.PosStockLot{}, entity.PosInventoryHistoryLot{}, entity.PosCostingSetting{}, entity.PosCostLayer{}, entity.PosStockReservation{}, entity.PosSerialNumber{}, entity.PosInventoryHistorySerial{}, entity.PosStockTransferItemSerial{}, entity.PosProductUnit{}, entity.PosNegativeStockSetting{}, entity.PosNegativeStockViolation{}, entity.PosProductOption{}, entity.PosProductVariantValue{}, entity.PosProductBarcode{}, entity.PosInternalBarcodeSequence{}, entity.PosEmbeddedBarcodeRule{})
 		return sqlDB
 	}
 }
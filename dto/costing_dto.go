@@ -0,0 +1,21 @@
+package dto
+
+import "errors"
+
+// COSTING_SETTING Failed Messages
+const (
+	MESSAGE_FAILED_GET_COSTING_SETTING    = "failed to get costing setting"
+	MESSAGE_FAILED_UPDATE_COSTING_SETTING = "failed to update costing setting"
+)
+
+// COSTING_SETTING Success Messages
+const (
+	MESSAGE_SUCCESS_GET_COSTING_SETTING    = "success get costing setting"
+	MESSAGE_SUCCESS_UPDATE_COSTING_SETTING = "success update costing setting"
+)
+
+// COSTING_SETTING Custom Errors
+var (
+	ErrGetCostingSetting    = errors.New(MESSAGE_FAILED_GET_COSTING_SETTING)
+	ErrUpdateCostingSetting = errors.New(MESSAGE_FAILED_UPDATE_COSTING_SETTING)
+)
@@ -0,0 +1,27 @@
+package dto
+
+import "errors"
+
+// EMBEDDED_BARCODE_RULE Failed Messages
+const (
+	MESSAGE_FAILED_CREATE_EMBEDDED_BARCODE_RULE = "failed to create embedded barcode rule"
+	MESSAGE_FAILED_UPDATE_EMBEDDED_BARCODE_RULE = "failed to update embedded barcode rule"
+	MESSAGE_FAILED_DELETE_EMBEDDED_BARCODE_RULE = "failed to delete embedded barcode rule"
+	MESSAGE_FAILED_GET_EMBEDDED_BARCODE_RULE    = "failed to get embedded barcode rule"
+)
+
+// EMBEDDED_BARCODE_RULE Success Messages
+const (
+	MESSAGE_SUCCESS_CREATE_EMBEDDED_BARCODE_RULE = "success create embedded barcode rule"
+	MESSAGE_SUCCESS_UPDATE_EMBEDDED_BARCODE_RULE = "success update embedded barcode rule"
+	MESSAGE_SUCCESS_DELETE_EMBEDDED_BARCODE_RULE = "success delete embedded barcode rule"
+	MESSAGE_SUCCESS_GET_EMBEDDED_BARCODE_RULE    = "success get embedded barcode rule"
+)
+
+// EMBEDDED_BARCODE_RULE Custom Errors
+var (
+	ErrCreateEmbeddedBarcodeRule = errors.New(MESSAGE_FAILED_CREATE_EMBEDDED_BARCODE_RULE)
+	ErrUpdateEmbeddedBarcodeRule = errors.New(MESSAGE_FAILED_UPDATE_EMBEDDED_BARCODE_RULE)
+	ErrDeleteEmbeddedBarcodeRule = errors.New(MESSAGE_FAILED_DELETE_EMBEDDED_BARCODE_RULE)
+	ErrGetEmbeddedBarcodeRule    = errors.New(MESSAGE_FAILED_GET_EMBEDDED_BARCODE_RULE)
+)
@@ -1,27 +1,48 @@
 package dto
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 // INVENTORY_HISTORY Failed Messages
 const (
-	MESSAGE_FAILED_CREATE_INVENTORY_HISTORY = "failed to create inventory history"
-	MESSAGE_FAILED_UPDATE_INVENTORY_HISTORY = "failed to update inventory history"
-	MESSAGE_FAILED_DELETE_INVENTORY_HISTORY = "failed to delete inventory history"
-	MESSAGE_FAILED_GET_INVENTORY_HISTORY    = "failed to get inventory history"
+	MESSAGE_FAILED_CREATE_INVENTORY_HISTORY  = "failed to create inventory history"
+	MESSAGE_FAILED_REVERSE_INVENTORY_HISTORY = "failed to reverse inventory history"
+	MESSAGE_FAILED_GET_INVENTORY_HISTORY     = "failed to get inventory history"
+	MESSAGE_FAILED_GET_STOCK_AS_OF           = "failed to get stock as of date"
+	MESSAGE_FAILED_GET_STOCK_CARD            = "failed to get stock card"
 )
 
 // INVENTORY_HISTORY Success Messages
 const (
-	MESSAGE_SUCCESS_CREATE_INVENTORY_HISTORY = "success create inventory history"
-	MESSAGE_SUCCESS_UPDATE_INVENTORY_HISTORY = "success update inventory history"
-	MESSAGE_SUCCESS_DELETE_INVENTORY_HISTORY = "success delete inventory history"
-	MESSAGE_SUCCESS_GET_INVENTORY_HISTORY    = "success get inventory history"
+	MESSAGE_SUCCESS_CREATE_INVENTORY_HISTORY  = "success create inventory history"
+	MESSAGE_SUCCESS_REVERSE_INVENTORY_HISTORY = "success reverse inventory history"
+	MESSAGE_SUCCESS_GET_INVENTORY_HISTORY     = "success get inventory history"
+	MESSAGE_SUCCESS_GET_STOCK_AS_OF           = "success get stock as of date"
+	MESSAGE_SUCCESS_GET_STOCK_CARD            = "success get stock card"
 )
 
 // INVENTORY_HISTORY Custom Errors
 var (
-	ErrCreateInventoryHistory = errors.New(MESSAGE_FAILED_CREATE_INVENTORY_HISTORY)
-	ErrUpdateInventoryHistory = errors.New(MESSAGE_FAILED_UPDATE_INVENTORY_HISTORY)
-	ErrDeleteInventoryHistory = errors.New(MESSAGE_FAILED_DELETE_INVENTORY_HISTORY)
-	ErrGetInventoryHistory    = errors.New(MESSAGE_FAILED_GET_INVENTORY_HISTORY)
+	ErrCreateInventoryHistory  = errors.New(MESSAGE_FAILED_CREATE_INVENTORY_HISTORY)
+	ErrReverseInventoryHistory = errors.New(MESSAGE_FAILED_REVERSE_INVENTORY_HISTORY)
+	ErrGetInventoryHistory     = errors.New(MESSAGE_FAILED_GET_INVENTORY_HISTORY)
+	ErrGetStockAsOf            = errors.New(MESSAGE_FAILED_GET_STOCK_AS_OF)
+	ErrGetStockCard            = errors.New(MESSAGE_FAILED_GET_STOCK_CARD)
 )
+
+// InventoryHistoryFilter narrows and orders the inventory movements read, empty fields do not
+// filter. QuantitySign is positive or negative, SortBy is date, created_at or quantity and
+// SortOrder is asc or desc.
+type InventoryHistoryFilter struct {
+	ProductID    string
+	StoreID      string
+	BranchID     string
+	DateFrom     *time.Time
+	DateTo       *time.Time
+	QuantitySign string
+	CreatedBy    string
+	SortBy       string
+	SortOrder    string
+}
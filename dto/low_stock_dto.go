@@ -0,0 +1,24 @@
+package dto
+
+import "errors"
+
+// LOW_STOCK Failed Messages
+const (
+	MESSAGE_FAILED_GET_LOW_STOCK_PRODUCTS      = "failed to get low stock products"
+	MESSAGE_FAILED_ACKNOWLEDGE_LOW_STOCK_ALERT = "failed to acknowledge low stock alert"
+	MESSAGE_FAILED_GET_LOW_STOCK_ALERT         = "failed to get low stock alert"
+)
+
+// LOW_STOCK Success Messages
+const (
+	MESSAGE_SUCCESS_GET_LOW_STOCK_PRODUCTS      = "success get low stock products"
+	MESSAGE_SUCCESS_ACKNOWLEDGE_LOW_STOCK_ALERT = "success acknowledge low stock alert"
+	MESSAGE_SUCCESS_GET_LOW_STOCK_ALERT         = "success get low stock alert"
+)
+
+// LOW_STOCK Custom Errors
+var (
+	ErrGetLowStockProducts      = errors.New(MESSAGE_FAILED_GET_LOW_STOCK_PRODUCTS)
+	ErrAcknowledgeLowStockAlert = errors.New(MESSAGE_FAILED_ACKNOWLEDGE_LOW_STOCK_ALERT)
+	ErrGetLowStockAlert         = errors.New(MESSAGE_FAILED_GET_LOW_STOCK_ALERT)
+)
@@ -0,0 +1,33 @@
+package dto
+
+import "errors"
+
+// NEGATIVE_STOCK Failed Messages
+const (
+	MESSAGE_FAILED_GET_NEGATIVE_STOCK_SETTING      = "failed to get negative stock setting"
+	MESSAGE_FAILED_RESOLVE_NEGATIVE_STOCK_POLICY   = "failed to resolve negative stock policy"
+	MESSAGE_FAILED_UPDATE_NEGATIVE_STOCK_SETTING   = "failed to update negative stock setting"
+	MESSAGE_FAILED_DELETE_NEGATIVE_STOCK_SETTING   = "failed to delete negative stock setting"
+	MESSAGE_FAILED_GET_NEGATIVE_STOCK_VIOLATION    = "failed to get negative stock violation"
+	MESSAGE_FAILED_REVIEW_NEGATIVE_STOCK_VIOLATION = "failed to review negative stock violation"
+)
+
+// NEGATIVE_STOCK Success Messages
+const (
+	MESSAGE_SUCCESS_GET_NEGATIVE_STOCK_SETTING      = "success get negative stock setting"
+	MESSAGE_SUCCESS_RESOLVE_NEGATIVE_STOCK_POLICY   = "success resolve negative stock policy"
+	MESSAGE_SUCCESS_UPDATE_NEGATIVE_STOCK_SETTING   = "success update negative stock setting"
+	MESSAGE_SUCCESS_DELETE_NEGATIVE_STOCK_SETTING   = "success delete negative stock setting"
+	MESSAGE_SUCCESS_GET_NEGATIVE_STOCK_VIOLATION    = "success get negative stock violation"
+	MESSAGE_SUCCESS_REVIEW_NEGATIVE_STOCK_VIOLATION = "success review negative stock violation"
+)
+
+// NEGATIVE_STOCK Custom Errors
+var (
+	ErrGetNegativeStockSetting      = errors.New(MESSAGE_FAILED_GET_NEGATIVE_STOCK_SETTING)
+	ErrResolveNegativeStockPolicy   = errors.New(MESSAGE_FAILED_RESOLVE_NEGATIVE_STOCK_POLICY)
+	ErrUpdateNegativeStockSetting   = errors.New(MESSAGE_FAILED_UPDATE_NEGATIVE_STOCK_SETTING)
+	ErrDeleteNegativeStockSetting   = errors.New(MESSAGE_FAILED_DELETE_NEGATIVE_STOCK_SETTING)
+	ErrGetNegativeStockViolation    = errors.New(MESSAGE_FAILED_GET_NEGATIVE_STOCK_VIOLATION)
+	ErrReviewNegativeStockViolation = errors.New(MESSAGE_FAILED_REVIEW_NEGATIVE_STOCK_VIOLATION)
+)
@@ -0,0 +1,27 @@
+package dto
+
+import "errors"
+
+// PRODUCT_BARCODE Failed Messages
+const (
+	MESSAGE_FAILED_CREATE_PRODUCT_BARCODE = "failed to create product barcode"
+	MESSAGE_FAILED_DELETE_PRODUCT_BARCODE = "failed to delete product barcode"
+	MESSAGE_FAILED_GET_PRODUCT_BARCODE    = "failed to get product barcode"
+	MESSAGE_FAILED_GENERATE_BARCODE       = "failed to generate internal barcode"
+)
+
+// PRODUCT_BARCODE Success Messages
+const (
+	MESSAGE_SUCCESS_CREATE_PRODUCT_BARCODE = "success create product barcode"
+	MESSAGE_SUCCESS_DELETE_PRODUCT_BARCODE = "success delete product barcode"
+	MESSAGE_SUCCESS_GET_PRODUCT_BARCODE    = "success get product barcode"
+	MESSAGE_SUCCESS_GENERATE_BARCODE       = "success generate internal barcode"
+)
+
+// PRODUCT_BARCODE Custom Errors
+var (
+	ErrCreateProductBarcode = errors.New(MESSAGE_FAILED_CREATE_PRODUCT_BARCODE)
+	ErrDeleteProductBarcode = errors.New(MESSAGE_FAILED_DELETE_PRODUCT_BARCODE)
+	ErrGetProductBarcode    = errors.New(MESSAGE_FAILED_GET_PRODUCT_BARCODE)
+	ErrGenerateBarcode      = errors.New(MESSAGE_FAILED_GENERATE_BARCODE)
+)
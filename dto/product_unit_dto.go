@@ -0,0 +1,27 @@
+package dto
+
+import "errors"
+
+// PRODUCT_UNIT Failed Messages
+const (
+	MESSAGE_FAILED_CREATE_PRODUCT_UNIT = "failed to create product unit"
+	MESSAGE_FAILED_UPDATE_PRODUCT_UNIT = "failed to update product unit"
+	MESSAGE_FAILED_DELETE_PRODUCT_UNIT = "failed to delete product unit"
+	MESSAGE_FAILED_GET_PRODUCT_UNIT    = "failed to get product unit"
+)
+
+// PRODUCT_UNIT Success Messages
+const (
+	MESSAGE_SUCCESS_CREATE_PRODUCT_UNIT = "success create product unit"
+	MESSAGE_SUCCESS_UPDATE_PRODUCT_UNIT = "success update product unit"
+	MESSAGE_SUCCESS_DELETE_PRODUCT_UNIT = "success delete product unit"
+	MESSAGE_SUCCESS_GET_PRODUCT_UNIT    = "success get product unit"
+)
+
+// PRODUCT_UNIT Custom Errors
+var (
+	ErrCreateProductUnit = errors.New(MESSAGE_FAILED_CREATE_PRODUCT_UNIT)
+	ErrUpdateProductUnit = errors.New(MESSAGE_FAILED_UPDATE_PRODUCT_UNIT)
+	ErrDeleteProductUnit = errors.New(MESSAGE_FAILED_DELETE_PRODUCT_UNIT)
+	ErrGetProductUnit    = errors.New(MESSAGE_FAILED_GET_PRODUCT_UNIT)
+)
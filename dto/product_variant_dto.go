@@ -0,0 +1,21 @@
+package dto
+
+import "errors"
+
+// PRODUCT_VARIANT Failed Messages
+const (
+	MESSAGE_FAILED_SET_PRODUCT_OPTIONS    = "failed to set product options"
+	MESSAGE_FAILED_CREATE_PRODUCT_VARIANT = "failed to create product variant"
+)
+
+// PRODUCT_VARIANT Success Messages
+const (
+	MESSAGE_SUCCESS_SET_PRODUCT_OPTIONS    = "success set product options"
+	MESSAGE_SUCCESS_CREATE_PRODUCT_VARIANT = "success create product variant"
+)
+
+// PRODUCT_VARIANT Custom Errors
+var (
+	ErrSetProductOptions    = errors.New(MESSAGE_FAILED_SET_PRODUCT_OPTIONS)
+	ErrCreateProductVariant = errors.New(MESSAGE_FAILED_CREATE_PRODUCT_VARIANT)
+)
@@ -0,0 +1,36 @@
+package dto
+
+import "errors"
+
+// PURCHASE_ORDER Failed Messages
+const (
+	MESSAGE_FAILED_CREATE_PURCHASE_ORDER                  = "failed to create purchase order"
+	MESSAGE_FAILED_UPDATE_PURCHASE_ORDER                  = "failed to update purchase order"
+	MESSAGE_FAILED_SUBMIT_PURCHASE_ORDER                  = "failed to submit purchase order"
+	MESSAGE_FAILED_RECEIVE_PURCHASE_ORDER                 = "failed to receive purchase order"
+	MESSAGE_FAILED_CANCEL_PURCHASE_ORDER                  = "failed to cancel purchase order"
+	MESSAGE_FAILED_GET_PURCHASE_ORDER                     = "failed to get purchase order"
+	MESSAGE_FAILED_GENERATE_REPLENISHMENT_PURCHASE_ORDERS = "failed to generate replenishment purchase orders"
+)
+
+// PURCHASE_ORDER Success Messages
+const (
+	MESSAGE_SUCCESS_CREATE_PURCHASE_ORDER                  = "success create purchase order"
+	MESSAGE_SUCCESS_UPDATE_PURCHASE_ORDER                  = "success update purchase order"
+	MESSAGE_SUCCESS_SUBMIT_PURCHASE_ORDER                  = "success submit purchase order"
+	MESSAGE_SUCCESS_RECEIVE_PURCHASE_ORDER                 = "success receive purchase order"
+	MESSAGE_SUCCESS_CANCEL_PURCHASE_ORDER                  = "success cancel purchase order"
+	MESSAGE_SUCCESS_GET_PURCHASE_ORDER                     = "success get purchase order"
+	MESSAGE_SUCCESS_GENERATE_REPLENISHMENT_PURCHASE_ORDERS = "success generate replenishment purchase orders"
+)
+
+// PURCHASE_ORDER Custom Errors
+var (
+	ErrCreatePurchaseOrder                 = errors.New(MESSAGE_FAILED_CREATE_PURCHASE_ORDER)
+	ErrUpdatePurchaseOrder                 = errors.New(MESSAGE_FAILED_UPDATE_PURCHASE_ORDER)
+	ErrSubmitPurchaseOrder                 = errors.New(MESSAGE_FAILED_SUBMIT_PURCHASE_ORDER)
+	ErrReceivePurchaseOrder                = errors.New(MESSAGE_FAILED_RECEIVE_PURCHASE_ORDER)
+	ErrCancelPurchaseOrder                 = errors.New(MESSAGE_FAILED_CANCEL_PURCHASE_ORDER)
+	ErrGetPurchaseOrder                    = errors.New(MESSAGE_FAILED_GET_PURCHASE_ORDER)
+	ErrGenerateReplenishmentPurchaseOrders = errors.New(MESSAGE_FAILED_GENERATE_REPLENISHMENT_PURCHASE_ORDERS)
+)
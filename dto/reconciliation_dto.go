@@ -0,0 +1,21 @@
+package dto
+
+import "errors"
+
+// INVENTORY_RECONCILIATION Failed Messages
+const (
+	MESSAGE_FAILED_GET_INVENTORY_DISCREPANCIES = "failed to get inventory discrepancies"
+	MESSAGE_FAILED_RECONCILE_INVENTORY         = "failed to reconcile inventory"
+)
+
+// INVENTORY_RECONCILIATION Success Messages
+const (
+	MESSAGE_SUCCESS_GET_INVENTORY_DISCREPANCIES = "success get inventory discrepancies"
+	MESSAGE_SUCCESS_RECONCILE_INVENTORY         = "success reconcile inventory"
+)
+
+// INVENTORY_RECONCILIATION Custom Errors
+var (
+	ErrGetInventoryDiscrepancies = errors.New(MESSAGE_FAILED_GET_INVENTORY_DISCREPANCIES)
+	ErrReconcileInventory        = errors.New(MESSAGE_FAILED_RECONCILE_INVENTORY)
+)
@@ -0,0 +1,21 @@
+package dto
+
+import "errors"
+
+// SALE_STOCK Failed Messages
+const (
+	MESSAGE_FAILED_DEDUCT_STOCK_FOR_SALE = "failed to deduct stock for sale"
+	MESSAGE_FAILED_RETURN_STOCK_FOR_SALE = "failed to return stock for sale"
+)
+
+// SALE_STOCK Success Messages
+const (
+	MESSAGE_SUCCESS_DEDUCT_STOCK_FOR_SALE = "success deduct stock for sale"
+	MESSAGE_SUCCESS_RETURN_STOCK_FOR_SALE = "success return stock for sale"
+)
+
+// SALE_STOCK Custom Errors
+var (
+	ErrDeductStockForSale = errors.New(MESSAGE_FAILED_DEDUCT_STOCK_FOR_SALE)
+	ErrReturnStockForSale = errors.New(MESSAGE_FAILED_RETURN_STOCK_FOR_SALE)
+)
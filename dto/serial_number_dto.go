@@ -0,0 +1,18 @@
+package dto
+
+import "errors"
+
+// SERIAL_NUMBER Failed Messages
+const (
+	MESSAGE_FAILED_GET_SERIAL_NUMBER_HISTORY = "failed to get serial number history"
+)
+
+// SERIAL_NUMBER Success Messages
+const (
+	MESSAGE_SUCCESS_GET_SERIAL_NUMBER_HISTORY = "success get serial number history"
+)
+
+// SERIAL_NUMBER Custom Errors
+var (
+	ErrGetSerialNumberHistory = errors.New(MESSAGE_FAILED_GET_SERIAL_NUMBER_HISTORY)
+)
@@ -0,0 +1,21 @@
+package dto
+
+import "errors"
+
+// STOCK_LEVEL Failed Messages
+const (
+	MESSAGE_FAILED_UPDATE_STOCK_LEVEL = "failed to update stock level"
+	MESSAGE_FAILED_GET_STOCK_LEVEL    = "failed to get stock level"
+)
+
+// STOCK_LEVEL Success Messages
+const (
+	MESSAGE_SUCCESS_UPDATE_STOCK_LEVEL = "success update stock level"
+	MESSAGE_SUCCESS_GET_STOCK_LEVEL    = "success get stock level"
+)
+
+// STOCK_LEVEL Custom Errors
+var (
+	ErrUpdateStockLevel = errors.New(MESSAGE_FAILED_UPDATE_STOCK_LEVEL)
+	ErrGetStockLevel    = errors.New(MESSAGE_FAILED_GET_STOCK_LEVEL)
+)
@@ -0,0 +1,21 @@
+package dto
+
+import "errors"
+
+// STOCK_LOT Failed Messages
+const (
+	MESSAGE_FAILED_GET_STOCK_LOT          = "failed to get stock lot"
+	MESSAGE_FAILED_GET_EXPIRING_STOCK_LOT = "failed to get expiring stock lot"
+)
+
+// STOCK_LOT Success Messages
+const (
+	MESSAGE_SUCCESS_GET_STOCK_LOT          = "success get stock lot"
+	MESSAGE_SUCCESS_GET_EXPIRING_STOCK_LOT = "success get expiring stock lot"
+)
+
+// STOCK_LOT Custom Errors
+var (
+	ErrGetStockLot         = errors.New(MESSAGE_FAILED_GET_STOCK_LOT)
+	ErrGetExpiringStockLot = errors.New(MESSAGE_FAILED_GET_EXPIRING_STOCK_LOT)
+)
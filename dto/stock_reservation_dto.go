@@ -0,0 +1,24 @@
+package dto
+
+import "errors"
+
+// STOCK_RESERVATION Failed Messages
+const (
+	MESSAGE_FAILED_RESERVE_STOCK             = "failed to reserve stock"
+	MESSAGE_FAILED_COMMIT_STOCK_RESERVATION  = "failed to commit stock reservation"
+	MESSAGE_FAILED_RELEASE_STOCK_RESERVATION = "failed to release stock reservation"
+)
+
+// STOCK_RESERVATION Success Messages
+const (
+	MESSAGE_SUCCESS_RESERVE_STOCK             = "success reserve stock"
+	MESSAGE_SUCCESS_COMMIT_STOCK_RESERVATION  = "success commit stock reservation"
+	MESSAGE_SUCCESS_RELEASE_STOCK_RESERVATION = "success release stock reservation"
+)
+
+// STOCK_RESERVATION Custom Errors
+var (
+	ErrReserveStock            = errors.New(MESSAGE_FAILED_RESERVE_STOCK)
+	ErrCommitStockReservation  = errors.New(MESSAGE_FAILED_COMMIT_STOCK_RESERVATION)
+	ErrReleaseStockReservation = errors.New(MESSAGE_FAILED_RELEASE_STOCK_RESERVATION)
+)
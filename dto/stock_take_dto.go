@@ -0,0 +1,33 @@
+package dto
+
+import "errors"
+
+// STOCK_TAKE Failed Messages
+const (
+	MESSAGE_FAILED_CREATE_STOCK_TAKE        = "failed to create stock take"
+	MESSAGE_FAILED_SUBMIT_STOCK_TAKE_COUNTS = "failed to submit stock take counts"
+	MESSAGE_FAILED_GET_STOCK_TAKE_VARIANCE  = "failed to get stock take variance"
+	MESSAGE_FAILED_APPROVE_STOCK_TAKE       = "failed to approve stock take"
+	MESSAGE_FAILED_CANCEL_STOCK_TAKE        = "failed to cancel stock take"
+	MESSAGE_FAILED_GET_STOCK_TAKE           = "failed to get stock take"
+)
+
+// STOCK_TAKE Success Messages
+const (
+	MESSAGE_SUCCESS_CREATE_STOCK_TAKE        = "success create stock take"
+	MESSAGE_SUCCESS_SUBMIT_STOCK_TAKE_COUNTS = "success submit stock take counts"
+	MESSAGE_SUCCESS_GET_STOCK_TAKE_VARIANCE  = "success get stock take variance"
+	MESSAGE_SUCCESS_APPROVE_STOCK_TAKE       = "success approve stock take"
+	MESSAGE_SUCCESS_CANCEL_STOCK_TAKE        = "success cancel stock take"
+	MESSAGE_SUCCESS_GET_STOCK_TAKE           = "success get stock take"
+)
+
+// STOCK_TAKE Custom Errors
+var (
+	ErrCreateStockTake       = errors.New(MESSAGE_FAILED_CREATE_STOCK_TAKE)
+	ErrSubmitStockTakeCounts = errors.New(MESSAGE_FAILED_SUBMIT_STOCK_TAKE_COUNTS)
+	ErrGetStockTakeVariance  = errors.New(MESSAGE_FAILED_GET_STOCK_TAKE_VARIANCE)
+	ErrApproveStockTake      = errors.New(MESSAGE_FAILED_APPROVE_STOCK_TAKE)
+	ErrCancelStockTake       = errors.New(MESSAGE_FAILED_CANCEL_STOCK_TAKE)
+	ErrGetStockTake          = errors.New(MESSAGE_FAILED_GET_STOCK_TAKE)
+)
@@ -0,0 +1,30 @@
+package dto
+
+import "errors"
+
+// STOCK_TRANSFER Failed Messages
+const (
+	MESSAGE_FAILED_CREATE_STOCK_TRANSFER   = "failed to create stock transfer"
+	MESSAGE_FAILED_DISPATCH_STOCK_TRANSFER = "failed to dispatch stock transfer"
+	MESSAGE_FAILED_RECEIVE_STOCK_TRANSFER  = "failed to receive stock transfer"
+	MESSAGE_FAILED_CANCEL_STOCK_TRANSFER   = "failed to cancel stock transfer"
+	MESSAGE_FAILED_GET_STOCK_TRANSFER      = "failed to get stock transfer"
+)
+
+// STOCK_TRANSFER Success Messages
+const (
+	MESSAGE_SUCCESS_CREATE_STOCK_TRANSFER   = "success create stock transfer"
+	MESSAGE_SUCCESS_DISPATCH_STOCK_TRANSFER = "success dispatch stock transfer"
+	MESSAGE_SUCCESS_RECEIVE_STOCK_TRANSFER  = "success receive stock transfer"
+	MESSAGE_SUCCESS_CANCEL_STOCK_TRANSFER   = "success cancel stock transfer"
+	MESSAGE_SUCCESS_GET_STOCK_TRANSFER      = "success get stock transfer"
+)
+
+// STOCK_TRANSFER Custom Errors
+var (
+	ErrCreateStockTransfer   = errors.New(MESSAGE_FAILED_CREATE_STOCK_TRANSFER)
+	ErrDispatchStockTransfer = errors.New(MESSAGE_FAILED_DISPATCH_STOCK_TRANSFER)
+	ErrReceiveStockTransfer  = errors.New(MESSAGE_FAILED_RECEIVE_STOCK_TRANSFER)
+	ErrCancelStockTransfer   = errors.New(MESSAGE_FAILED_CANCEL_STOCK_TRANSFER)
+	ErrGetStockTransfer      = errors.New(MESSAGE_FAILED_GET_STOCK_TRANSFER)
+)
@@ -0,0 +1,18 @@
+package dto
+
+import "errors"
+
+// INVENTORY_VALUATION Failed Messages
+const (
+	MESSAGE_FAILED_GET_INVENTORY_VALUATION = "failed to get inventory valuation"
+)
+
+// INVENTORY_VALUATION Success Messages
+const (
+	MESSAGE_SUCCESS_GET_INVENTORY_VALUATION = "success get inventory valuation"
+)
+
+// INVENTORY_VALUATION Custom Errors
+var (
+	ErrGetInventoryValuation = errors.New(MESSAGE_FAILED_GET_INVENTORY_VALUATION)
+)
@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Inventory costing methods
+const (
+	CostingMethodWeightedAverage = "weighted_average"
+	CostingMethodFIFO            = "fifo"
+)
+
+// PosCostingSetting is the inventory costing method chosen by a company
+type PosCostingSetting struct {
+	CompanyID     uuid.UUID `gorm:"type:uuid;primary_key" json:"company_id"`
+	CostingMethod string    `gorm:"type:varchar(20);not null" json:"costing_method"`
+	CreatedAt     time.Time `gorm:"type:timestamp" json:"created_at"`
+	CreatedBy     uuid.UUID `gorm:"type:uuid" json:"created_by"`
+	UpdatedAt     time.Time `gorm:"type:timestamp" json:"updated_at"`
+	UpdatedBy     uuid.UUID `gorm:"type:uuid" json:"updated_by"`
+}
+
+// PosCostLayer is the stock received by a positive inventory movement at one unit cost,
+// RemainingQuantity is what is left of it after the decrements that consumed it
+type PosCostLayer struct {
+	CostLayerID       uuid.UUID `gorm:"type:uuid;primary_key" json:"cost_layer_id"`
+	ProductID         uuid.UUID `gorm:"type:uuid;not null" json:"product_id"`
+	StoreID           uuid.UUID `gorm:"type:uuid;not null" json:"store_id"`
+	InventoryID       uuid.UUID `gorm:"type:uuid;not null" json:"inventory_id"`
+	UnitCost          float64   `gorm:"type:decimal(12,4);not null" json:"unit_cost"`
+	Quantity          int       `gorm:"type:int;not null" json:"quantity"`
+	RemainingQuantity int       `gorm:"type:int;not null" json:"remaining_quantity"`
+	BranchID          uuid.UUID `gorm:"type:uuid;not null" json:"branch_id"`
+	CompanyID         uuid.UUID `gorm:"type:uuid;not null" json:"company_id"`
+	CreatedAt         time.Time `gorm:"type:timestamp" json:"created_at"`
+	UpdatedAt         time.Time `gorm:"type:timestamp" json:"updated_at"`
+}
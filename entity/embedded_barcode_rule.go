@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Value types of an embedded barcode rule
+const (
+	EmbeddedValueTypePrice  = "price"
+	EmbeddedValueTypeWeight = "weight"
+)
+
+// PosEmbeddedBarcodeRule decodes the EAN-13 labels printed by scales. A label is the prefix, the
+// PLU in PluDigits digits, the value in the last ValueDigits digits before the check digit, and
+// the check digit. Digits left between the PLU and the value, e.g. a price check digit, are
+// skipped. The value has Decimals decimals and is a price, or a weight in Unit. The product of a
+// PLU is the product with the label of that PLU with all digits after the PLU set to zero.
+type PosEmbeddedBarcodeRule struct {
+	RuleID      uuid.UUID `gorm:"type:uuid;primary_key" json:"rule_id"`
+	Prefix      string    `gorm:"type:varchar(5);not null;unique_index:idx_pos_embedded_barcode_rules_company_prefix" json:"prefix"`
+	PluDigits   int       `gorm:"type:int;not null" json:"plu_digits"`
+	ValueDigits int       `gorm:"type:int;not null" json:"value_digits"`
+	ValueType   string    `gorm:"type:varchar(10);not null" json:"value_type"`
+	Decimals    int       `gorm:"type:int;not null;default:0" json:"decimals"`
+	Unit        string    `gorm:"type:varchar(20)" json:"unit"`
+	CompanyID   uuid.UUID `gorm:"type:uuid;not null;unique_index:idx_pos_embedded_barcode_rules_company_prefix" json:"company_id"`
+	CreatedAt   time.Time `gorm:"type:timestamp" json:"created_at"`
+	CreatedBy   uuid.UUID `gorm:"type:uuid" json:"created_by"`
+	UpdatedAt   time.Time `gorm:"type:timestamp" json:"updated_at"`
+	UpdatedBy   uuid.UUID `gorm:"type:uuid" json:"updated_by"`
+}
@@ -6,16 +6,58 @@ import (
 	"github.com/google/uuid"
 )
 
+// Inventory movement types
+const (
+	MovementTypePurchaseReceipt = "purchase_receipt"
+	MovementTypeSale            = "sale"
+	MovementTypeReturn          = "return"
+	MovementTypeDamage          = "damage"
+	MovementTypeTransferIn      = "transfer_in"
+	MovementTypeTransferOut     = "transfer_out"
+	MovementTypeCountCorrection = "count_correction"
+	MovementTypeReversal        = "reversal"
+	// MovementTypeReconciliation is the reason code of the entries posted by the reconciliation to
+	// bring the ledger back in line with the store stock levels, they do not move any stock
+	MovementTypeReconciliation = "reconciliation"
+	// MovementTypeOpeningBalance is the movement type of the entries posted when the stock levels
+	// are first seeded from the product stock by the upgrade script, it can not be posted by users
+	MovementTypeOpeningBalance = "opening_balance"
+	// MovementTypeAdjustment is the movement type of the entries recorded before movements were
+	// typed, it can not be posted anymore
+	MovementTypeAdjustment = "adjustment"
+)
+
+// SystemUserID is the CreatedBy of the entries posted by the service itself, e.g. by the scheduled
+// reconciliation or the stock level seed, so they can be told apart from the entries of users
+var SystemUserID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
 type PosInventoryHistory struct {
-	InventoryID uuid.UUID  `gorm:"type:uuid;primary_key" json:"inventory_id"`
-	ProductID   uuid.UUID  `gorm:"type:uuid;not null" json:"product_id"`
-	StoreID     *uuid.UUID `gorm:"type:uuid" json:"store_id"`
-	Date        time.Time  `gorm:"type:timestamp;not null" json:"date"`
-	Quantity    int        `gorm:"type:int;not null" json:"quantity"`
-	BranchID    *uuid.UUID `gorm:"type:uuid" json:"branch_id"`
-	CompanyID   uuid.UUID  `gorm:"type:uuid;not null" json:"company_id"`
-	CreatedAt   time.Time  `gorm:"type:timestamp" json:"created_at"`
-	CreatedBy   uuid.UUID  `gorm:"type:uuid" json:"created_by"`
-	UpdatedAt   time.Time  `gorm:"type:timestamp" json:"updated_at"`
-	UpdatedBy   uuid.UUID  `gorm:"type:uuid" json:"updated_by"`
+	InventoryID     uuid.UUID  `gorm:"type:uuid;primary_key" json:"inventory_id"`
+	ProductID       uuid.UUID  `gorm:"type:uuid;not null" json:"product_id"`
+	StoreID         *uuid.UUID `gorm:"type:uuid" json:"store_id"`
+	Date            time.Time  `gorm:"type:timestamp;not null" json:"date"`
+	Quantity        int        `gorm:"type:int;not null" json:"quantity"`
+	MovementType    string     `gorm:"type:varchar(50);not null;default:'adjustment'" json:"movement_type"`
+	Note            string     `gorm:"type:text" json:"note"`
+	ReferenceNo     string     `gorm:"type:varchar(255)" json:"reference_no"`
+	LotCode         string     `gorm:"type:varchar(100)" json:"lot_code"`
+	ExpiryDate      *time.Time `gorm:"type:timestamp" json:"expiry_date"`
+	UnitCost        float64    `gorm:"type:decimal(12,4)" json:"unit_cost"`
+	CostAmount      float64    `gorm:"type:decimal(14,4)" json:"cost_amount"`
+	TransferID      *uuid.UUID `gorm:"type:uuid" json:"transfer_id"`
+	PurchaseOrderID *uuid.UUID `gorm:"type:uuid" json:"purchase_order_id"`
+	ReversalOfID    *uuid.UUID `gorm:"type:uuid" json:"reversal_of_id"`
+	SaleID          *uuid.UUID `gorm:"type:uuid" json:"sale_id"`
+	Unit            string     `gorm:"type:varchar(20)" json:"unit"`
+	UnitQuantity    float64    `gorm:"type:decimal(14,4)" json:"unit_quantity"`
+	BranchID        *uuid.UUID `gorm:"type:uuid" json:"branch_id"`
+	CompanyID       uuid.UUID  `gorm:"type:uuid;not null" json:"company_id"`
+	CreatedAt       time.Time  `gorm:"type:timestamp" json:"created_at"`
+	CreatedBy       uuid.UUID  `gorm:"type:uuid" json:"created_by"`
+	UpdatedAt       time.Time  `gorm:"type:timestamp" json:"updated_at"`
+	UpdatedBy       uuid.UUID  `gorm:"type:uuid" json:"updated_by"`
+	SerialNumbers   []string   `gorm:"-" json:"serial_numbers"`
+	// NegativeStockWarning is set when the movement took the store below zero under the
+	// allow_warning policy
+	NegativeStockWarning string `gorm:"-" json:"-"`
 }
@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Low stock alert statuses
+const (
+	LowStockAlertStatusOpen         = "open"
+	LowStockAlertStatusAcknowledged = "acknowledged"
+	LowStockAlertStatusResolved     = "resolved"
+)
+
+type PosLowStockAlert struct {
+	AlertID        uuid.UUID  `gorm:"type:uuid;primary_key" json:"alert_id"`
+	ProductID      uuid.UUID  `gorm:"type:uuid;not null" json:"product_id"`
+	StoreID        uuid.UUID  `gorm:"type:uuid;not null" json:"store_id"`
+	BranchID       uuid.UUID  `gorm:"type:uuid;not null" json:"branch_id"`
+	CompanyID      uuid.UUID  `gorm:"type:uuid;not null" json:"company_id"`
+	Quantity       int        `gorm:"type:int;not null" json:"quantity"`
+	ReorderLevel   int        `gorm:"type:int;not null" json:"reorder_level"`
+	Status         string     `gorm:"type:varchar(20);not null" json:"status"`
+	AcknowledgedAt *time.Time `gorm:"type:timestamp" json:"acknowledged_at"`
+	AcknowledgedBy *uuid.UUID `gorm:"type:uuid" json:"acknowledged_by"`
+	ResolvedAt     *time.Time `gorm:"type:timestamp" json:"resolved_at"`
+	CreatedAt      time.Time  `gorm:"type:timestamp" json:"created_at"`
+	UpdatedAt      time.Time  `gorm:"type:timestamp" json:"updated_at"`
+}
@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Negative stock policies, forbid rejects any decrement below zero while the allow policies
+// post it and record a violation for review, with or without a warning to the user
+const (
+	NegativeStockPolicyForbid        = "forbid"
+	NegativeStockPolicyAllowWarning  = "allow_warning"
+	NegativeStockPolicyAllowSilently = "allow_silently"
+)
+
+// Negative stock setting scopes
+const (
+	SettingScopeCompany = "company"
+	SettingScopeBranch  = "branch"
+	SettingScopeStore   = "store"
+)
+
+// Negative stock violation statuses
+const (
+	NegativeStockViolationStatusOpen     = "open"
+	NegativeStockViolationStatusReviewed = "reviewed"
+)
+
+// PosNegativeStockSetting is the negative stock policy set for a company, branch or store,
+// ScopeID is the ID of that company, branch or store. A store without a setting inherits the
+// setting of its branch, then of its company, and forbids negative stock when none is set.
+type PosNegativeStockSetting struct {
+	ScopeID   uuid.UUID  `gorm:"type:uuid;primary_key" json:"scope_id"`
+	ScopeType string     `gorm:"type:varchar(20);not null" json:"scope_type"`
+	Policy    string     `gorm:"type:varchar(20);not null" json:"policy"`
+	BranchID  *uuid.UUID `gorm:"type:uuid" json:"branch_id"`
+	CompanyID uuid.UUID  `gorm:"type:uuid;not null" json:"company_id"`
+	CreatedAt time.Time  `gorm:"type:timestamp" json:"created_at"`
+	CreatedBy uuid.UUID  `gorm:"type:uuid" json:"created_by"`
+	UpdatedAt time.Time  `gorm:"type:timestamp" json:"updated_at"`
+	UpdatedBy uuid.UUID  `gorm:"type:uuid" json:"updated_by"`
+}
+
+// PosNegativeStockViolation is an inventory movement that was allowed to take the stock level
+// of a store below zero, kept open until it is reviewed
+type PosNegativeStockViolation struct {
+	ViolationID    uuid.UUID  `gorm:"type:uuid;primary_key" json:"violation_id"`
+	InventoryID    uuid.UUID  `gorm:"type:uuid;not null" json:"inventory_id"`
+	ProductID      uuid.UUID  `gorm:"type:uuid;not null" json:"product_id"`
+	StoreID        uuid.UUID  `gorm:"type:uuid;not null" json:"store_id"`
+	QuantityBefore int        `gorm:"type:int;not null" json:"quantity_before"`
+	QuantityAfter  int        `gorm:"type:int;not null" json:"quantity_after"`
+	Policy         string     `gorm:"type:varchar(20);not null" json:"policy"`
+	Status         string     `gorm:"type:varchar(20);not null" json:"status"`
+	ReviewNote     string     `gorm:"type:text" json:"review_note"`
+	ReviewedAt     *time.Time `gorm:"type:timestamp" json:"reviewed_at"`
+	ReviewedBy     *uuid.UUID `gorm:"type:uuid" json:"reviewed_by"`
+	BranchID       uuid.UUID  `gorm:"type:uuid;not null" json:"branch_id"`
+	CompanyID      uuid.UUID  `gorm:"type:uuid;not null" json:"company_id"`
+	CreatedAt      time.Time  `gorm:"type:timestamp" json:"created_at"`
+	CreatedBy      uuid.UUID  `gorm:"type:uuid" json:"created_by"`
+	UpdatedAt      time.Time  `gorm:"type:timestamp" json:"updated_at"`
+}
@@ -19,6 +19,11 @@ type PosProduct struct {
 	SupplierID         uuid.UUID  `gorm:"type:uuid" json:"supplier_id"`
 	ProductDescription string     `gorm:"type:text" json:"product_description"`
 	Active             bool       `gorm:"type:boolean;default:true" json:"active"`
+	Serialized         bool       `gorm:"type:boolean;default:false" json:"serialized"`
+	BaseUnit           string     `gorm:"type:varchar(20);default:'each'" json:"base_unit"`
+	ParentProductID    *uuid.UUID `gorm:"type:uuid" json:"parent_product_id"`
+	HasVariants        bool       `gorm:"type:boolean;default:false" json:"has_variants"`
+	PriceOverride      bool       `gorm:"type:boolean;default:false" json:"price_override"`
 	StoreID            uuid.UUID  `gorm:"type:uuid" json:"store_id"`
 	BranchID           *uuid.UUID `gorm:"type:uuid" json:"branch_id"`
 	CompanyID          uuid.UUID  `gorm:"type:uuid;not null" json:"company_id"`
@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Barcode types of a product barcode
+const (
+	BarcodeTypeManufacturer = "manufacturer"
+	BarcodeTypeInternal     = "internal"
+	BarcodeTypeCase         = "case"
+)
+
+// PosProductBarcode is a barcode a product is scanned by, a barcode is unique within the company.
+// Quantity is the number of base units one scan stands for, e.g. 24 for the case barcode of a case
+// of 24, and 1 for every other barcode.
+type PosProductBarcode struct {
+	BarcodeID   uuid.UUID `gorm:"type:uuid;primary_key" json:"barcode_id"`
+	ProductID   uuid.UUID `gorm:"type:uuid;not null" json:"product_id"`
+	Barcode     string    `gorm:"type:varchar(255);not null;unique_index:idx_pos_product_barcodes_company_barcode" json:"barcode"`
+	BarcodeType string    `gorm:"type:varchar(20);not null" json:"barcode_type"`
+	Quantity    int       `gorm:"type:int;not null;default:1" json:"quantity"`
+	CompanyID   uuid.UUID `gorm:"type:uuid;not null;unique_index:idx_pos_product_barcodes_company_barcode" json:"company_id"`
+	CreatedAt   time.Time `gorm:"type:timestamp" json:"created_at"`
+	CreatedBy   uuid.UUID `gorm:"type:uuid" json:"created_by"`
+	UpdatedAt   time.Time `gorm:"type:timestamp" json:"updated_at"`
+	UpdatedBy   uuid.UUID `gorm:"type:uuid" json:"updated_by"`
+}
+
+// PosInternalBarcodeSequence is the position of the next internal barcode a company generates
+// within a prefix range, e.g. 040-049
+type PosInternalBarcodeSequence struct {
+	CompanyID   uuid.UUID `gorm:"type:uuid;primary_key" json:"company_id"`
+	PrefixRange string    `gorm:"type:varchar(30);primary_key" json:"prefix_range"`
+	NextValue   int64     `gorm:"type:bigint;not null;default:0" json:"next_value"`
+	UpdatedAt   time.Time `gorm:"type:timestamp" json:"updated_at"`
+}
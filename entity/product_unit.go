@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultBaseUnit is the base unit of products that do not set one
+const DefaultBaseUnit = "each"
+
+// PosProductUnit converts a unit of measure of a product to its base unit, Factor is the number
+// of base units in one unit, e.g. a case of 24 has factor 24 over a base unit of each. Units
+// with AllowFraction accept fractional quantities, e.g. 1.25 kg over a base unit of g.
+type PosProductUnit struct {
+	ProductUnitID uuid.UUID `gorm:"type:uuid;primary_key" json:"product_unit_id"`
+	ProductID     uuid.UUID `gorm:"type:uuid;not null;unique_index:idx_pos_product_units_product_unit" json:"product_id"`
+	Unit          string    `gorm:"type:varchar(20);not null;unique_index:idx_pos_product_units_product_unit" json:"unit"`
+	Factor        float64   `gorm:"type:decimal(14,4);not null" json:"factor"`
+	AllowFraction bool      `gorm:"type:boolean;default:false" json:"allow_fraction"`
+	CompanyID     uuid.UUID `gorm:"type:uuid;not null" json:"company_id"`
+	CreatedAt     time.Time `gorm:"type:timestamp" json:"created_at"`
+	CreatedBy     uuid.UUID `gorm:"type:uuid" json:"created_by"`
+	UpdatedAt     time.Time `gorm:"type:timestamp" json:"updated_at"`
+	UpdatedBy     uuid.UUID `gorm:"type:uuid" json:"updated_by"`
+}
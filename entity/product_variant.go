@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PosProductOption is an option axis of a parent product, such as size or colour. The stock of a
+// parent product with options is kept on its variants.
+type PosProductOption struct {
+	OptionID  uuid.UUID `gorm:"type:uuid;primary_key" json:"option_id"`
+	ProductID uuid.UUID `gorm:"type:uuid;not null;unique_index:idx_pos_product_options_product_name" json:"product_id"`
+	Name      string    `gorm:"type:varchar(50);not null;unique_index:idx_pos_product_options_product_name" json:"name"`
+	Position  int       `gorm:"type:int;not null" json:"position"`
+	CompanyID uuid.UUID `gorm:"type:uuid;not null" json:"company_id"`
+	CreatedAt time.Time `gorm:"type:timestamp" json:"created_at"`
+	CreatedBy uuid.UUID `gorm:"type:uuid" json:"created_by"`
+	UpdatedAt time.Time `gorm:"type:timestamp" json:"updated_at"`
+	UpdatedBy uuid.UUID `gorm:"type:uuid" json:"updated_by"`
+}
+
+// PosProductVariantValue is the value of a variant product on an option axis of its parent
+type PosProductVariantValue struct {
+	ProductID uuid.UUID `gorm:"type:uuid;primary_key" json:"product_id"`
+	OptionID  uuid.UUID `gorm:"type:uuid;primary_key" json:"option_id"`
+	Value     string    `gorm:"type:varchar(100);not null" json:"value"`
+}
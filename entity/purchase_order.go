@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Purchase order statuses
+const (
+	PurchaseOrderStatusDraft             = "draft"
+	PurchaseOrderStatusSubmitted         = "submitted"
+	PurchaseOrderStatusPartiallyReceived = "partially_received"
+	PurchaseOrderStatusReceived          = "received"
+	PurchaseOrderStatusCancelled         = "cancelled"
+)
+
+type PosPurchaseOrder struct {
+	PurchaseOrderID uuid.UUID  `gorm:"type:uuid;primary_key" json:"purchase_order_id"`
+	SupplierID      uuid.UUID  `gorm:"type:uuid;not null" json:"supplier_id"`
+	StoreID         uuid.UUID  `gorm:"type:uuid;not null" json:"store_id"`
+	BranchID        uuid.UUID  `gorm:"type:uuid;not null" json:"branch_id"`
+	Status          string     `gorm:"type:varchar(20);not null" json:"status"`
+	Note            string     `gorm:"type:text" json:"note"`
+	SubmittedAt     *time.Time `gorm:"type:timestamp" json:"submitted_at"`
+	SubmittedBy     *uuid.UUID `gorm:"type:uuid" json:"submitted_by"`
+	ReceivedAt      *time.Time `gorm:"type:timestamp" json:"received_at"`
+	CompanyID       uuid.UUID  `gorm:"type:uuid;not null" json:"company_id"`
+	CreatedAt       time.Time  `gorm:"type:timestamp" json:"created_at"`
+	CreatedBy       uuid.UUID  `gorm:"type:uuid" json:"created_by"`
+	UpdatedAt       time.Time  `gorm:"type:timestamp" json:"updated_at"`
+	UpdatedBy       uuid.UUID  `gorm:"type:uuid" json:"updated_by"`
+}
+
+type PosPurchaseOrderItem struct {
+	PurchaseOrderItemID uuid.UUID `gorm:"type:uuid;primary_key" json:"purchase_order_item_id"`
+	PurchaseOrderID     uuid.UUID `gorm:"type:uuid;not null" json:"purchase_order_id"`
+	ProductID           uuid.UUID `gorm:"type:uuid;not null" json:"product_id"`
+	Quantity            int       `gorm:"type:int;not null" json:"quantity"`
+	ReceivedQuantity    int       `gorm:"type:int;not null" json:"received_quantity"`
+	UnitCost            float64   `gorm:"type:decimal(10,2);not null" json:"unit_cost"`
+}
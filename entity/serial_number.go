@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Serial number statuses
+const (
+	SerialStatusInStock     = "in_stock"
+	SerialStatusSold        = "sold"
+	SerialStatusReturned    = "returned"
+	SerialStatusTransferred = "transferred"
+	SerialStatusRemoved     = "removed"
+)
+
+// PosSerialNumber is one unit of a serialized product, with the store it is in and its status
+// after its last inventory movement
+type PosSerialNumber struct {
+	SerialID     uuid.UUID `gorm:"type:uuid;primary_key" json:"serial_id"`
+	ProductID    uuid.UUID `gorm:"type:uuid;not null;unique_index:idx_pos_serial_numbers_product_serial" json:"product_id"`
+	SerialNumber string    `gorm:"type:varchar(100);not null;unique_index:idx_pos_serial_numbers_product_serial" json:"serial_number"`
+	StoreID      uuid.UUID `gorm:"type:uuid;not null" json:"store_id"`
+	Status       string    `gorm:"type:varchar(20);not null" json:"status"`
+	BranchID     uuid.UUID `gorm:"type:uuid;not null" json:"branch_id"`
+	CompanyID    uuid.UUID `gorm:"type:uuid;not null" json:"company_id"`
+	CreatedAt    time.Time `gorm:"type:timestamp" json:"created_at"`
+	UpdatedAt    time.Time `gorm:"type:timestamp" json:"updated_at"`
+}
+
+// PosInventoryHistorySerial links an inventory movement to a serial number it moved
+type PosInventoryHistorySerial struct {
+	InventoryID uuid.UUID `gorm:"type:uuid;primary_key" json:"inventory_id"`
+	SerialID    uuid.UUID `gorm:"type:uuid;primary_key" json:"serial_id"`
+}
+
+// PosStockTransferItemSerial is a serial number to be moved by a stock transfer item
+type PosStockTransferItemSerial struct {
+	TransferItemID uuid.UUID `gorm:"type:uuid;primary_key" json:"transfer_item_id"`
+	SerialNumber   string    `gorm:"type:varchar(100);primary_key" json:"serial_number"`
+}
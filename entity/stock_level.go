@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PosStockLevel struct {
+	ProductID    uuid.UUID `gorm:"type:uuid;primary_key" json:"product_id"`
+	StoreID      uuid.UUID `gorm:"type:uuid;primary_key" json:"store_id"`
+	Quantity     int       `gorm:"type:int;not null" json:"quantity"`
+	ReorderLevel int       `gorm:"type:int" json:"reorder_level"`
+	MaxLevel     int       `gorm:"type:int" json:"max_level"`
+	BranchID     uuid.UUID `gorm:"type:uuid;not null" json:"branch_id"`
+	CompanyID    uuid.UUID `gorm:"type:uuid;not null" json:"company_id"`
+	CreatedAt    time.Time `gorm:"type:timestamp" json:"created_at"`
+	CreatedBy    uuid.UUID `gorm:"type:uuid" json:"created_by"`
+	UpdatedAt    time.Time `gorm:"type:timestamp" json:"updated_at"`
+	UpdatedBy    uuid.UUID `gorm:"type:uuid" json:"updated_by"`
+}
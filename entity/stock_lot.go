@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PosStockLot struct {
+	LotID      uuid.UUID  `gorm:"type:uuid;primary_key" json:"lot_id"`
+	ProductID  uuid.UUID  `gorm:"type:uuid;not null;unique_index:idx_pos_stock_lots_product_store_lot" json:"product_id"`
+	StoreID    uuid.UUID  `gorm:"type:uuid;not null;unique_index:idx_pos_stock_lots_product_store_lot" json:"store_id"`
+	LotCode    string     `gorm:"type:varchar(100);not null;unique_index:idx_pos_stock_lots_product_store_lot" json:"lot_code"`
+	ExpiryDate *time.Time `gorm:"type:timestamp" json:"expiry_date"`
+	Quantity   int        `gorm:"type:int;not null" json:"quantity"`
+	BranchID   uuid.UUID  `gorm:"type:uuid;not null" json:"branch_id"`
+	CompanyID  uuid.UUID  `gorm:"type:uuid;not null" json:"company_id"`
+	CreatedAt  time.Time  `gorm:"type:timestamp" json:"created_at"`
+	UpdatedAt  time.Time  `gorm:"type:timestamp" json:"updated_at"`
+}
+
+// PosInventoryHistoryLot is the quantity of an inventory movement taken from or put into a lot
+type PosInventoryHistoryLot struct {
+	InventoryID uuid.UUID `gorm:"type:uuid;primary_key" json:"inventory_id"`
+	LotID       uuid.UUID `gorm:"type:uuid;primary_key" json:"lot_id"`
+	Quantity    int       `gorm:"type:int;not null" json:"quantity"`
+}
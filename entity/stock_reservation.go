@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Stock reservation statuses
+const (
+	ReservationStatusActive    = "active"
+	ReservationStatusCommitted = "committed"
+	ReservationStatusReleased  = "released"
+	ReservationStatusExpired   = "expired"
+)
+
+// PosStockReservation holds stock of a product in a store for an in-progress checkout until it is
+// committed as a sale, released or expires
+type PosStockReservation struct {
+	ReservationID uuid.UUID  `gorm:"type:uuid;primary_key" json:"reservation_id"`
+	ProductID     uuid.UUID  `gorm:"type:uuid;not null" json:"product_id"`
+	StoreID       uuid.UUID  `gorm:"type:uuid;not null" json:"store_id"`
+	Quantity      int        `gorm:"type:int;not null" json:"quantity"`
+	Status        string     `gorm:"type:varchar(20);not null" json:"status"`
+	ReferenceNo   string     `gorm:"type:varchar(255)" json:"reference_no"`
+	ExpiresAt     time.Time  `gorm:"type:timestamp;not null" json:"expires_at"`
+	InventoryID   *uuid.UUID `gorm:"type:uuid" json:"inventory_id"`
+	BranchID      uuid.UUID  `gorm:"type:uuid;not null" json:"branch_id"`
+	CompanyID     uuid.UUID  `gorm:"type:uuid;not null" json:"company_id"`
+	CreatedAt     time.Time  `gorm:"type:timestamp" json:"created_at"`
+	CreatedBy     uuid.UUID  `gorm:"type:uuid" json:"created_by"`
+	UpdatedAt     time.Time  `gorm:"type:timestamp" json:"updated_at"`
+	UpdatedBy     uuid.UUID  `gorm:"type:uuid" json:"updated_by"`
+}
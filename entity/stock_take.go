@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Stock take scopes
+const (
+	StockTakeScopeStore    = "store"
+	StockTakeScopeCategory = "category"
+	StockTakeScopeProducts = "products"
+)
+
+// Stock take statuses
+const (
+	StockTakeStatusOpen      = "open"
+	StockTakeStatusApproved  = "approved"
+	StockTakeStatusCancelled = "cancelled"
+)
+
+type PosStockTake struct {
+	StockTakeID uuid.UUID  `gorm:"type:uuid;primary_key" json:"stock_take_id"`
+	StoreID     uuid.UUID  `gorm:"type:uuid;not null" json:"store_id"`
+	BranchID    uuid.UUID  `gorm:"type:uuid;not null" json:"branch_id"`
+	Scope       string     `gorm:"type:varchar(20);not null" json:"scope"`
+	CategoryID  *uuid.UUID `gorm:"type:uuid" json:"category_id"`
+	Status      string     `gorm:"type:varchar(20);not null" json:"status"`
+	Note        string     `gorm:"type:text" json:"note"`
+	ApprovedAt  *time.Time `gorm:"type:timestamp" json:"approved_at"`
+	ApprovedBy  *uuid.UUID `gorm:"type:uuid" json:"approved_by"`
+	CompanyID   uuid.UUID  `gorm:"type:uuid;not null" json:"company_id"`
+	CreatedAt   time.Time  `gorm:"type:timestamp" json:"created_at"`
+	CreatedBy   uuid.UUID  `gorm:"type:uuid" json:"created_by"`
+	UpdatedAt   time.Time  `gorm:"type:timestamp" json:"updated_at"`
+	UpdatedBy   uuid.UUID  `gorm:"type:uuid" json:"updated_by"`
+}
+
+type PosStockTakeItem struct {
+	StockTakeItemID  uuid.UUID  `gorm:"type:uuid;primary_key" json:"stock_take_item_id"`
+	StockTakeID      uuid.UUID  `gorm:"type:uuid;not null" json:"stock_take_id"`
+	ProductID        uuid.UUID  `gorm:"type:uuid;not null" json:"product_id"`
+	ExpectedQuantity int        `gorm:"type:int;not null" json:"expected_quantity"`
+	CountedQuantity  *int       `gorm:"type:int" json:"counted_quantity"`
+	CountedAt        *time.Time `gorm:"type:timestamp" json:"counted_at"`
+	CountedBy        *uuid.UUID `gorm:"type:uuid" json:"counted_by"`
+}
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Stock transfer statuses
+const (
+	TransferStatusCreated    = "created"
+	TransferStatusDispatched = "dispatched"
+	TransferStatusReceived   = "received"
+	TransferStatusCancelled  = "cancelled"
+)
+
+type PosStockTransfer struct {
+	TransferID   uuid.UUID  `gorm:"type:uuid;primary_key" json:"transfer_id"`
+	FromStoreID  uuid.UUID  `gorm:"type:uuid;not null" json:"from_store_id"`
+	FromBranchID uuid.UUID  `gorm:"type:uuid;not null" json:"from_branch_id"`
+	ToStoreID    uuid.UUID  `gorm:"type:uuid;not null" json:"to_store_id"`
+	ToBranchID   uuid.UUID  `gorm:"type:uuid;not null" json:"to_branch_id"`
+	Status       string     `gorm:"type:varchar(20);not null" json:"status"`
+	Note         string     `gorm:"type:text" json:"note"`
+	DispatchedAt *time.Time `gorm:"type:timestamp" json:"dispatched_at"`
+	DispatchedBy *uuid.UUID `gorm:"type:uuid" json:"dispatched_by"`
+	ReceivedAt   *time.Time `gorm:"type:timestamp" json:"received_at"`
+	ReceivedBy   *uuid.UUID `gorm:"type:uuid" json:"received_by"`
+	CompanyID    uuid.UUID  `gorm:"type:uuid;not null" json:"company_id"`
+	CreatedAt    time.Time  `gorm:"type:timestamp" json:"created_at"`
+	CreatedBy    uuid.UUID  `gorm:"type:uuid" json:"created_by"`
+	UpdatedAt    time.Time  `gorm:"type:timestamp" json:"updated_at"`
+	UpdatedBy    uuid.UUID  `gorm:"type:uuid" json:"updated_by"`
+}
+
+type PosStockTransferItem struct {
+	TransferItemID uuid.UUID `gorm:"type:uuid;primary_key" json:"transfer_item_id"`
+	TransferID     uuid.UUID `gorm:"type:uuid;not null" json:"transfer_id"`
+	ProductID      uuid.UUID `gorm:"type:uuid;not null" json:"product_id"`
+	Quantity       int       `gorm:"type:int;not null" json:"quantity"`
+	SerialNumbers  []string  `gorm:"-" json:"serial_numbers"`
+}
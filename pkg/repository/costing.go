@@ -0,0 +1,238 @@
+package repository
+
+import (
+	"math"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosCostingSettingRepository interface {
+	ReadPosCostingSetting(companyID string) (*pb.PosCostingSetting, error)
+	UpdatePosCostingSetting(posCostingSetting *entity.PosCostingSetting) error
+}
+
+type posCostingSettingRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+func NewPosCostingSettingRepository(db *gorm.DB, redis *redis.Client) PosCostingSettingRepository {
+	return &posCostingSettingRepository{
+		db:    db,
+		redis: redis,
+	}
+}
+
+// ReadPosCostingSetting returns the costing setting of the company, companies that never chose
+// a costing method use the weighted average
+func (r *posCostingSettingRepository) ReadPosCostingSetting(companyID string) (*pb.PosCostingSetting, error) {
+	var posCostingSetting entity.PosCostingSetting
+
+	err := r.db.Where("company_id = ?", companyID).First(&posCostingSetting).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return &pb.PosCostingSetting{
+			CompanyId:     companyID,
+			CostingMethod: entity.CostingMethodWeightedAverage,
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &pb.PosCostingSetting{
+		CompanyId:     posCostingSetting.CompanyID.String(),
+		CostingMethod: posCostingSetting.CostingMethod,
+		CreatedAt:     timestamppb.New(posCostingSetting.CreatedAt),
+		CreatedBy:     posCostingSetting.CreatedBy.String(),
+		UpdatedAt:     timestamppb.New(posCostingSetting.UpdatedAt),
+		UpdatedBy:     posCostingSetting.UpdatedBy.String(),
+	}, nil
+}
+
+func (r *posCostingSettingRepository) UpdatePosCostingSetting(posCostingSetting *entity.PosCostingSetting) error {
+	return r.db.Exec(`INSERT INTO pos_costing_settings (company_id, costing_method, created_at, created_by, updated_at, updated_by)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (company_id) DO UPDATE SET costing_method = EXCLUDED.costing_method, updated_at = EXCLUDED.updated_at, updated_by = EXCLUDED.updated_by`,
+		posCostingSetting.CompanyID, posCostingSetting.CostingMethod, posCostingSetting.CreatedAt, posCostingSetting.CreatedBy,
+		posCostingSetting.UpdatedAt, posCostingSetting.UpdatedBy).Error
+}
+
+// readCostingMethod returns the costing method of the company inside the given transaction
+func readCostingMethod(tx *gorm.DB, companyID uuid.UUID) (string, error) {
+	var posCostingSetting entity.PosCostingSetting
+
+	err := tx.Where("company_id = ?", companyID).First(&posCostingSetting).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return entity.CostingMethodWeightedAverage, nil
+	} else if err != nil {
+		return "", err
+	}
+
+	return posCostingSetting.CostingMethod, nil
+}
+
+// applyCostMovement values an inventory movement before it is inserted. Positive movements open
+// a cost layer at their unit cost, the product cost price when no unit cost is given. Decrements
+// consume the oldest layers of the store and are valued at those layers for FIFO companies, or at
+// the product cost price for weighted average companies. The product cost price is then kept in
+// sync with the current average cost. It must run inside the transaction of applyInventoryMovement
+// with the product as it was locked, before the movement is applied to its stock quantity.
+func applyCostMovement(tx *gorm.DB, posProduct *entity.PosProduct, posInventoryHistory *entity.PosInventoryHistory) error {
+	if posInventoryHistory.Quantity == 0 {
+		return nil
+	}
+
+	costingMethod, err := readCostingMethod(tx, posInventoryHistory.CompanyID)
+	if err != nil {
+		return err
+	}
+
+	costPrice := posProduct.CostPrice
+
+	if posInventoryHistory.Quantity > 0 {
+		if posInventoryHistory.UnitCost <= 0 {
+			posInventoryHistory.UnitCost = posProduct.CostPrice
+		}
+		posInventoryHistory.CostAmount = roundCost(float64(posInventoryHistory.Quantity) * posInventoryHistory.UnitCost)
+
+		err := tx.Create(&entity.PosCostLayer{
+			CostLayerID:       uuid.New(),
+			ProductID:         posInventoryHistory.ProductID,
+			StoreID:           *posInventoryHistory.StoreID,
+			InventoryID:       posInventoryHistory.InventoryID,
+			UnitCost:          posInventoryHistory.UnitCost,
+			Quantity:          posInventoryHistory.Quantity,
+			RemainingQuantity: posInventoryHistory.Quantity,
+			BranchID:          *posInventoryHistory.BranchID,
+			CompanyID:         posInventoryHistory.CompanyID,
+			CreatedAt:         posInventoryHistory.CreatedAt,
+			UpdatedAt:         posInventoryHistory.UpdatedAt,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		if costingMethod == entity.CostingMethodWeightedAverage {
+			onHand := posProduct.StockQuantity
+			if onHand < 0 {
+				onHand = 0
+			}
+			costPrice = (float64(onHand)*posProduct.CostPrice + posInventoryHistory.CostAmount) / float64(onHand+posInventoryHistory.Quantity)
+		}
+	} else {
+		fifoCost, err := consumeCostLayers(tx, posProduct, posInventoryHistory)
+		if err != nil {
+			return err
+		}
+
+		costAmount := float64(posInventoryHistory.Quantity) * posProduct.CostPrice
+		if costingMethod == entity.CostingMethodFIFO {
+			costAmount = -fifoCost
+		}
+		posInventoryHistory.CostAmount = roundCost(costAmount)
+		posInventoryHistory.UnitCost = roundCost(costAmount / float64(posInventoryHistory.Quantity))
+	}
+
+	if costingMethod == entity.CostingMethodFIFO {
+		var layerValue struct {
+			Quantity int
+			Value    float64
+		}
+
+		err := tx.Model(&entity.PosCostLayer{}).
+			Select("COALESCE(SUM(remaining_quantity), 0) AS quantity, COALESCE(SUM(remaining_quantity * unit_cost), 0) AS value").
+			Where("product_id = ? AND remaining_quantity > 0", posInventoryHistory.ProductID).
+			Scan(&layerValue).Error
+		if err != nil {
+			return err
+		}
+
+		if layerValue.Quantity > 0 {
+			costPrice = layerValue.Value / float64(layerValue.Quantity)
+		}
+	}
+
+	if costPrice == posProduct.CostPrice {
+		return nil
+	}
+
+	return tx.Model(&entity.PosProduct{}).Where("product_id = ?", posInventoryHistory.ProductID).UpdateColumns(map[string]interface{}{
+		"cost_price": roundCost(costPrice),
+	}).Error
+}
+
+// consumeCostLayers takes the decrement out of the oldest cost layers of the store and returns
+// its FIFO cost, the quantity not covered by any layer is valued at the product cost price.
+// The reversal of a receipt takes from the layer the receipt opened first.
+func consumeCostLayers(tx *gorm.DB, posProduct *entity.PosProduct, posInventoryHistory *entity.PosInventoryHistory) (float64, error) {
+	var posCostLayers []entity.PosCostLayer
+
+	query := tx.Where("product_id = ? AND store_id = ? AND remaining_quantity > 0", posInventoryHistory.ProductID, *posInventoryHistory.StoreID)
+	if posInventoryHistory.ReversalOfID != nil {
+		query = query.Order(gorm.Expr("CASE WHEN inventory_id = ? THEN 0 ELSE 1 END", *posInventoryHistory.ReversalOfID))
+	}
+
+	err := query.Order("created_at asc").
+		Find(&posCostLayers).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var cost float64
+	remaining := -posInventoryHistory.Quantity
+	for _, posCostLayer := range posCostLayers {
+		if remaining == 0 {
+			break
+		}
+
+		taken := posCostLayer.RemainingQuantity
+		if taken > remaining {
+			taken = remaining
+		}
+
+		err := tx.Model(&entity.PosCostLayer{}).Where("cost_layer_id = ?", posCostLayer.CostLayerID).UpdateColumns(map[string]interface{}{
+			"remaining_quantity": gorm.Expr("remaining_quantity - ?", taken),
+			"updated_at":         posInventoryHistory.UpdatedAt,
+		}).Error
+		if err != nil {
+			return 0, err
+		}
+
+		cost += float64(taken) * posCostLayer.UnitCost
+		remaining -= taken
+	}
+
+	return cost + float64(remaining)*posProduct.CostPrice, nil
+}
+
+// readTransferOutUnitCost returns the unit cost the transfer item left the source store at,
+// so the destination store receives it at the same cost
+func readTransferOutUnitCost(tx *gorm.DB, transferID uuid.UUID, productID uuid.UUID) (float64, error) {
+	var transferOut struct {
+		Quantity   int
+		CostAmount float64
+	}
+
+	err := tx.Model(&entity.PosInventoryHistory{}).
+		Select("COALESCE(SUM(quantity), 0) AS quantity, COALESCE(SUM(cost_amount), 0) AS cost_amount").
+		Where("transfer_id = ? AND product_id = ? AND movement_type = ?", transferID, productID, entity.MovementTypeTransferOut).
+		Scan(&transferOut).Error
+	if err != nil {
+		return 0, err
+	}
+
+	if transferOut.Quantity == 0 {
+		return 0, nil
+	}
+
+	return roundCost(transferOut.CostAmount / float64(transferOut.Quantity)), nil
+}
+
+func roundCost(value float64) float64 {
+	return math.Round(value*10000) / 10000
+}
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosEmbeddedBarcodeRuleRepository interface {
+	CreatePosEmbeddedBarcodeRule(posEmbeddedBarcodeRule *entity.PosEmbeddedBarcodeRule) error
+	ReadPosEmbeddedBarcodeRule(ruleID string) (*pb.PosEmbeddedBarcodeRule, error)
+	UpdatePosEmbeddedBarcodeRule(posEmbeddedBarcodeRule *entity.PosEmbeddedBarcodeRule) error
+	DeletePosEmbeddedBarcodeRule(ruleID string) error
+	ReadAllPosEmbeddedBarcodeRules(companyID string) ([]*pb.PosEmbeddedBarcodeRule, error)
+}
+
+type posEmbeddedBarcodeRuleRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+func NewPosEmbeddedBarcodeRuleRepository(db *gorm.DB, redis *redis.Client) PosEmbeddedBarcodeRuleRepository {
+	return &posEmbeddedBarcodeRuleRepository{
+		db:    db,
+		redis: redis,
+	}
+}
+
+func (r *posEmbeddedBarcodeRuleRepository) CreatePosEmbeddedBarcodeRule(posEmbeddedBarcodeRule *entity.PosEmbeddedBarcodeRule) error {
+	return r.db.Create(posEmbeddedBarcodeRule).Error
+}
+
+func (r *posEmbeddedBarcodeRuleRepository) ReadPosEmbeddedBarcodeRule(ruleID string) (*pb.PosEmbeddedBarcodeRule, error) {
+	var posEmbeddedBarcodeRule entity.PosEmbeddedBarcodeRule
+	if err := r.db.Where("rule_id = ?", ruleID).First(&posEmbeddedBarcodeRule).Error; err != nil {
+		return nil, err
+	}
+
+	return toPbPosEmbeddedBarcodeRule(posEmbeddedBarcodeRule), nil
+}
+
+func (r *posEmbeddedBarcodeRuleRepository) UpdatePosEmbeddedBarcodeRule(posEmbeddedBarcodeRule *entity.PosEmbeddedBarcodeRule) error {
+	return r.db.Save(posEmbeddedBarcodeRule).Error
+}
+
+func (r *posEmbeddedBarcodeRuleRepository) DeletePosEmbeddedBarcodeRule(ruleID string) error {
+	return r.db.Where("rule_id = ?", ruleID).Delete(&entity.PosEmbeddedBarcodeRule{}).Error
+}
+
+func (r *posEmbeddedBarcodeRuleRepository) ReadAllPosEmbeddedBarcodeRules(companyID string) ([]*pb.PosEmbeddedBarcodeRule, error) {
+	var posEmbeddedBarcodeRules []entity.PosEmbeddedBarcodeRule
+	if err := r.db.Where("company_id = ?", companyID).Order("prefix asc").Find(&posEmbeddedBarcodeRules).Error; err != nil {
+		return nil, err
+	}
+
+	pbPosEmbeddedBarcodeRules := make([]*pb.PosEmbeddedBarcodeRule, len(posEmbeddedBarcodeRules))
+	for i, posEmbeddedBarcodeRule := range posEmbeddedBarcodeRules {
+		pbPosEmbeddedBarcodeRules[i] = toPbPosEmbeddedBarcodeRule(posEmbeddedBarcodeRule)
+	}
+
+	return pbPosEmbeddedBarcodeRules, nil
+}
+
+// decodeEmbeddedBarcode decodes an EAN-13 scale label by the rule of the company with the longest
+// prefix it starts with. It returns the label with the digits after the PLU set to zero, which the
+// product of the PLU is found by, or nil when no rule matches the barcode.
+func decodeEmbeddedBarcode(db *gorm.DB, companyID uuid.UUID, barcode string) (*entity.PosEmbeddedBarcodeRule, *pb.PosEmbeddedBarcode, string, error) {
+	if len(barcode) != 13 || !utils.ValidGTIN(barcode) {
+		return nil, nil, "", nil
+	}
+
+	var posEmbeddedBarcodeRules []entity.PosEmbeddedBarcodeRule
+	if err := db.Where("company_id = ?", companyID).Find(&posEmbeddedBarcodeRules).Error; err != nil {
+		return nil, nil, "", err
+	}
+
+	return decodeEmbeddedLabel(posEmbeddedBarcodeRules, barcode)
+}
+
+// decodeEmbeddedLabel decodes a valid EAN-13 scale label by the rule with the longest prefix it
+// starts with
+func decodeEmbeddedLabel(posEmbeddedBarcodeRules []entity.PosEmbeddedBarcodeRule, barcode string) (*entity.PosEmbeddedBarcodeRule, *pb.PosEmbeddedBarcode, string, error) {
+	var rule *entity.PosEmbeddedBarcodeRule
+	for i := range posEmbeddedBarcodeRules {
+		candidate := &posEmbeddedBarcodeRules[i]
+		if strings.HasPrefix(barcode, candidate.Prefix) && (rule == nil || len(candidate.Prefix) > len(rule.Prefix)) {
+			rule = candidate
+		}
+	}
+
+	if rule == nil {
+		return nil, nil, "", nil
+	}
+
+	pluEnd := len(rule.Prefix) + rule.PluDigits
+	value, err := strconv.ParseInt(barcode[12-rule.ValueDigits:12], 10, 64)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	decodedValue := float64(value) / math.Pow10(rule.Decimals)
+
+	embedded := &pb.PosEmbeddedBarcode{
+		RuleId:    rule.RuleID.String(),
+		Plu:       barcode[len(rule.Prefix):pluEnd],
+		ValueType: rule.ValueType,
+	}
+
+	switch rule.ValueType {
+	case entity.EmbeddedValueTypePrice:
+		embedded.Price = decodedValue
+	case entity.EmbeddedValueTypeWeight:
+		embedded.Weight = decodedValue
+		embedded.Unit = rule.Unit
+	}
+
+	lookup := barcode[:pluEnd] + strings.Repeat("0", 12-pluEnd)
+	lookup += string(utils.GTINCheckDigit(lookup))
+
+	return rule, embedded, lookup, nil
+}
+
+func toPbPosEmbeddedBarcodeRule(posEmbeddedBarcodeRule entity.PosEmbeddedBarcodeRule) *pb.PosEmbeddedBarcodeRule {
+	return &pb.PosEmbeddedBarcodeRule{
+		RuleId:      posEmbeddedBarcodeRule.RuleID.String(),
+		Prefix:      posEmbeddedBarcodeRule.Prefix,
+		PluDigits:   int32(posEmbeddedBarcodeRule.PluDigits),
+		ValueDigits: int32(posEmbeddedBarcodeRule.ValueDigits),
+		ValueType:   posEmbeddedBarcodeRule.ValueType,
+		Decimals:    int32(posEmbeddedBarcodeRule.Decimals),
+		Unit:        posEmbeddedBarcodeRule.Unit,
+		CompanyId:   posEmbeddedBarcodeRule.CompanyID.String(),
+		CreatedAt:   timestamppb.New(posEmbeddedBarcodeRule.CreatedAt),
+		CreatedBy:   posEmbeddedBarcodeRule.CreatedBy.String(),
+		UpdatedAt:   timestamppb.New(posEmbeddedBarcodeRule.UpdatedAt),
+		UpdatedBy:   posEmbeddedBarcodeRule.UpdatedBy.String(),
+	}
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+
+	"github.com/google/uuid"
+)
+
+func TestDecodeEmbeddedLabel(t *testing.T) {
+	priceRule := entity.PosEmbeddedBarcodeRule{RuleID: uuid.New(), Prefix: "20", PluDigits: 5, ValueDigits: 5, ValueType: entity.EmbeddedValueTypePrice, Decimals: 2}
+	weightRule := entity.PosEmbeddedBarcodeRule{RuleID: uuid.New(), Prefix: "21", PluDigits: 5, ValueDigits: 5, ValueType: entity.EmbeddedValueTypeWeight, Decimals: 3, Unit: "kg"}
+	shortPriceRule := entity.PosEmbeddedBarcodeRule{RuleID: uuid.New(), Prefix: "2", PluDigits: 5, ValueDigits: 4, ValueType: entity.EmbeddedValueTypePrice, Decimals: 2}
+	rules := []entity.PosEmbeddedBarcodeRule{shortPriceRule, priceRule, weightRule}
+
+	tests := []struct {
+		name       string
+		barcode    string
+		wantRule   *entity.PosEmbeddedBarcodeRule
+		wantPlu    string
+		wantPrice  float64
+		wantWeight float64
+		wantUnit   string
+		wantLookup string
+	}{
+		{"price label", "2012345012349", &priceRule, "12345", 12.34, 0, "", "2012345000001"},
+		{"weight label", "2112345015002", &weightRule, "12345", 0, 1.5, "kg", "2112345000008"},
+		{"shorter prefix when no longer one matches", "2212345009992", &shortPriceRule, "21234", 9.99, 0, "", "2212340000000"},
+		{"zero value", "2012345000001", &priceRule, "12345", 0, 0, "", "2012345000001"},
+		{"no rule for the prefix", "4006381333931", nil, "", 0, 0, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, embedded, lookup, err := decodeEmbeddedLabel(rules, tt.barcode)
+			if err != nil {
+				t.Fatalf("decodeEmbeddedLabel(%q) error = %v", tt.barcode, err)
+			}
+
+			if tt.wantRule == nil {
+				if rule != nil || embedded != nil || lookup != "" {
+					t.Errorf("decodeEmbeddedLabel(%q) = %v, %v, %q, want no match", tt.barcode, rule, embedded, lookup)
+				}
+				return
+			}
+
+			if rule == nil || rule.RuleID != tt.wantRule.RuleID {
+				t.Fatalf("decodeEmbeddedLabel(%q) rule = %v, want prefix %s", tt.barcode, rule, tt.wantRule.Prefix)
+			}
+			if embedded.RuleId != tt.wantRule.RuleID.String() || embedded.ValueType != tt.wantRule.ValueType {
+				t.Errorf("decodeEmbeddedLabel(%q) embedded rule = %s %s, want %s %s", tt.barcode, embedded.RuleId, embedded.ValueType, tt.wantRule.RuleID, tt.wantRule.ValueType)
+			}
+			if embedded.Plu != tt.wantPlu {
+				t.Errorf("decodeEmbeddedLabel(%q) plu = %q, want %q", tt.barcode, embedded.Plu, tt.wantPlu)
+			}
+			if math.Abs(embedded.Price-tt.wantPrice) > 1e-9 || math.Abs(embedded.Weight-tt.wantWeight) > 1e-9 || embedded.Unit != tt.wantUnit {
+				t.Errorf("decodeEmbeddedLabel(%q) price, weight, unit = %v, %v, %q, want %v, %v, %q", tt.barcode, embedded.Price, embedded.Weight, embedded.Unit, tt.wantPrice, tt.wantWeight, tt.wantUnit)
+			}
+			if lookup != tt.wantLookup {
+				t.Errorf("decodeEmbeddedLabel(%q) lookup = %q, want %q", tt.barcode, lookup, tt.wantLookup)
+			}
+		})
+	}
+}
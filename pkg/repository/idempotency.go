@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/jinzhu/gorm"
+)
+
+const (
+	IdempotencyStatusPending   = "pending"
+	IdempotencyStatusCompleted = "completed"
+)
+
+// IdempotencyRecord is what is stored under an idempotency key: the fingerprint of the request and,
+// once it completed, its marshalled response
+type IdempotencyRecord struct {
+	Fingerprint string `json:"fingerprint"`
+	Status      string `json:"status"`
+	Response    []byte `json:"response,omitempty"`
+}
+
+type PosIdempotencyRepository interface {
+	ReserveIdempotencyKey(key string, fingerprint string, ttl time.Duration) (*IdempotencyRecord, error)
+	CompleteIdempotencyKey(key string, fingerprint string, response []byte, ttl time.Duration) error
+	ReleaseIdempotencyKey(key string) error
+}
+
+type posIdempotencyRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+func NewPosIdempotencyRepository(db *gorm.DB, redis *redis.Client) PosIdempotencyRepository {
+	return &posIdempotencyRepository{
+		db:    db,
+		redis: redis,
+	}
+}
+
+// ReserveIdempotencyKey marks the key as pending for the request. It returns nil when the key was
+// free, otherwise the record already stored under it.
+func (r *posIdempotencyRepository) ReserveIdempotencyKey(key string, fingerprint string, ttl time.Duration) (*IdempotencyRecord, error) {
+	pendingData, err := json.Marshal(&IdempotencyRecord{
+		Fingerprint: fingerprint,
+		Status:      IdempotencyStatusPending,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reserved, err := r.redis.SetNX(context.Background(), "idempotency_"+key, pendingData, ttl).Result()
+	if err != nil {
+		return nil, err
+	}
+	if reserved {
+		return nil, nil
+	}
+
+	recordData, err := r.redis.Get(context.Background(), "idempotency_"+key).Result()
+	if err == redis.Nil {
+		// The key expired in between, take it again
+		return r.ReserveIdempotencyKey(key, fingerprint, ttl)
+	} else if err != nil {
+		return nil, err
+	}
+
+	var idempotencyRecord IdempotencyRecord
+	if err := json.Unmarshal([]byte(recordData), &idempotencyRecord); err != nil {
+		return nil, err
+	}
+
+	return &idempotencyRecord, nil
+}
+
+// CompleteIdempotencyKey stores the response of the request for replays of the key
+func (r *posIdempotencyRepository) CompleteIdempotencyKey(key string, fingerprint string, response []byte, ttl time.Duration) error {
+	recordData, err := json.Marshal(&IdempotencyRecord{
+		Fingerprint: fingerprint,
+		Status:      IdempotencyStatusCompleted,
+		Response:    response,
+	})
+	if err != nil {
+		return err
+	}
+
+	return r.redis.Set(context.Background(), "idempotency_"+key, recordData, ttl).Err()
+}
+
+// ReleaseIdempotencyKey frees a key whose request failed, so it can be retried
+func (r *posIdempotencyRepository) ReleaseIdempotencyKey(key string) error {
+	return r.redis.Del(context.Background(), "idempotency_"+key).Err()
+}
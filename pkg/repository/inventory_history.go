@@ -4,25 +4,32 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"log"
 	"math"
 	"os"
+	"strings"
 	"time"
 
 	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
 	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
 	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
 
 	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
 	"github.com/jinzhu/gorm"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type PosInventoryHistoryRepository interface {
 	CreatePosInventoryHistory(posInventoryHistory *entity.PosInventoryHistory) error
+	CreatePosInventoryHistoryWithStock(posInventoryHistory *entity.PosInventoryHistory) error
 	ReadPosInventoryHistory(inventoryID string) (*pb.PosInventoryHistory, error)
-	UpdatePosInventoryHistory(posInventoryHistory *entity.PosInventoryHistory) error
-	DeletePosInventoryHistory(inventoryID string) error
-	ReadAllPosInventoryHistories(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error)
+	ReversePosInventoryHistory(inventoryID string, note string, userID uuid.UUID) (*entity.PosInventoryHistory, error)
+	ReadAllPosInventoryHistories(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, filter dto.InventoryHistoryFilter) (*dto.PaginationResult, error)
+	ReadPosStockAsOf(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, asOf time.Time, productID string, storeID string) (*dto.PaginationResult, error)
+	ReadPosStockCard(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, productID string, storeID string, from *time.Time, to *time.Time) (*dto.PaginationResult, int64, error)
 }
 
 type posInventoryHistoryRepository struct {
@@ -45,6 +52,163 @@ func (r *posInventoryHistoryRepository) CreatePosInventoryHistory(posInventoryHi
 	return nil
 }
 
+// CreatePosInventoryHistoryWithStock inserts the ledger entry and applies its quantity to the
+// product stock in a single transaction. The product row is locked for the duration of the
+// transaction so concurrent adjustments can not overwrite each other.
+func (r *posInventoryHistoryRepository) CreatePosInventoryHistoryWithStock(posInventoryHistory *entity.PosInventoryHistory) error {
+	var posProduct *entity.PosProduct
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		posProduct, err = applyInventoryMovement(tx, posInventoryHistory)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// Invalidate the cached product only after the transaction is committed
+	invalidateProductCache(r.redis, *posProduct)
+
+	return nil
+}
+
+// applyInventoryMovement values and inserts the ledger entry and adjusts the stock level, lots,
+// serial numbers and cost layers of the movement store inside the given transaction, returning the product as it was before the adjustment.
+// The product total stock quantity is kept as the sum of all store stock levels.
+func applyInventoryMovement(tx *gorm.DB, posInventoryHistory *entity.PosInventoryHistory) (*entity.PosProduct, error) {
+	var posProduct entity.PosProduct
+	var posStockLevel entity.PosStockLevel
+
+	if posInventoryHistory.StoreID == nil || posInventoryHistory.BranchID == nil {
+		return nil, errors.New("error inventory movement, store id and branch id could not be empty")
+	}
+
+	// Lock the product row until commit, always before the stock level to keep a stable lock order
+	if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("product_id = ?", posInventoryHistory.ProductID).First(&posProduct).Error; err != nil {
+		return nil, err
+	}
+
+	// A parent product only groups its variants, the stock is kept on each variant
+	if posProduct.HasVariants {
+		return nil, errors.New("error inventory movement, the stock of a product with variants is kept on its variants")
+	}
+
+	// Make sure the store has a stock level row for the product
+	err := tx.Exec(`INSERT INTO pos_stock_levels (product_id, store_id, quantity, reorder_level, max_level, branch_id, company_id, created_at, created_by, updated_at, updated_by)
+		VALUES (?, ?, 0, ?, 0, ?, ?, ?, ?, ?, ?) ON CONFLICT (product_id, store_id) DO NOTHING`,
+		posInventoryHistory.ProductID, *posInventoryHistory.StoreID, posProduct.ReorderLevel, *posInventoryHistory.BranchID, posInventoryHistory.CompanyID,
+		posInventoryHistory.CreatedAt, posInventoryHistory.CreatedBy, posInventoryHistory.UpdatedAt, posInventoryHistory.UpdatedBy).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// Lock the stock level row until commit
+	if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("product_id = ? AND store_id = ?", posInventoryHistory.ProductID, *posInventoryHistory.StoreID).First(&posStockLevel).Error; err != nil {
+		return nil, err
+	}
+
+	// Decrements below zero, or into the stock held by active reservations of other checkouts for
+	// sales, follow the negative stock policy of the store
+	negativeStockPolicy := ""
+	if posInventoryHistory.Quantity < 0 {
+		quantityAfter := posStockLevel.Quantity + posInventoryHistory.Quantity
+
+		reserved := 0
+		if posInventoryHistory.MovementType == entity.MovementTypeSale {
+			reserved, err = readReservedQuantity(tx, posInventoryHistory.ProductID, *posInventoryHistory.StoreID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if quantityAfter < 0 || quantityAfter < reserved {
+			posNegativeStockSetting, err := resolveNegativeStockSetting(tx, posInventoryHistory.CompanyID, posInventoryHistory.BranchID, posInventoryHistory.StoreID)
+			if err != nil {
+				return nil, err
+			}
+
+			if posNegativeStockSetting.Policy == entity.NegativeStockPolicyForbid {
+				if quantityAfter < 0 {
+					return nil, errors.New("error cant decrease stock quantity, the request quantity is bigger than current avaliable stock quantity")
+				}
+				return nil, errors.New("error cant decrease stock quantity, the remaining stock quantity is reserved by other checkouts")
+			}
+
+			if quantityAfter < 0 {
+				negativeStockPolicy = posNegativeStockSetting.Policy
+			}
+		}
+	}
+
+	// Value the movement and keep the product cost price in sync before the stock changes
+	if err := applyCostMovement(tx, &posProduct, posInventoryHistory); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Create(posInventoryHistory).Error; err != nil {
+		return nil, err
+	}
+
+	// Apply the movement atomically instead of writing back a previously read value
+	err = tx.Model(&entity.PosStockLevel{}).Where("product_id = ? AND store_id = ?", posInventoryHistory.ProductID, *posInventoryHistory.StoreID).UpdateColumns(map[string]interface{}{
+		"quantity":   gorm.Expr("quantity + ?", posInventoryHistory.Quantity),
+		"updated_at": posInventoryHistory.UpdatedAt,
+		"updated_by": posInventoryHistory.UpdatedBy,
+	}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Model(&entity.PosProduct{}).Where("product_id = ?", posInventoryHistory.ProductID).UpdateColumns(map[string]interface{}{
+		"stock_quantity": gorm.Expr("stock_quantity + ?", posInventoryHistory.Quantity),
+		"updated_at":     posInventoryHistory.UpdatedAt,
+		"updated_by":     posInventoryHistory.UpdatedBy,
+	}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// Raise or resolve the low stock alert of the store as the stock crosses its reorder level
+	if err := applyLowStockAlert(tx, posStockLevel, posStockLevel.Quantity+posInventoryHistory.Quantity, posInventoryHistory.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	if negativeStockPolicy != "" {
+		if err := recordNegativeStockViolation(tx, posInventoryHistory, negativeStockPolicy, posStockLevel.Quantity); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := applyLotMovement(tx, posInventoryHistory); err != nil {
+		return nil, err
+	}
+
+	if err := applySerialMovement(tx, &posProduct, posInventoryHistory); err != nil {
+		return nil, err
+	}
+
+	return &posProduct, nil
+}
+
+// invalidateProductCache removes the products cached by ID. It runs after the stock transaction
+// is committed, so a failure is only logged: the movement was applied and must not be reported
+// as failed, which would let a retry post it again.
+func invalidateProductCache(redisClient *redis.Client, posProducts ...entity.PosProduct) {
+	keys := make([]string, 0, len(posProducts))
+	for _, posProduct := range posProducts {
+		keys = append(keys, posProduct.ProductID.String())
+	}
+
+	if len(keys) == 0 {
+		return
+	}
+
+	if err := redisClient.Del(context.Background(), keys...).Err(); err != nil {
+		log.Printf("failed to invalidate cached products %v: %v", keys, err)
+	}
+}
+
 func (r *posInventoryHistoryRepository) ReadPosInventoryHistory(inventoryID string) (*pb.PosInventoryHistory, error) {
 	// Try to get the inventory history from Redis first
 	inventoryHistoryData, err := r.redis.Get(context.Background(), inventoryID).Result()
@@ -55,21 +219,14 @@ func (r *posInventoryHistoryRepository) ReadPosInventoryHistory(inventoryID stri
 			return nil, err
 		}
 
-		// Convert entity.PosInventoryHistory to pb.PosInventoryHistory
-		posInventoryHistory := &pb.PosInventoryHistory{
-			InventoryId: posInventoryHistoryEntity.InventoryID.String(),
-			ProductId:   posInventoryHistoryEntity.ProductID.String(),
-			StoreId:     posInventoryHistoryEntity.StoreID.String(),
-			Date:        timestamppb.New(posInventoryHistoryEntity.Date),
-			Quantity:    int32(posInventoryHistoryEntity.Quantity),
-			BranchId:    posInventoryHistoryEntity.BranchID.String(),
-			CompanyId:   posInventoryHistoryEntity.CompanyID.String(),
-			CreatedAt:   timestamppb.New(posInventoryHistoryEntity.CreatedAt),
-			CreatedBy:   posInventoryHistoryEntity.CreatedBy.String(),
-			UpdatedAt:   timestamppb.New(posInventoryHistoryEntity.UpdatedAt),
-			UpdatedBy:   posInventoryHistoryEntity.UpdatedBy.String(),
+		posInventoryHistoryEntity.SerialNumbers, err = readMovementSerialNumbers(r.db, posInventoryHistoryEntity.InventoryID)
+		if err != nil {
+			return nil, err
 		}
 
+		// Convert entity.PosInventoryHistory to pb.PosInventoryHistory
+		posInventoryHistory := toPbPosInventoryHistory(posInventoryHistoryEntity)
+
 		// Store the inventory history in Redis for future queries
 		inventoryHistoryData, err := json.Marshal(posInventoryHistoryEntity)
 		if err != nil {
@@ -93,56 +250,101 @@ func (r *posInventoryHistoryRepository) ReadPosInventoryHistory(inventoryID stri
 	}
 
 	// Convert entity.PosInventoryHistory to pb.PosInventoryHistory
-	posInventoryHistory := &pb.PosInventoryHistory{
-		InventoryId: posInventoryHistoryEntity.InventoryID.String(),
-		ProductId:   posInventoryHistoryEntity.ProductID.String(),
-		StoreId:     posInventoryHistoryEntity.StoreID.String(),
-		Date:        timestamppb.New(posInventoryHistoryEntity.Date),
-		Quantity:    int32(posInventoryHistoryEntity.Quantity),
-		BranchId:    posInventoryHistoryEntity.BranchID.String(),
-		CompanyId:   posInventoryHistoryEntity.CompanyID.String(),
-		CreatedAt:   timestamppb.New(posInventoryHistoryEntity.CreatedAt),
-		CreatedBy:   posInventoryHistoryEntity.CreatedBy.String(),
-		UpdatedAt:   timestamppb.New(posInventoryHistoryEntity.UpdatedAt),
-		UpdatedBy:   posInventoryHistoryEntity.UpdatedBy.String(),
-	}
+	posInventoryHistory := toPbPosInventoryHistory(posInventoryHistoryEntity)
 
 	return posInventoryHistory, nil
 }
 
-func (r *posInventoryHistoryRepository) UpdatePosInventoryHistory(posInventoryHistory *entity.PosInventoryHistory) error {
-	if err := r.db.Save(posInventoryHistory).Error; err != nil {
-		return err
-	}
+// ReversePosInventoryHistory posts a compensating entry linked to the given ledger entry, so the
+// stock, lots and cost layers are rolled back while the original entry stays untouched. An entry
+// can be reversed only once and reversal entries can not be reversed themselves.
+func (r *posInventoryHistoryRepository) ReversePosInventoryHistory(inventoryID string, note string, userID uuid.UUID) (*entity.PosInventoryHistory, error) {
+	var posProduct *entity.PosProduct
+	var reversal *entity.PosInventoryHistory
 
-	// Update the inventory history in Redis
-	inventoryHistoryData, err := json.Marshal(posInventoryHistory)
-	if err != nil {
-		return err
-	}
-	err = r.redis.Set(context.Background(), posInventoryHistory.InventoryID.String(), inventoryHistoryData, 7*24*time.Hour).Err()
-	if err != nil {
-		return err
-	}
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Lock the original entry so concurrent reversals of it are serialized
+		var original entity.PosInventoryHistory
+		if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("inventory_id = ?", inventoryID).First(&original).Error; err != nil {
+			return err
+		}
 
-	return nil
-}
+		if original.MovementType == entity.MovementTypeReversal {
+			return errors.New("error reverse inventory history, a reversal entry can not be reversed")
+		}
 
-func (r *posInventoryHistoryRepository) DeletePosInventoryHistory(inventoryID string) error {
-	if err := r.db.Where("inventory_id = ?", inventoryID).Delete(&entity.PosInventoryHistory{}).Error; err != nil {
-		return err
-	}
+		if original.MovementType == entity.MovementTypeReconciliation {
+			return errors.New("error reverse inventory history, a reconciliation entry can not be reversed")
+		}
 
-	// Delete the inventory history from Redis
-	err := r.redis.Del(context.Background(), inventoryID).Err()
-	if err != nil {
+		if original.TransferID != nil || original.PurchaseOrderID != nil {
+			return errors.New("error reverse inventory history, movements of a stock transfer or purchase order can not be reversed")
+		}
+
+		if original.SaleID != nil {
+			return errors.New("error reverse inventory history, movements of a sale can not be reversed, return the sale lines instead")
+		}
+
+		var reversalCount int
+		if err := tx.Model(&entity.PosInventoryHistory{}).Where("reversal_of_id = ?", original.InventoryID).Count(&reversalCount).Error; err != nil {
+			return err
+		}
+		if reversalCount > 0 {
+			return errors.New("error reverse inventory history, the movement is already reversed")
+		}
+
+		if note == "" {
+			note = fmt.Sprintf("reversal of %s movement %s", original.MovementType, original.InventoryID)
+		}
+
+		now := time.Now()
+		reversal = &entity.PosInventoryHistory{
+			InventoryID:  uuid.New(),
+			ProductID:    original.ProductID,
+			StoreID:      original.StoreID,
+			Date:         now,
+			Quantity:     -original.Quantity,
+			MovementType: entity.MovementTypeReversal,
+			Note:         note,
+			ReferenceNo:  original.ReferenceNo,
+			ReversalOfID: &original.InventoryID,
+			BranchID:     original.BranchID,
+			CompanyID:    original.CompanyID,
+			CreatedAt:    now,
+			CreatedBy:    userID,
+			UpdatedAt:    now,
+			UpdatedBy:    userID,
+		}
+
+		// Put the stock back at the cost it left with, and take a receipt back out of its own lot
+		if reversal.Quantity > 0 {
+			reversal.UnitCost = original.UnitCost
+		} else {
+			reversal.LotCode = original.LotCode
+		}
+
+		var err error
+		posProduct, err = applyInventoryMovement(tx, reversal)
 		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	// Invalidate the cached product only after the transaction is committed
+	invalidateProductCache(r.redis, *posProduct)
+
+	return reversal, nil
+}
+
+// inventoryHistorySortColumns maps the sort options of ReadAllPosInventoryHistories to columns
+var inventoryHistorySortColumns = map[string]string{
+	"date":       "date",
+	"created_at": "created_at",
+	"quantity":   "quantity",
 }
 
-func (r *posInventoryHistoryRepository) ReadAllPosInventoryHistories(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error) {
+func (r *posInventoryHistoryRepository) ReadAllPosInventoryHistories(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, filter dto.InventoryHistoryFilter) (*dto.PaginationResult, error) {
 	var posInventoryHistories []entity.PosInventoryHistory
 	var totalRecords int64
 
@@ -163,13 +365,58 @@ func (r *posInventoryHistoryRepository) ReadAllPosInventoryHistories(pagination
 		return nil, errors.New("invalid role")
 	}
 
+	if filter.ProductID != "" {
+		query = query.Where("product_id = ?", filter.ProductID)
+	}
+	if filter.StoreID != "" {
+		query = query.Where("store_id = ?", filter.StoreID)
+	}
+	if filter.BranchID != "" {
+		query = query.Where("branch_id = ?", filter.BranchID)
+	}
+	if filter.DateFrom != nil {
+		query = query.Where("date >= ?", *filter.DateFrom)
+	}
+	if filter.DateTo != nil {
+		query = query.Where("date <= ?", *filter.DateTo)
+	}
+	switch filter.QuantitySign {
+	case "positive":
+		query = query.Where("quantity > 0")
+	case "negative":
+		query = query.Where("quantity < 0")
+	}
+	if filter.CreatedBy != "" {
+		query = query.Where("created_by = ?", filter.CreatedBy)
+	}
+
+	if err := query.Count(&totalRecords).Error; err != nil {
+		return nil, err
+	}
+
+	sortColumn, ok := inventoryHistorySortColumns[filter.SortBy]
+	if !ok {
+		sortColumn = "date"
+	}
+	sortOrder := "desc"
+	if filter.SortOrder == "asc" {
+		sortOrder = "asc"
+	}
+	// The ledger order breaks ties so pages stay stable
+	query = query.Order(sortColumn + " " + sortOrder).Order("created_at " + sortOrder).Order("inventory_id " + sortOrder)
+
 	if pagination.Limit > 0 && pagination.Page > 0 {
 		offset := (pagination.Page - 1) * pagination.Limit
 		query = query.Offset(offset).Limit(pagination.Limit)
 	}
 
-	query.Find(&posInventoryHistories)
-	query.Count(&totalRecords)
+	if err := query.Find(&posInventoryHistories).Error; err != nil {
+		return nil, err
+	}
+
+	if err := loadSerialNumbers(r.db, posInventoryHistories); err != nil {
+		return nil, err
+	}
 
 	totalPages := int(math.Ceil(float64(totalRecords) / float64(pagination.Limit)))
 
@@ -180,3 +427,211 @@ func (r *posInventoryHistoryRepository) ReadAllPosInventoryHistories(pagination
 		TotalPages:   totalPages,
 	}, nil
 }
+
+// ReadPosStockAsOf rebuilds the stock per product and store from the ledger entries up to the given time
+func (r *posInventoryHistoryRepository) ReadPosStockAsOf(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, asOf time.Time, productID string, storeID string) (*dto.PaginationResult, error) {
+	var posStocks []struct {
+		ProductID uuid.UUID
+		StoreID   *uuid.UUID
+		BranchID  *uuid.UUID
+		CompanyID uuid.UUID
+		Quantity  int64
+	}
+	var totalRecords int64
+
+	scopeCondition, scopeValue, err := inventoryHistoryScope(roleName, jwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.db.Table("pos_inventory_histories h").
+		Select("h.product_id, h.store_id, h.branch_id, h.company_id, SUM(h.quantity) AS quantity").
+		Where(scopeCondition, scopeValue).
+		Where("h.date <= ?", asOf).
+		Group("h.product_id, h.store_id, h.branch_id, h.company_id")
+
+	if productID != "" {
+		query = query.Where("h.product_id = ?", productID)
+	}
+
+	if storeID != "" {
+		query = query.Where("h.store_id = ?", storeID)
+	}
+
+	// Count the groups, not the ledger entries
+	if err := r.db.Raw("SELECT COUNT(*) FROM (?) stocks", query.QueryExpr()).Row().Scan(&totalRecords); err != nil {
+		return nil, err
+	}
+
+	if pagination.Limit > 0 && pagination.Page > 0 {
+		offset := (pagination.Page - 1) * pagination.Limit
+		query = query.Offset(offset).Limit(pagination.Limit)
+	}
+
+	if err := query.Order("h.product_id, h.store_id").Scan(&posStocks).Error; err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalRecords) / float64(pagination.Limit)))
+
+	pbPosStocks := make([]*pb.PosStockAsOf, len(posStocks))
+	for i, posStock := range posStocks {
+		pbPosStocks[i] = &pb.PosStockAsOf{
+			ProductId: posStock.ProductID.String(),
+			StoreId:   utils.UUIDString(posStock.StoreID),
+			BranchId:  utils.UUIDString(posStock.BranchID),
+			CompanyId: posStock.CompanyID.String(),
+			Quantity:  posStock.Quantity,
+		}
+	}
+
+	return &dto.PaginationResult{
+		TotalRecords: totalRecords,
+		Records:      pbPosStocks,
+		CurrentPage:  pagination.Page,
+		TotalPages:   totalPages,
+	}, nil
+}
+
+// ReadPosStockCard lists the movements of a product, of one store or of all stores in scope, in
+// ledger order with the balance after each movement. It also returns the opening balance, the
+// stock before the first movement of the period.
+func (r *posInventoryHistoryRepository) ReadPosStockCard(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, productID string, storeID string, from *time.Time, to *time.Time) (*dto.PaginationResult, int64, error) {
+	var posStockCardEntries []struct {
+		entity.PosInventoryHistory
+		RunningBalance int64
+	}
+	var totalRecords int64
+	var openingBalance int64
+
+	scopeCondition, scopeValue, err := inventoryHistoryScope(roleName, jwtPayload)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	conditions := []string{"h.product_id = ?", scopeCondition}
+	values := []interface{}{productID, scopeValue}
+
+	if storeID != "" {
+		conditions = append(conditions, "h.store_id = ?")
+		values = append(values, storeID)
+	}
+
+	if to != nil {
+		conditions = append(conditions, "h.date <= ?")
+		values = append(values, *to)
+	}
+
+	ledgerCondition := strings.Join(conditions, " AND ")
+	periodCondition := "TRUE"
+	var periodValues []interface{}
+
+	if from != nil {
+		err := r.db.Table("pos_inventory_histories h").
+			Select("COALESCE(SUM(h.quantity), 0)").
+			Where(ledgerCondition, values...).
+			Where("h.date < ?", *from).
+			Row().Scan(&openingBalance)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		periodCondition = "card.date >= ?"
+		periodValues = append(periodValues, *from)
+	}
+
+	if err := r.db.Table("pos_inventory_histories h").Where(ledgerCondition, values...).Where(strings.Replace(periodCondition, "card.", "h.", 1), periodValues...).Count(&totalRecords).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// The running balance is computed over the whole ledger before the period and the page are cut
+	cardQuery := `SELECT * FROM (
+		SELECT h.*, SUM(h.quantity) OVER (ORDER BY h.date, h.created_at, h.inventory_id) AS running_balance
+		FROM pos_inventory_histories h WHERE ` + ledgerCondition + `
+	) card WHERE ` + periodCondition + ` ORDER BY card.date, card.created_at, card.inventory_id`
+	cardValues := append(values, periodValues...)
+
+	if pagination.Limit > 0 && pagination.Page > 0 {
+		cardQuery += " LIMIT ? OFFSET ?"
+		cardValues = append(cardValues, pagination.Limit, (pagination.Page-1)*pagination.Limit)
+	}
+
+	if err := r.db.Raw(cardQuery, cardValues...).Scan(&posStockCardEntries).Error; err != nil {
+		return nil, 0, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalRecords) / float64(pagination.Limit)))
+
+	posInventoryHistories := make([]entity.PosInventoryHistory, len(posStockCardEntries))
+	for i, posStockCardEntry := range posStockCardEntries {
+		posInventoryHistories[i] = posStockCardEntry.PosInventoryHistory
+	}
+
+	if err := loadSerialNumbers(r.db, posInventoryHistories); err != nil {
+		return nil, 0, err
+	}
+
+	pbPosStockCardEntries := make([]*pb.PosStockCardEntry, len(posStockCardEntries))
+	for i, posStockCardEntry := range posStockCardEntries {
+		pbPosStockCardEntries[i] = &pb.PosStockCardEntry{
+			PosInventoryHistory: toPbPosInventoryHistory(posInventoryHistories[i]),
+			RunningBalance:      posStockCardEntry.RunningBalance,
+		}
+	}
+
+	return &dto.PaginationResult{
+		TotalRecords: totalRecords,
+		Records:      pbPosStockCardEntries,
+		CurrentPage:  pagination.Page,
+		TotalPages:   totalPages,
+	}, openingBalance, nil
+}
+
+// inventoryHistoryScope returns the condition limiting ledger entries to the company, branch or
+// store of the login user
+func inventoryHistoryScope(roleName string, jwtPayload *pb.JWTPayload) (string, string, error) {
+	companyRole := os.Getenv("COMPANY_USER_ROLE")
+	branchRole := os.Getenv("BRANCH_USER_ROLE")
+	storeRole := os.Getenv("STORE_USER_ROLE")
+
+	switch roleName {
+	case companyRole:
+		return "h.company_id = ?", jwtPayload.CompanyId, nil
+	case branchRole:
+		return "h.branch_id = ?", jwtPayload.BranchId, nil
+	case storeRole:
+		return "h.store_id = ?", jwtPayload.StoreId, nil
+	default:
+		return "", "", errors.New("invalid role")
+	}
+}
+
+func toPbPosInventoryHistory(posInventoryHistory entity.PosInventoryHistory) *pb.PosInventoryHistory {
+	return &pb.PosInventoryHistory{
+		InventoryId:     posInventoryHistory.InventoryID.String(),
+		ProductId:       posInventoryHistory.ProductID.String(),
+		StoreId:         posInventoryHistory.StoreID.String(),
+		Date:            timestamppb.New(posInventoryHistory.Date),
+		Quantity:        int32(posInventoryHistory.Quantity),
+		MovementType:    posInventoryHistory.MovementType,
+		Note:            posInventoryHistory.Note,
+		ReferenceNo:     posInventoryHistory.ReferenceNo,
+		LotCode:         posInventoryHistory.LotCode,
+		ExpiryDate:      utils.TimestampFromTime(posInventoryHistory.ExpiryDate),
+		UnitCost:        posInventoryHistory.UnitCost,
+		CostAmount:      posInventoryHistory.CostAmount,
+		TransferId:      utils.UUIDString(posInventoryHistory.TransferID),
+		PurchaseOrderId: utils.UUIDString(posInventoryHistory.PurchaseOrderID),
+		ReversalOfId:    utils.UUIDString(posInventoryHistory.ReversalOfID),
+		SaleId:          utils.UUIDString(posInventoryHistory.SaleID),
+		SerialNumbers:   posInventoryHistory.SerialNumbers,
+		Unit:            posInventoryHistory.Unit,
+		UnitQuantity:    posInventoryHistory.UnitQuantity,
+		BranchId:        posInventoryHistory.BranchID.String(),
+		CompanyId:       posInventoryHistory.CompanyID.String(),
+		CreatedAt:       timestamppb.New(posInventoryHistory.CreatedAt),
+		CreatedBy:       posInventoryHistory.CreatedBy.String(),
+		UpdatedAt:       timestamppb.New(posInventoryHistory.UpdatedAt),
+		UpdatedBy:       posInventoryHistory.UpdatedBy.String(),
+	}
+}
@@ -0,0 +1,328 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosLowStockRepository interface {
+	ListLowStockProducts(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, storeID string) (*dto.PaginationResult, error)
+	CheckLowStockLevels() (created int, resolved int, err error)
+	ReadPosLowStockAlert(alertID string) (*pb.PosLowStockAlert, error)
+	AcknowledgePosLowStockAlert(alertID string, userID uuid.UUID) error
+	ReadAllPosLowStockAlerts(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, status string) (*dto.PaginationResult, error)
+}
+
+type posLowStockRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+func NewPosLowStockRepository(db *gorm.DB, redis *redis.Client) PosLowStockRepository {
+	return &posLowStockRepository{
+		db:    db,
+		redis: redis,
+	}
+}
+
+// lowStockCondition matches stock levels at or below their reorder level, a reorder level of
+// zero means no threshold has been set for the store
+const lowStockCondition = "sl.reorder_level > 0 AND sl.quantity <= sl.reorder_level"
+
+func (r *posLowStockRepository) ListLowStockProducts(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, storeID string) (*dto.PaginationResult, error) {
+	var posLowStockProducts []struct {
+		ProductID        uuid.UUID
+		ProductBarcodeID string
+		ProductName      string
+		SupplierID       uuid.UUID
+		StoreID          uuid.UUID
+		BranchID         uuid.UUID
+		CompanyID        uuid.UUID
+		Quantity         int
+		ReorderLevel     int
+		MaxLevel         int
+	}
+	var totalRecords int64
+
+	query := r.db.Table("pos_stock_levels sl").
+		Select("sl.product_id, p.product_barcode_id, p.product_name, p.supplier_id, sl.store_id, sl.branch_id, sl.company_id, sl.quantity, sl.reorder_level, sl.max_level").
+		Joins("JOIN pos_products p ON p.product_id = sl.product_id").
+		Where(lowStockCondition).
+		Where("p.active = ?", true)
+
+	companyRole := os.Getenv("COMPANY_USER_ROLE")
+	branchRole := os.Getenv("BRANCH_USER_ROLE")
+	storeRole := os.Getenv("STORE_USER_ROLE")
+
+	switch roleName {
+	case companyRole:
+		query = query.Where("sl.company_id = ?", jwtPayload.CompanyId)
+	case branchRole:
+		query = query.Where("sl.branch_id = ?", jwtPayload.BranchId)
+	case storeRole:
+		query = query.Where("sl.store_id = ?", jwtPayload.StoreId)
+	default:
+		return nil, errors.New("invalid role")
+	}
+
+	if storeID != "" {
+		query = query.Where("sl.store_id = ?", storeID)
+	}
+
+	if err := query.Count(&totalRecords).Error; err != nil {
+		return nil, err
+	}
+
+	if pagination.Limit > 0 && pagination.Page > 0 {
+		offset := (pagination.Page - 1) * pagination.Limit
+		query = query.Offset(offset).Limit(pagination.Limit)
+	}
+
+	if err := query.Order("sl.quantity - sl.reorder_level, p.product_name").Scan(&posLowStockProducts).Error; err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalRecords) / float64(pagination.Limit)))
+
+	pbPosLowStockProducts := make([]*pb.PosLowStockProduct, len(posLowStockProducts))
+	for i, posLowStockProduct := range posLowStockProducts {
+		pbPosLowStockProducts[i] = &pb.PosLowStockProduct{
+			ProductId:        posLowStockProduct.ProductID.String(),
+			ProductBarcodeId: posLowStockProduct.ProductBarcodeID,
+			ProductName:      posLowStockProduct.ProductName,
+			SupplierId:       posLowStockProduct.SupplierID.String(),
+			StoreId:          posLowStockProduct.StoreID.String(),
+			BranchId:         posLowStockProduct.BranchID.String(),
+			CompanyId:        posLowStockProduct.CompanyID.String(),
+			Quantity:         int32(posLowStockProduct.Quantity),
+			ReorderLevel:     int32(posLowStockProduct.ReorderLevel),
+			MaxLevel:         int32(posLowStockProduct.MaxLevel),
+		}
+	}
+
+	return &dto.PaginationResult{
+		TotalRecords: totalRecords,
+		Records:      pbPosLowStockProducts,
+		CurrentPage:  pagination.Page,
+		TotalPages:   totalPages,
+	}, nil
+}
+
+// CheckLowStockLevels records an alert for every stock level that fell to or below its reorder
+// level since the last check and resolves the alerts of stock levels that were replenished.
+// A stock level only gets a new alert after its previous alert was resolved, so each crossing
+// of the threshold is reported once.
+func (r *posLowStockRepository) CheckLowStockLevels() (int, int, error) {
+	var created, resolved int
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		result := tx.Exec(`UPDATE pos_low_stock_alerts a SET status = ?, resolved_at = ?, updated_at = ?
+			FROM pos_stock_levels sl
+			WHERE sl.product_id = a.product_id AND sl.store_id = a.store_id AND a.status IN (?)
+			AND NOT (`+lowStockCondition+`)`,
+			entity.LowStockAlertStatusResolved, now, now,
+			[]string{entity.LowStockAlertStatusOpen, entity.LowStockAlertStatusAcknowledged})
+		if result.Error != nil {
+			return result.Error
+		}
+		resolved = int(result.RowsAffected)
+
+		var posStockLevels []entity.PosStockLevel
+		err := tx.Table("pos_stock_levels sl").
+			Select("sl.*").
+			Where(lowStockCondition).
+			Where(`NOT EXISTS (SELECT 1 FROM pos_low_stock_alerts a
+				WHERE a.product_id = sl.product_id AND a.store_id = sl.store_id AND a.status IN (?))`,
+				[]string{entity.LowStockAlertStatusOpen, entity.LowStockAlertStatusAcknowledged}).
+			Scan(&posStockLevels).Error
+		if err != nil {
+			return err
+		}
+
+		for _, posStockLevel := range posStockLevels {
+			alert := entity.PosLowStockAlert{
+				AlertID:      uuid.New(),
+				ProductID:    posStockLevel.ProductID,
+				StoreID:      posStockLevel.StoreID,
+				BranchID:     posStockLevel.BranchID,
+				CompanyID:    posStockLevel.CompanyID,
+				Quantity:     posStockLevel.Quantity,
+				ReorderLevel: posStockLevel.ReorderLevel,
+				Status:       entity.LowStockAlertStatusOpen,
+				CreatedAt:    now,
+				UpdatedAt:    now,
+			}
+			if err := tx.Create(&alert).Error; err != nil {
+				return err
+			}
+		}
+		created = len(posStockLevels)
+
+		return nil
+	})
+
+	return created, resolved, err
+}
+
+// applyLowStockAlert raises an alert when a movement takes the stock level of the store to or below
+// its reorder level and resolves the unresolved alert once the stock is back above it
+func applyLowStockAlert(tx *gorm.DB, posStockLevel entity.PosStockLevel, quantityAfter int, now time.Time) error {
+	unresolved := []string{entity.LowStockAlertStatusOpen, entity.LowStockAlertStatusAcknowledged}
+
+	if posStockLevel.ReorderLevel <= 0 || quantityAfter > posStockLevel.ReorderLevel {
+		return tx.Model(&entity.PosLowStockAlert{}).
+			Where("product_id = ? AND store_id = ? AND status IN (?)", posStockLevel.ProductID, posStockLevel.StoreID, unresolved).
+			UpdateColumns(map[string]interface{}{
+				"status":      entity.LowStockAlertStatusResolved,
+				"resolved_at": now,
+				"updated_at":  now,
+			}).Error
+	}
+
+	var alertCount int64
+	if err := tx.Model(&entity.PosLowStockAlert{}).Where("product_id = ? AND store_id = ? AND status IN (?)", posStockLevel.ProductID, posStockLevel.StoreID, unresolved).Count(&alertCount).Error; err != nil {
+		return err
+	}
+	if alertCount > 0 {
+		return nil
+	}
+
+	alert := entity.PosLowStockAlert{
+		AlertID:      uuid.New(),
+		ProductID:    posStockLevel.ProductID,
+		StoreID:      posStockLevel.StoreID,
+		BranchID:     posStockLevel.BranchID,
+		CompanyID:    posStockLevel.CompanyID,
+		Quantity:     quantityAfter,
+		ReorderLevel: posStockLevel.ReorderLevel,
+		Status:       entity.LowStockAlertStatusOpen,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	return tx.Create(&alert).Error
+}
+
+func (r *posLowStockRepository) ReadPosLowStockAlert(alertID string) (*pb.PosLowStockAlert, error) {
+	var posLowStockAlert entity.PosLowStockAlert
+	if err := r.db.Where("alert_id = ?", alertID).First(&posLowStockAlert).Error; err != nil {
+		return nil, err
+	}
+
+	return toPbPosLowStockAlert(posLowStockAlert), nil
+}
+
+func (r *posLowStockRepository) AcknowledgePosLowStockAlert(alertID string, userID uuid.UUID) error {
+	now := time.Now()
+
+	result := r.db.Model(&entity.PosLowStockAlert{}).
+		Where("alert_id = ? AND status = ?", alertID, entity.LowStockAlertStatusOpen).
+		UpdateColumns(map[string]interface{}{
+			"status":          entity.LowStockAlertStatusAcknowledged,
+			"acknowledged_at": now,
+			"acknowledged_by": userID,
+			"updated_at":      now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("low stock alert %s is not open", alertID)
+	}
+
+	return nil
+}
+
+func (r *posLowStockRepository) ReadAllPosLowStockAlerts(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, status string) (*dto.PaginationResult, error) {
+	var posLowStockAlerts []entity.PosLowStockAlert
+	var totalRecords int64
+
+	query := r.db.Model(&entity.PosLowStockAlert{})
+
+	companyRole := os.Getenv("COMPANY_USER_ROLE")
+	branchRole := os.Getenv("BRANCH_USER_ROLE")
+	storeRole := os.Getenv("STORE_USER_ROLE")
+
+	switch roleName {
+	case companyRole:
+		query = query.Where("company_id = ?", jwtPayload.CompanyId)
+	case branchRole:
+		query = query.Where("branch_id = ?", jwtPayload.BranchId)
+	case storeRole:
+		query = query.Where("store_id = ?", jwtPayload.StoreId)
+	default:
+		return nil, errors.New("invalid role")
+	}
+
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	if err := query.Count(&totalRecords).Error; err != nil {
+		return nil, err
+	}
+
+	if pagination.Limit > 0 && pagination.Page > 0 {
+		offset := (pagination.Page - 1) * pagination.Limit
+		query = query.Offset(offset).Limit(pagination.Limit)
+	}
+
+	if err := query.Order("created_at desc").Find(&posLowStockAlerts).Error; err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalRecords) / float64(pagination.Limit)))
+
+	pbPosLowStockAlerts := make([]*pb.PosLowStockAlert, len(posLowStockAlerts))
+	for i, posLowStockAlert := range posLowStockAlerts {
+		pbPosLowStockAlerts[i] = toPbPosLowStockAlert(posLowStockAlert)
+	}
+
+	return &dto.PaginationResult{
+		TotalRecords: totalRecords,
+		Records:      pbPosLowStockAlerts,
+		CurrentPage:  pagination.Page,
+		TotalPages:   totalPages,
+	}, nil
+}
+
+func toPbPosLowStockAlert(posLowStockAlert entity.PosLowStockAlert) *pb.PosLowStockAlert {
+	pbPosLowStockAlert := &pb.PosLowStockAlert{
+		AlertId:        posLowStockAlert.AlertID.String(),
+		ProductId:      posLowStockAlert.ProductID.String(),
+		StoreId:        posLowStockAlert.StoreID.String(),
+		BranchId:       posLowStockAlert.BranchID.String(),
+		CompanyId:      posLowStockAlert.CompanyID.String(),
+		Quantity:       int32(posLowStockAlert.Quantity),
+		ReorderLevel:   int32(posLowStockAlert.ReorderLevel),
+		Status:         posLowStockAlert.Status,
+		AcknowledgedBy: utils.UUIDString(posLowStockAlert.AcknowledgedBy),
+		CreatedAt:      timestamppb.New(posLowStockAlert.CreatedAt),
+		UpdatedAt:      timestamppb.New(posLowStockAlert.UpdatedAt),
+	}
+
+	if posLowStockAlert.AcknowledgedAt != nil {
+		pbPosLowStockAlert.AcknowledgedAt = timestamppb.New(*posLowStockAlert.AcknowledgedAt)
+	}
+	if posLowStockAlert.ResolvedAt != nil {
+		pbPosLowStockAlert.ResolvedAt = timestamppb.New(*posLowStockAlert.ResolvedAt)
+	}
+
+	return pbPosLowStockAlert
+}
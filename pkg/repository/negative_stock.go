@@ -0,0 +1,290 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosNegativeStockRepository interface {
+	ReadPosNegativeStockSetting(scopeID string) (*pb.PosNegativeStockSetting, error)
+	ReadPosNegativeStockSettings(companyID string, branchID string) ([]*pb.PosNegativeStockSetting, error)
+	ResolveNegativeStockPolicy(companyID string, branchID string, storeID string) (*pb.ResolvePosNegativeStockPolicyResponse, error)
+	UpdatePosNegativeStockSetting(posNegativeStockSetting *entity.PosNegativeStockSetting) error
+	DeletePosNegativeStockSetting(scopeID string) error
+	ReadPosNegativeStockViolation(violationID string) (*pb.PosNegativeStockViolation, error)
+	ReviewPosNegativeStockViolation(violationID string, note string, userID uuid.UUID) error
+	ReadAllPosNegativeStockViolations(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, status string) (*dto.PaginationResult, error)
+}
+
+type posNegativeStockRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+func NewPosNegativeStockRepository(db *gorm.DB, redis *redis.Client) PosNegativeStockRepository {
+	return &posNegativeStockRepository{
+		db:    db,
+		redis: redis,
+	}
+}
+
+func (r *posNegativeStockRepository) ReadPosNegativeStockSetting(scopeID string) (*pb.PosNegativeStockSetting, error) {
+	var posNegativeStockSetting entity.PosNegativeStockSetting
+	if err := r.db.Where("scope_id = ?", scopeID).First(&posNegativeStockSetting).Error; err != nil {
+		return nil, err
+	}
+
+	return toPbPosNegativeStockSetting(posNegativeStockSetting), nil
+}
+
+// ReadPosNegativeStockSettings returns the settings of the company, only the company setting and
+// the settings of the branch and its stores when a branch is given
+func (r *posNegativeStockRepository) ReadPosNegativeStockSettings(companyID string, branchID string) ([]*pb.PosNegativeStockSetting, error) {
+	var posNegativeStockSettings []entity.PosNegativeStockSetting
+
+	query := r.db.Where("company_id = ?", companyID)
+	if branchID != "" {
+		query = query.Where("scope_type = ? OR branch_id = ?", entity.SettingScopeCompany, branchID)
+	}
+
+	err := query.Order(gorm.Expr("CASE scope_type WHEN ? THEN 0 WHEN ? THEN 1 ELSE 2 END", entity.SettingScopeCompany, entity.SettingScopeBranch)).
+		Order("created_at asc").
+		Find(&posNegativeStockSettings).Error
+	if err != nil {
+		return nil, err
+	}
+
+	pbPosNegativeStockSettings := make([]*pb.PosNegativeStockSetting, len(posNegativeStockSettings))
+	for i, posNegativeStockSetting := range posNegativeStockSettings {
+		pbPosNegativeStockSettings[i] = toPbPosNegativeStockSetting(posNegativeStockSetting)
+	}
+
+	return pbPosNegativeStockSettings, nil
+}
+
+// ResolveNegativeStockPolicy returns the policy that applies to the store, or to the branch when
+// no store is given, and the scope it is inherited from
+func (r *posNegativeStockRepository) ResolveNegativeStockPolicy(companyID string, branchID string, storeID string) (*pb.ResolvePosNegativeStockPolicyResponse, error) {
+	posNegativeStockSetting, err := resolveNegativeStockSetting(r.db, uuid.MustParse(companyID), utils.ParseUUID(branchID), utils.ParseUUID(storeID))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ResolvePosNegativeStockPolicyResponse{
+		Policy:    posNegativeStockSetting.Policy,
+		ScopeType: posNegativeStockSetting.ScopeType,
+	}
+	if posNegativeStockSetting.ScopeID != uuid.Nil {
+		resp.ScopeId = posNegativeStockSetting.ScopeID.String()
+	}
+
+	return resp, nil
+}
+
+func (r *posNegativeStockRepository) UpdatePosNegativeStockSetting(posNegativeStockSetting *entity.PosNegativeStockSetting) error {
+	return r.db.Exec(`INSERT INTO pos_negative_stock_settings (scope_id, scope_type, policy, branch_id, company_id, created_at, created_by, updated_at, updated_by)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (scope_id) DO UPDATE SET policy = EXCLUDED.policy, updated_at = EXCLUDED.updated_at, updated_by = EXCLUDED.updated_by`,
+		posNegativeStockSetting.ScopeID, posNegativeStockSetting.ScopeType, posNegativeStockSetting.Policy, posNegativeStockSetting.BranchID,
+		posNegativeStockSetting.CompanyID, posNegativeStockSetting.CreatedAt, posNegativeStockSetting.CreatedBy,
+		posNegativeStockSetting.UpdatedAt, posNegativeStockSetting.UpdatedBy).Error
+}
+
+func (r *posNegativeStockRepository) DeletePosNegativeStockSetting(scopeID string) error {
+	return r.db.Where("scope_id = ?", scopeID).Delete(&entity.PosNegativeStockSetting{}).Error
+}
+
+func (r *posNegativeStockRepository) ReadPosNegativeStockViolation(violationID string) (*pb.PosNegativeStockViolation, error) {
+	var posNegativeStockViolation entity.PosNegativeStockViolation
+	if err := r.db.Where("violation_id = ?", violationID).First(&posNegativeStockViolation).Error; err != nil {
+		return nil, err
+	}
+
+	return toPbPosNegativeStockViolation(posNegativeStockViolation), nil
+}
+
+func (r *posNegativeStockRepository) ReviewPosNegativeStockViolation(violationID string, note string, userID uuid.UUID) error {
+	now := time.Now()
+
+	result := r.db.Model(&entity.PosNegativeStockViolation{}).
+		Where("violation_id = ? AND status = ?", violationID, entity.NegativeStockViolationStatusOpen).
+		UpdateColumns(map[string]interface{}{
+			"status":      entity.NegativeStockViolationStatusReviewed,
+			"review_note": note,
+			"reviewed_at": now,
+			"reviewed_by": userID,
+			"updated_at":  now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("negative stock violation %s is not open", violationID)
+	}
+
+	return nil
+}
+
+func (r *posNegativeStockRepository) ReadAllPosNegativeStockViolations(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, status string) (*dto.PaginationResult, error) {
+	var posNegativeStockViolations []entity.PosNegativeStockViolation
+	var totalRecords int64
+
+	query := r.db.Model(&entity.PosNegativeStockViolation{})
+
+	companyRole := os.Getenv("COMPANY_USER_ROLE")
+	branchRole := os.Getenv("BRANCH_USER_ROLE")
+	storeRole := os.Getenv("STORE_USER_ROLE")
+
+	switch roleName {
+	case companyRole:
+		query = query.Where("company_id = ?", jwtPayload.CompanyId)
+	case branchRole:
+		query = query.Where("branch_id = ?", jwtPayload.BranchId)
+	case storeRole:
+		query = query.Where("store_id = ?", jwtPayload.StoreId)
+	default:
+		return nil, errors.New("invalid role")
+	}
+
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	if err := query.Count(&totalRecords).Error; err != nil {
+		return nil, err
+	}
+
+	if pagination.Limit > 0 && pagination.Page > 0 {
+		offset := (pagination.Page - 1) * pagination.Limit
+		query = query.Offset(offset).Limit(pagination.Limit)
+	}
+
+	if err := query.Order("created_at desc").Find(&posNegativeStockViolations).Error; err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalRecords) / float64(pagination.Limit)))
+
+	pbPosNegativeStockViolations := make([]*pb.PosNegativeStockViolation, len(posNegativeStockViolations))
+	for i, posNegativeStockViolation := range posNegativeStockViolations {
+		pbPosNegativeStockViolations[i] = toPbPosNegativeStockViolation(posNegativeStockViolation)
+	}
+
+	return &dto.PaginationResult{
+		TotalRecords: totalRecords,
+		Records:      pbPosNegativeStockViolations,
+		CurrentPage:  pagination.Page,
+		TotalPages:   totalPages,
+	}, nil
+}
+
+// resolveNegativeStockSetting returns the most specific setting of the store, its branch and its
+// company. When none is set the returned setting has no scope and forbids negative stock.
+func resolveNegativeStockSetting(db *gorm.DB, companyID uuid.UUID, branchID *uuid.UUID, storeID *uuid.UUID) (*entity.PosNegativeStockSetting, error) {
+	scopeIDs := []uuid.UUID{companyID}
+	if branchID != nil {
+		scopeIDs = append(scopeIDs, *branchID)
+	}
+	if storeID != nil {
+		scopeIDs = append(scopeIDs, *storeID)
+	}
+
+	var posNegativeStockSettings []entity.PosNegativeStockSetting
+	err := db.Where("company_id = ? AND scope_id IN (?)", companyID, scopeIDs).
+		Order(gorm.Expr("CASE scope_type WHEN ? THEN 0 WHEN ? THEN 1 ELSE 2 END", entity.SettingScopeStore, entity.SettingScopeBranch)).
+		Limit(1).
+		Find(&posNegativeStockSettings).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(posNegativeStockSettings) == 0 {
+		return &entity.PosNegativeStockSetting{
+			Policy:    entity.NegativeStockPolicyForbid,
+			CompanyID: companyID,
+		}, nil
+	}
+
+	return &posNegativeStockSettings[0], nil
+}
+
+// recordNegativeStockViolation flags a movement that was allowed to take the store below zero for
+// review, and sets the warning of the movement under the allow_warning policy. It must run inside
+// the transaction of applyInventoryMovement, after the ledger entry is inserted.
+func recordNegativeStockViolation(tx *gorm.DB, posInventoryHistory *entity.PosInventoryHistory, policy string, quantityBefore int) error {
+	quantityAfter := quantityBefore + posInventoryHistory.Quantity
+
+	err := tx.Create(&entity.PosNegativeStockViolation{
+		ViolationID:    uuid.New(),
+		InventoryID:    posInventoryHistory.InventoryID,
+		ProductID:      posInventoryHistory.ProductID,
+		StoreID:        *posInventoryHistory.StoreID,
+		QuantityBefore: quantityBefore,
+		QuantityAfter:  quantityAfter,
+		Policy:         policy,
+		Status:         entity.NegativeStockViolationStatusOpen,
+		BranchID:       *posInventoryHistory.BranchID,
+		CompanyID:      posInventoryHistory.CompanyID,
+		CreatedAt:      posInventoryHistory.CreatedAt,
+		CreatedBy:      posInventoryHistory.CreatedBy,
+		UpdatedAt:      posInventoryHistory.UpdatedAt,
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	if policy == entity.NegativeStockPolicyAllowWarning {
+		posInventoryHistory.NegativeStockWarning = fmt.Sprintf("stock quantity of the product in the store is now %d, the movement is flagged for review", quantityAfter)
+	}
+
+	return nil
+}
+
+func toPbPosNegativeStockSetting(posNegativeStockSetting entity.PosNegativeStockSetting) *pb.PosNegativeStockSetting {
+	return &pb.PosNegativeStockSetting{
+		ScopeId:   posNegativeStockSetting.ScopeID.String(),
+		ScopeType: posNegativeStockSetting.ScopeType,
+		Policy:    posNegativeStockSetting.Policy,
+		BranchId:  utils.UUIDString(posNegativeStockSetting.BranchID),
+		CompanyId: posNegativeStockSetting.CompanyID.String(),
+		CreatedAt: timestamppb.New(posNegativeStockSetting.CreatedAt),
+		CreatedBy: posNegativeStockSetting.CreatedBy.String(),
+		UpdatedAt: timestamppb.New(posNegativeStockSetting.UpdatedAt),
+		UpdatedBy: posNegativeStockSetting.UpdatedBy.String(),
+	}
+}
+
+func toPbPosNegativeStockViolation(posNegativeStockViolation entity.PosNegativeStockViolation) *pb.PosNegativeStockViolation {
+	return &pb.PosNegativeStockViolation{
+		ViolationId:    posNegativeStockViolation.ViolationID.String(),
+		InventoryId:    posNegativeStockViolation.InventoryID.String(),
+		ProductId:      posNegativeStockViolation.ProductID.String(),
+		StoreId:        posNegativeStockViolation.StoreID.String(),
+		QuantityBefore: int32(posNegativeStockViolation.QuantityBefore),
+		QuantityAfter:  int32(posNegativeStockViolation.QuantityAfter),
+		Policy:         posNegativeStockViolation.Policy,
+		Status:         posNegativeStockViolation.Status,
+		ReviewNote:     posNegativeStockViolation.ReviewNote,
+		ReviewedAt:     utils.TimestampFromTime(posNegativeStockViolation.ReviewedAt),
+		ReviewedBy:     utils.UUIDString(posNegativeStockViolation.ReviewedBy),
+		BranchId:       posNegativeStockViolation.BranchID.String(),
+		CompanyId:      posNegativeStockViolation.CompanyID.String(),
+		CreatedAt:      timestamppb.New(posNegativeStockViolation.CreatedAt),
+		CreatedBy:      posNegativeStockViolation.CreatedBy.String(),
+		UpdatedAt:      timestamppb.New(posNegativeStockViolation.UpdatedAt),
+	}
+}
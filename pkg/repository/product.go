@@ -11,8 +11,10 @@ import (
 	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
 	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
 	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
 
 	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
 	"github.com/jinzhu/gorm"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -20,10 +22,11 @@ import (
 type PosProductRepository interface {
 	CreatePosProduct(posProduct *entity.PosProduct) error
 	ReadPosProduct(productID string) (*pb.PosProduct, error)
-	ReadPosProductBarcode(productID string) (*pb.PosProduct, error)
+	ReadPosProductBarcode(companyID string, barcode string) (*pb.PosProduct, *pb.PosProductBarcode, *pb.PosEmbeddedBarcode, error)
 	UpdatePosProduct(posProduct *entity.PosProduct) error
 	DeletePosProduct(productID string) error
 	ReadAllPosProducts(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error)
+	ConvertToBaseUnit(productID string, unit string, unitQuantity float64) (int, float64, error)
 }
 
 type posProductRepository struct {
@@ -39,11 +42,17 @@ func NewPosProductRepository(db *gorm.DB, redis *redis.Client) PosProductReposit
 }
 
 func (r *posProductRepository) CreatePosProduct(posProduct *entity.PosProduct) error {
-	result := r.db.Create(posProduct)
-	if result.Error != nil {
-		return result.Error
-	}
-	return nil
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(posProduct).Error; err != nil {
+			return err
+		}
+
+		// The barcode of the product is its first barcode
+		if posProduct.ProductBarcodeID == "" {
+			return nil
+		}
+		return createProductBarcode(tx, primaryProductBarcode(posProduct))
+	})
 }
 
 func (r *posProductRepository) ReadPosProduct(productID string) (*pb.PosProduct, error) {
@@ -70,6 +79,11 @@ func (r *posProductRepository) ReadPosProduct(productID string) (*pb.PosProduct,
 			SupplierId:         posProductEntity.SupplierID.String(),
 			ProductDescription: posProductEntity.ProductDescription,
 			Active:             posProductEntity.Active,
+			Serialized:         posProductEntity.Serialized,
+			BaseUnit:           posProductEntity.BaseUnit,
+			ParentProductId:    utils.UUIDString(posProductEntity.ParentProductID),
+			HasVariants:        posProductEntity.HasVariants,
+			PriceOverride:      posProductEntity.PriceOverride,
 			StoreId:            posProductEntity.StoreID.String(),
 			BranchId:           posProductEntity.BranchID.String(),
 			CompanyId:          posProductEntity.CompanyID.String(),
@@ -115,6 +129,11 @@ func (r *posProductRepository) ReadPosProduct(productID string) (*pb.PosProduct,
 		SupplierId:         posProductEntity.SupplierID.String(),
 		ProductDescription: posProductEntity.ProductDescription,
 		Active:             posProductEntity.Active,
+		Serialized:         posProductEntity.Serialized,
+		BaseUnit:           posProductEntity.BaseUnit,
+		ParentProductId:    utils.UUIDString(posProductEntity.ParentProductID),
+		HasVariants:        posProductEntity.HasVariants,
+		PriceOverride:      posProductEntity.PriceOverride,
 		StoreId:            posProductEntity.StoreID.String(),
 		BranchId:           posProductEntity.BranchID.String(),
 		CompanyId:          posProductEntity.CompanyID.String(),
@@ -127,112 +146,70 @@ func (r *posProductRepository) ReadPosProduct(productID string) (*pb.PosProduct,
 	return posProduct, nil
 }
 
-func (r *posProductRepository) ReadPosProductBarcode(productBarcodeID string) (*pb.PosProduct, error) {
-	// Try to get the product from Redis first
-	productData, err := r.redis.Get(context.Background(), productBarcodeID).Result()
-	if err == redis.Nil {
-		// Product not found in Redis, get from PostgreSQL
-		var posProductEntity entity.PosProduct
-		if err := r.db.Where("product_barcode_id = ?", productBarcodeID).First(&posProductEntity).Error; err != nil {
-			return nil, err
-		}
-
-		// Convert entity.PosProduct to pb.PosProduct
-		posProduct := &pb.PosProduct{
-			ProductId:          posProductEntity.ProductID.String(),
-			ProductBarcodeId:   posProductEntity.ProductBarcodeID,
-			ProductName:        posProductEntity.ProductName,
-			Price:              posProductEntity.Price,
-			CostPrice:          posProductEntity.CostPrice,
-			CategoryId:         posProductEntity.CategoryID.String(),
-			SubCategoryId:      posProductEntity.SubCategoryID.String(),
-			StockQuantity:      int32(posProductEntity.StockQuantity),
-			ReorderLevel:       int32(posProductEntity.ReorderLevel),
-			SupplierId:         posProductEntity.SupplierID.String(),
-			ProductDescription: posProductEntity.ProductDescription,
-			Active:             posProductEntity.Active,
-			StoreId:            posProductEntity.StoreID.String(),
-			BranchId:           posProductEntity.BranchID.String(),
-			CompanyId:          posProductEntity.CompanyID.String(),
-			CreatedAt:          timestamppb.New(posProductEntity.CreatedAt),
-			CreatedBy:          posProductEntity.CreatedBy.String(),
-			UpdatedAt:          timestamppb.New(posProductEntity.UpdatedAt),
-			UpdatedBy:          posProductEntity.UpdatedBy.String(),
-		}
-
-		// Store the product in Redis for future queries
-		productData, err := json.Marshal(posProductEntity)
-		if err != nil {
-			return nil, err
-		}
-		err = r.redis.Set(context.Background(), productBarcodeID, productData, 7*24*time.Hour).Err()
-		if err != nil {
-			return nil, err
-		}
-
-		return posProduct, nil
-	} else if err != nil {
-		return nil, err
+// ReadPosProductBarcode returns the product scanned by any of its barcodes within the company,
+// with the barcode it is scanned by. A scale label also returns the price or weight it carries.
+func (r *posProductRepository) ReadPosProductBarcode(companyID string, barcode string) (*pb.PosProduct, *pb.PosProductBarcode, *pb.PosEmbeddedBarcode, error) {
+	companyUUID, err := uuid.Parse(companyID)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
-	// Product found in Redis, unmarshal the data
-	var posProductEntity entity.PosProduct
-	err = json.Unmarshal([]byte(productData), &posProductEntity)
+	posProductBarcode, embedded, _, err := resolveProductBarcode(r.db, companyUUID, barcode)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
-	// Convert entity.PosProduct to pb.PosProduct
-	posProduct := &pb.PosProduct{
-		ProductId:          posProductEntity.ProductID.String(),
-		ProductBarcodeId:   posProductEntity.ProductBarcodeID,
-		ProductName:        posProductEntity.ProductName,
-		Price:              posProductEntity.Price,
-		CostPrice:          posProductEntity.CostPrice,
-		CategoryId:         posProductEntity.CategoryID.String(),
-		SubCategoryId:      posProductEntity.SubCategoryID.String(),
-		StockQuantity:      int32(posProductEntity.StockQuantity),
-		ReorderLevel:       int32(posProductEntity.ReorderLevel),
-		SupplierId:         posProductEntity.SupplierID.String(),
-		ProductDescription: posProductEntity.ProductDescription,
-		Active:             posProductEntity.Active,
-		StoreId:            posProductEntity.StoreID.String(),
-		BranchId:           posProductEntity.BranchID.String(),
-		CompanyId:          posProductEntity.CompanyID.String(),
-		CreatedAt:          timestamppb.New(posProductEntity.CreatedAt),
-		CreatedBy:          posProductEntity.CreatedBy.String(),
-		UpdatedAt:          timestamppb.New(posProductEntity.UpdatedAt),
-		UpdatedBy:          posProductEntity.UpdatedBy.String(),
+	// The product itself is cached by its ID
+	posProduct, err := r.ReadPosProduct(posProductBarcode.ProductID.String())
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
-	return posProduct, nil
+	return posProduct, toPbPosProductBarcode(*posProductBarcode), embedded, nil
 }
 
+// UpdatePosProduct writes the catalog columns of the product only. The stock quantity and the
+// cost price are owned by the inventory movements, writing back the values read before the
+// update would undo the movements posted in between and reset the weighted-average cost.
 func (r *posProductRepository) UpdatePosProduct(posProduct *entity.PosProduct) error {
-	if err := r.db.Save(posProduct).Error; err != nil {
-		return err
-	}
-
-	// Update the product in Redis
-	productData, err := json.Marshal(posProduct)
-	if err != nil {
-		return err
-	}
-	err = r.redis.Set(context.Background(), posProduct.ProductID.String(), productData, 7*24*time.Hour).Err()
+	err := r.db.Model(&entity.PosProduct{}).Where("product_id = ?", posProduct.ProductID).Updates(map[string]interface{}{
+		"product_name":        posProduct.ProductName,
+		"price":               posProduct.Price,
+		"reorder_level":       posProduct.ReorderLevel,
+		"supplier_id":         posProduct.SupplierID,
+		"product_description": posProduct.ProductDescription,
+		"active":              posProduct.Active,
+		"serialized":          posProduct.Serialized,
+		"base_unit":           posProduct.BaseUnit,
+		"price_override":      posProduct.PriceOverride,
+		"store_id":            posProduct.StoreID,
+		"updated_at":          posProduct.UpdatedAt,
+		"updated_by":          posProduct.UpdatedBy,
+	}).Error
 	if err != nil {
 		return err
 	}
 
+	// The cached product is dropped rather than replaced, the next read loads the stored columns
+	invalidateProductCache(r.redis, *posProduct)
+
 	return nil
 }
 
 func (r *posProductRepository) DeletePosProduct(productID string) error {
-	if err := r.db.Where("product_id = ?", productID).Delete(&entity.PosProduct{}).Error; err != nil {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("product_id = ?", productID).Delete(&entity.PosProductBarcode{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("product_id = ?", productID).Delete(&entity.PosProduct{}).Error
+	})
+	if err != nil {
 		return err
 	}
 
 	// Delete the product from Redis
-	err := r.redis.Del(context.Background(), productID).Err()
+	err = r.redis.Del(context.Background(), productID).Err()
 	if err != nil {
 		return err
 	}
@@ -256,7 +233,8 @@ func (r *posProductRepository) ReadAllPosProducts(pagination dto.Pagination, rol
 	case branchRole:
 		query = query.Where("branch_id = ?", jwtPayload.BranchId)
 	case storeRole:
-		query = query.Where("store_id = ?", jwtPayload.StoreId)
+		// Products are shared by the stores of a branch, stock is kept per store
+		query = query.Where("branch_id = ?", jwtPayload.BranchId)
 	default:
 		return nil, errors.New("invalid role")
 	}
@@ -278,3 +256,14 @@ func (r *posProductRepository) ReadAllPosProducts(pagination dto.Pagination, rol
 		TotalPages:   totalPages,
 	}, nil
 }
+
+// ConvertToBaseUnit converts a quantity in one of the units of the product to its base unit and
+// returns it with the conversion factor
+func (r *posProductRepository) ConvertToBaseUnit(productID string, unit string, unitQuantity float64) (int, float64, error) {
+	id, err := uuid.Parse(productID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return convertToBaseUnit(r.db, id, unit, unitQuantity)
+}
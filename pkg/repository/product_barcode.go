@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosProductBarcodeRepository interface {
+	CreatePosProductBarcode(posProductBarcode *entity.PosProductBarcode) error
+	ReadPosProductBarcode(barcodeID string) (*pb.PosProductBarcode, error)
+	DeletePosProductBarcode(barcodeID string) error
+	ReadAllPosProductBarcodes(productID string) ([]*pb.PosProductBarcode, error)
+	GenerateInternalBarcode(companyID uuid.UUID, prefixFrom string, prefixTo string, posProductBarcode *entity.PosProductBarcode) (string, error)
+}
+
+type posProductBarcodeRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+func NewPosProductBarcodeRepository(db *gorm.DB, redis *redis.Client) PosProductBarcodeRepository {
+	return &posProductBarcodeRepository{
+		db:    db,
+		redis: redis,
+	}
+}
+
+func (r *posProductBarcodeRepository) CreatePosProductBarcode(posProductBarcode *entity.PosProductBarcode) error {
+	return createProductBarcode(r.db, posProductBarcode)
+}
+
+func (r *posProductBarcodeRepository) ReadPosProductBarcode(barcodeID string) (*pb.PosProductBarcode, error) {
+	var posProductBarcode entity.PosProductBarcode
+	if err := r.db.Where("barcode_id = ?", barcodeID).First(&posProductBarcode).Error; err != nil {
+		return nil, err
+	}
+
+	return toPbPosProductBarcode(posProductBarcode), nil
+}
+
+func (r *posProductBarcodeRepository) DeletePosProductBarcode(barcodeID string) error {
+	return r.db.Where("barcode_id = ?", barcodeID).Delete(&entity.PosProductBarcode{}).Error
+}
+
+func (r *posProductBarcodeRepository) ReadAllPosProductBarcodes(productID string) ([]*pb.PosProductBarcode, error) {
+	var posProductBarcodes []entity.PosProductBarcode
+	if err := r.db.Where("product_id = ?", productID).Order("created_at asc").Find(&posProductBarcodes).Error; err != nil {
+		return nil, err
+	}
+
+	pbPosProductBarcodes := make([]*pb.PosProductBarcode, len(posProductBarcodes))
+	for i, posProductBarcode := range posProductBarcodes {
+		pbPosProductBarcodes[i] = toPbPosProductBarcode(posProductBarcode)
+	}
+
+	return pbPosProductBarcodes, nil
+}
+
+// GenerateInternalBarcode allocates the next EAN-13 of the company within the prefix range that
+// no product uses yet. The sequence row is locked so concurrent calls never get the same barcode.
+// When a product barcode is given, it is created with the generated barcode in the same transaction.
+func (r *posProductBarcodeRepository) GenerateInternalBarcode(companyID uuid.UUID, prefixFrom string, prefixTo string, posProductBarcode *entity.PosProductBarcode) (string, error) {
+	var barcode string
+	prefixRange := prefixFrom + "-" + prefixTo
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("INSERT INTO pos_internal_barcode_sequences (company_id, prefix_range, next_value, updated_at) VALUES (?, ?, 0, ?) ON CONFLICT DO NOTHING", companyID, prefixRange, time.Now()).Error
+		if err != nil {
+			return err
+		}
+
+		var sequence entity.PosInternalBarcodeSequence
+		if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("company_id = ? AND prefix_range = ?", companyID, prefixRange).First(&sequence).Error; err != nil {
+			return err
+		}
+
+		// Skip the barcodes already given to products by hand
+		next := sequence.NextValue
+		for {
+			generated, ok := utils.InternalBarcode(prefixFrom, prefixTo, next)
+			if !ok {
+				return errors.New("error generate internal barcode, the prefix range " + prefixRange + " has no barcodes left")
+			}
+			next++
+
+			_, err := findProductBarcode(tx, companyID, generated)
+			if gorm.IsRecordNotFoundError(err) {
+				barcode = generated
+				break
+			} else if err != nil {
+				return err
+			}
+		}
+
+		err = tx.Model(&entity.PosInternalBarcodeSequence{}).Where("company_id = ? AND prefix_range = ?", companyID, prefixRange).UpdateColumns(map[string]interface{}{
+			"next_value": next,
+			"updated_at": time.Now(),
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		if posProductBarcode == nil {
+			return nil
+		}
+
+		posProductBarcode.Barcode = barcode
+		return createProductBarcode(tx, posProductBarcode)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return barcode, nil
+}
+
+// createProductBarcode inserts a barcode that is not yet used within the company. The unique index
+// on company and barcode still rejects a barcode inserted concurrently.
+func createProductBarcode(db *gorm.DB, posProductBarcode *entity.PosProductBarcode) error {
+	_, err := findProductBarcode(db, posProductBarcode.CompanyID, posProductBarcode.Barcode)
+	if err == nil {
+		return errors.New("barcode " + posProductBarcode.Barcode + " is already used by another product within the company")
+	} else if !gorm.IsRecordNotFoundError(err) {
+		return err
+	}
+
+	return db.Create(posProductBarcode).Error
+}
+
+// resolveProductBarcode returns the barcode within the company a scanned barcode is found by,
+// with the number of base units one scan stands for. A scale label that is not a barcode itself is
+// decoded by the embedded barcode rules of the company and found by the barcode of its PLU.
+func resolveProductBarcode(db *gorm.DB, companyID uuid.UUID, barcode string) (*entity.PosProductBarcode, *pb.PosEmbeddedBarcode, int, error) {
+	posProductBarcode, err := findProductBarcode(db, companyID, barcode)
+	if err == nil {
+		return posProductBarcode, nil, posProductBarcode.Quantity, nil
+	} else if !gorm.IsRecordNotFoundError(err) {
+		return nil, nil, 0, err
+	}
+
+	rule, embedded, lookup, decodeErr := decodeEmbeddedBarcode(db, companyID, barcode)
+	if decodeErr != nil {
+		return nil, nil, 0, decodeErr
+	}
+	if rule == nil {
+		return nil, nil, 0, err
+	}
+
+	posProductBarcode, err = findProductBarcode(db, companyID, lookup)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	// A price label is one pack, a weight label is its weight in the base unit of the product
+	embedded.Quantity = 1
+	if rule.ValueType == entity.EmbeddedValueTypeWeight {
+		baseUnit, factor, _, err := readUnitConversion(db, posProductBarcode.ProductID, rule.Unit)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+
+		quantity, err := labelWeightQuantity(embedded.Weight, rule.Unit, baseUnit, factor)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		embedded.Quantity = int32(quantity)
+	}
+
+	return posProductBarcode, embedded, int(embedded.Quantity), nil
+}
+
+// labelWeightQuantity converts the weight of a scale label to whole base units of the product. A
+// weight is a fraction of its unit by nature, but the stock is kept in whole base units, so the
+// base unit of a product sold by weight must be at least as fine as the label, e.g. g.
+func labelWeightQuantity(weight float64, unit string, baseUnit string, factor float64) (int, error) {
+	quantity, err := baseUnitQuantity(unit, baseUnit, weight, factor, true)
+	if err != nil {
+		return 0, fmt.Errorf("weight label of %v %s can not be kept in whole %s, products sold by weight need a base unit as fine as the label", weight, unit, baseUnit)
+	}
+
+	return quantity, nil
+}
+
+// findProductBarcode returns the barcode within the company
+func findProductBarcode(db *gorm.DB, companyID uuid.UUID, barcode string) (*entity.PosProductBarcode, error) {
+	var posProductBarcode entity.PosProductBarcode
+	if err := db.Where("company_id = ? AND barcode = ?", companyID, barcode).First(&posProductBarcode).Error; err != nil {
+		return nil, err
+	}
+
+	return &posProductBarcode, nil
+}
+
+// primaryProductBarcode returns the barcode row of the barcode a product is created with
+func primaryProductBarcode(posProduct *entity.PosProduct) *entity.PosProductBarcode {
+	return &entity.PosProductBarcode{
+		BarcodeID:   uuid.New(),
+		ProductID:   posProduct.ProductID,
+		Barcode:     posProduct.ProductBarcodeID,
+		BarcodeType: entity.BarcodeTypeManufacturer,
+		Quantity:    1,
+		CompanyID:   posProduct.CompanyID,
+		CreatedAt:   posProduct.CreatedAt,
+		CreatedBy:   posProduct.CreatedBy,
+		UpdatedAt:   posProduct.UpdatedAt,
+		UpdatedBy:   posProduct.UpdatedBy,
+	}
+}
+
+func toPbPosProductBarcode(posProductBarcode entity.PosProductBarcode) *pb.PosProductBarcode {
+	return &pb.PosProductBarcode{
+		BarcodeId:   posProductBarcode.BarcodeID.String(),
+		ProductId:   posProductBarcode.ProductID.String(),
+		Barcode:     posProductBarcode.Barcode,
+		BarcodeType: posProductBarcode.BarcodeType,
+		Quantity:    int32(posProductBarcode.Quantity),
+		CompanyId:   posProductBarcode.CompanyID.String(),
+		CreatedAt:   timestamppb.New(posProductBarcode.CreatedAt),
+		CreatedBy:   posProductBarcode.CreatedBy.String(),
+		UpdatedAt:   timestamppb.New(posProductBarcode.UpdatedAt),
+		UpdatedBy:   posProductBarcode.UpdatedBy.String(),
+	}
+}
@@ -0,0 +1,34 @@
+package repository
+
+import "testing"
+
+func TestLabelWeightQuantity(t *testing.T) {
+	tests := []struct {
+		name     string
+		weight   float64
+		unit     string
+		baseUnit string
+		factor   float64
+		want     int
+		wantErr  bool
+	}{
+		{"kg label on a gram product", 1.235, "kg", "g", 1000, 1235, false},
+		{"gram label on a gram product", 1235, "g", "g", 1, 1235, false},
+		{"kg label of whole kilograms on a kg product", 2, "kg", "kg", 1, 2, false},
+		{"fractional kg label on a kg product", 1.235, "kg", "kg", 1, 0, true},
+		{"gram label on a kg product", 1235, "g", "kg", 0.001, 0, true},
+		{"kg label finer than a gram", 1.2345, "kg", "g", 1000, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := labelWeightQuantity(tt.weight, tt.unit, tt.baseUnit, tt.factor)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("labelWeightQuantity() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("labelWeightQuantity() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"fmt"
+	"math"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// unitQuantityTolerance absorbs the float error of unit quantities that convert to whole base units
+const unitQuantityTolerance = 1e-6
+
+type PosProductUnitRepository interface {
+	CreatePosProductUnit(posProductUnit *entity.PosProductUnit) error
+	ReadPosProductUnit(productUnitID string) (*pb.PosProductUnit, error)
+	UpdatePosProductUnit(posProductUnit *entity.PosProductUnit) error
+	DeletePosProductUnit(productUnitID string) error
+	ReadAllPosProductUnits(productID string) ([]*pb.PosProductUnit, error)
+}
+
+type posProductUnitRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+func NewPosProductUnitRepository(db *gorm.DB, redis *redis.Client) PosProductUnitRepository {
+	return &posProductUnitRepository{
+		db:    db,
+		redis: redis,
+	}
+}
+
+func (r *posProductUnitRepository) CreatePosProductUnit(posProductUnit *entity.PosProductUnit) error {
+	return r.db.Create(posProductUnit).Error
+}
+
+func (r *posProductUnitRepository) ReadPosProductUnit(productUnitID string) (*pb.PosProductUnit, error) {
+	var posProductUnit entity.PosProductUnit
+	if err := r.db.Where("product_unit_id = ?", productUnitID).First(&posProductUnit).Error; err != nil {
+		return nil, err
+	}
+
+	return toPbPosProductUnit(posProductUnit), nil
+}
+
+func (r *posProductUnitRepository) UpdatePosProductUnit(posProductUnit *entity.PosProductUnit) error {
+	return r.db.Save(posProductUnit).Error
+}
+
+func (r *posProductUnitRepository) DeletePosProductUnit(productUnitID string) error {
+	return r.db.Where("product_unit_id = ?", productUnitID).Delete(&entity.PosProductUnit{}).Error
+}
+
+func (r *posProductUnitRepository) ReadAllPosProductUnits(productID string) ([]*pb.PosProductUnit, error) {
+	var posProductUnits []entity.PosProductUnit
+	if err := r.db.Where("product_id = ?", productID).Order("factor asc").Find(&posProductUnits).Error; err != nil {
+		return nil, err
+	}
+
+	pbPosProductUnits := make([]*pb.PosProductUnit, len(posProductUnits))
+	for i, posProductUnit := range posProductUnits {
+		pbPosProductUnits[i] = toPbPosProductUnit(posProductUnit)
+	}
+
+	return pbPosProductUnits, nil
+}
+
+// convertToBaseUnit converts a quantity in a unit of the product to whole base units and returns
+// it with the conversion factor. The base unit itself converts with factor 1 and only takes whole
+// quantities, other units take fractions only when they allow them, and the result must always be
+// a whole number of base units.
+func convertToBaseUnit(db *gorm.DB, productID uuid.UUID, unit string, unitQuantity float64) (int, float64, error) {
+	baseUnit, factor, allowFraction, err := readUnitConversion(db, productID, unit)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	baseQuantity, err := baseUnitQuantity(unit, baseUnit, unitQuantity, factor, allowFraction)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return baseQuantity, factor, nil
+}
+
+// readUnitConversion returns the base unit of the product with the factor of the unit to it and
+// whether the unit takes fractional quantities
+func readUnitConversion(db *gorm.DB, productID uuid.UUID, unit string) (string, float64, bool, error) {
+	var posProduct entity.PosProduct
+	if err := db.Select("product_id, base_unit").Where("product_id = ?", productID).First(&posProduct).Error; err != nil {
+		return "", 0, false, err
+	}
+
+	baseUnit := posProduct.BaseUnit
+	if baseUnit == "" {
+		baseUnit = entity.DefaultBaseUnit
+	}
+
+	if unit == baseUnit {
+		return baseUnit, 1, false, nil
+	}
+
+	var posProductUnit entity.PosProductUnit
+	err := db.Where("product_id = ? AND unit = ?", productID, unit).First(&posProductUnit).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return "", 0, false, fmt.Errorf("unit %s is not defined for product %s", unit, productID)
+	} else if err != nil {
+		return "", 0, false, err
+	}
+
+	return baseUnit, posProductUnit.Factor, posProductUnit.AllowFraction, nil
+}
+
+// baseUnitQuantity converts a quantity of the unit into a whole quantity of the base unit
+func baseUnitQuantity(unit string, baseUnit string, unitQuantity float64, factor float64, allowFraction bool) (int, error) {
+	if !allowFraction && math.Abs(unitQuantity-math.Round(unitQuantity)) > unitQuantityTolerance {
+		return 0, fmt.Errorf("unit %s does not allow fractional quantities", unit)
+	}
+
+	baseQuantity := unitQuantity * factor
+	if math.Abs(baseQuantity-math.Round(baseQuantity)) > unitQuantityTolerance {
+		return 0, fmt.Errorf("%v %s is not a whole number of %s", unitQuantity, unit, baseUnit)
+	}
+
+	return int(math.Round(baseQuantity)), nil
+}
+
+func toPbPosProductUnit(posProductUnit entity.PosProductUnit) *pb.PosProductUnit {
+	return &pb.PosProductUnit{
+		ProductUnitId: posProductUnit.ProductUnitID.String(),
+		ProductId:     posProductUnit.ProductID.String(),
+		Unit:          posProductUnit.Unit,
+		Factor:        posProductUnit.Factor,
+		AllowFraction: posProductUnit.AllowFraction,
+		CompanyId:     posProductUnit.CompanyID.String(),
+		CreatedAt:     timestamppb.New(posProductUnit.CreatedAt),
+		CreatedBy:     posProductUnit.CreatedBy.String(),
+		UpdatedAt:     timestamppb.New(posProductUnit.UpdatedAt),
+		UpdatedBy:     posProductUnit.UpdatedBy.String(),
+	}
+}
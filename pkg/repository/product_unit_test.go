@@ -0,0 +1,38 @@
+package repository
+
+import "testing"
+
+func TestBaseUnitQuantity(t *testing.T) {
+	tests := []struct {
+		name          string
+		unit          string
+		baseUnit      string
+		unitQuantity  float64
+		factor        float64
+		allowFraction bool
+		want          int
+		wantErr       bool
+	}{
+		{"base unit", "each", "each", 3, 1, false, 3, false},
+		{"case of twelve", "case", "each", 2, 12, false, 24, false},
+		{"negative quantity", "case", "each", -2, 12, false, -24, false},
+		{"fractional case is rejected", "case", "each", 1.5, 12, false, 0, true},
+		{"fractional kilogram in grams", "kg", "g", 1.25, 1000, true, 1250, false},
+		{"float error is absorbed", "kg", "g", 0.1 + 0.2, 1000, true, 300, false},
+		{"fraction of a base unit is rejected", "kg", "g", 0.0005, 1000, true, 0, true},
+		{"half dozen in dozens", "half_dozen", "dozen", 3, 0.5, false, 0, true},
+		{"zero quantity", "case", "each", 0, 12, false, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := baseUnitQuantity(tt.unit, tt.baseUnit, tt.unitQuantity, tt.factor, tt.allowFraction)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("baseUnitQuantity() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("baseUnitQuantity() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
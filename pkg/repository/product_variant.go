@@ -0,0 +1,337 @@
+package repository
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosProductVariantRepository interface {
+	SetPosProductOptions(productID uuid.UUID, optionNames []string, userID uuid.UUID) ([]*pb.PosProductOption, error)
+	ReadPosProductOptions(productID string) ([]*pb.PosProductOption, error)
+	CreatePosProductVariant(posProduct *entity.PosProduct, values map[string]string) error
+	ReadPosProductVariants(parentProductID string) ([]*pb.PosProduct, error)
+	ReadPosProductVariantValues(productID string) ([]*pb.PosProductVariantValue, error)
+	UpdatePosProductVariantPrices(parentProductID uuid.UUID, price float64, userID uuid.UUID) error
+}
+
+type posProductVariantRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+func NewPosProductVariantRepository(db *gorm.DB, redis *redis.Client) PosProductVariantRepository {
+	return &posProductVariantRepository{
+		db:    db,
+		redis: redis,
+	}
+}
+
+// SetPosProductOptions replaces the option axes of a parent product. The product row is locked so
+// no variant or stock movement can be added while the axes change.
+func (r *posProductVariantRepository) SetPosProductOptions(productID uuid.UUID, optionNames []string, userID uuid.UUID) ([]*pb.PosProductOption, error) {
+	var posProduct entity.PosProduct
+	var posProductOptions []entity.PosProductOption
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("product_id = ?", productID).First(&posProduct).Error; err != nil {
+			return err
+		}
+
+		if posProduct.ParentProductID != nil {
+			return errors.New("error set product options, a variant can not have options")
+		}
+
+		if posProduct.StockQuantity != 0 {
+			return errors.New("error set product options, options can only be set while the product has no stock")
+		}
+
+		var variantCount int
+		if err := tx.Model(&entity.PosProduct{}).Where("parent_product_id = ?", productID).Count(&variantCount).Error; err != nil {
+			return err
+		}
+		if variantCount > 0 {
+			return errors.New("error set product options, options can only be set while the product has no variants")
+		}
+
+		if err := tx.Where("product_id = ?", productID).Delete(&entity.PosProductOption{}).Error; err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for i, optionName := range optionNames {
+			posProductOption := entity.PosProductOption{
+				OptionID:  uuid.New(),
+				ProductID: productID,
+				Name:      optionName,
+				Position:  i + 1,
+				CompanyID: posProduct.CompanyID,
+				CreatedAt: now,
+				CreatedBy: userID,
+				UpdatedAt: now,
+				UpdatedBy: userID,
+			}
+			if err := tx.Create(&posProductOption).Error; err != nil {
+				return err
+			}
+			posProductOptions = append(posProductOptions, posProductOption)
+		}
+
+		return tx.Model(&entity.PosProduct{}).Where("product_id = ?", productID).UpdateColumns(map[string]interface{}{
+			"has_variants": len(optionNames) > 0,
+			"updated_at":   now,
+			"updated_by":   userID,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate the cached product only after the transaction is committed
+	invalidateProductCache(r.redis, posProduct)
+
+	pbPosProductOptions := make([]*pb.PosProductOption, len(posProductOptions))
+	for i, posProductOption := range posProductOptions {
+		pbPosProductOptions[i] = toPbPosProductOption(posProductOption)
+	}
+
+	return pbPosProductOptions, nil
+}
+
+func (r *posProductVariantRepository) ReadPosProductOptions(productID string) ([]*pb.PosProductOption, error) {
+	var posProductOptions []entity.PosProductOption
+	if err := r.db.Where("product_id = ?", productID).Order("position asc").Find(&posProductOptions).Error; err != nil {
+		return nil, err
+	}
+
+	pbPosProductOptions := make([]*pb.PosProductOption, len(posProductOptions))
+	for i, posProductOption := range posProductOptions {
+		pbPosProductOptions[i] = toPbPosProductOption(posProductOption)
+	}
+
+	return pbPosProductOptions, nil
+}
+
+// CreatePosProductVariant inserts a variant with its value on every option axis of the parent,
+// given by option name. The parent row is locked so two variants with the same values can not be
+// created concurrently.
+func (r *posProductVariantRepository) CreatePosProductVariant(posProduct *entity.PosProduct, values map[string]string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var parent entity.PosProduct
+		if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("product_id = ?", *posProduct.ParentProductID).First(&parent).Error; err != nil {
+			return err
+		}
+
+		var posProductOptions []entity.PosProductOption
+		if err := tx.Where("product_id = ?", parent.ProductID).Order("position asc").Find(&posProductOptions).Error; err != nil {
+			return err
+		}
+
+		if len(posProductOptions) == 0 {
+			return errors.New("error create product variant, the parent product has no options")
+		}
+
+		if len(values) != len(posProductOptions) {
+			return errors.New("error create product variant, a value is needed for every option of the parent product, and only for those")
+		}
+
+		posProductVariantValues := make([]entity.PosProductVariantValue, len(posProductOptions))
+		combination := make([]string, len(posProductOptions))
+		for i, posProductOption := range posProductOptions {
+			value, ok := values[posProductOption.Name]
+			if !ok {
+				return errors.New("error create product variant, value of option " + posProductOption.Name + " could not be empty")
+			}
+
+			posProductVariantValues[i] = entity.PosProductVariantValue{
+				ProductID: posProduct.ProductID,
+				OptionID:  posProductOption.OptionID,
+				Value:     value,
+			}
+			combination[i] = strings.ToLower(value)
+		}
+
+		// Every variant of a parent has its own combination of option values
+		existingCombinations, err := readVariantCombinations(tx, parent.ProductID)
+		if err != nil {
+			return err
+		}
+		if existingCombinations[strings.Join(combination, "\x00")] {
+			return errors.New("error create product variant, a variant with the same option values already exists")
+		}
+
+		if err := tx.Create(posProduct).Error; err != nil {
+			return err
+		}
+
+		if err := createProductBarcode(tx, primaryProductBarcode(posProduct)); err != nil {
+			return err
+		}
+
+		for _, posProductVariantValue := range posProductVariantValues {
+			if err := tx.Create(&posProductVariantValue).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ReadPosProductVariants returns the variants of a parent product with their option values
+func (r *posProductVariantRepository) ReadPosProductVariants(parentProductID string) ([]*pb.PosProduct, error) {
+	var posProducts []entity.PosProduct
+	if err := r.db.Where("parent_product_id = ?", parentProductID).Order("product_name asc").Find(&posProducts).Error; err != nil {
+		return nil, err
+	}
+
+	pbPosProducts := make([]*pb.PosProduct, len(posProducts))
+	for i, posProduct := range posProducts {
+		pbPosProducts[i] = toPbPosProductVariant(posProduct)
+
+		variantValues, err := r.ReadPosProductVariantValues(posProduct.ProductID.String())
+		if err != nil {
+			return nil, err
+		}
+		pbPosProducts[i].VariantValues = variantValues
+	}
+
+	return pbPosProducts, nil
+}
+
+// ReadPosProductVariantValues returns the option values of a variant in the order of the options
+func (r *posProductVariantRepository) ReadPosProductVariantValues(productID string) ([]*pb.PosProductVariantValue, error) {
+	var rows []struct {
+		OptionName string
+		Value      string
+	}
+
+	err := r.db.Table("pos_product_variant_values vv").
+		Select("o.name AS option_name, vv.value").
+		Joins("JOIN pos_product_options o ON o.option_id = vv.option_id").
+		Where("vv.product_id = ?", productID).
+		Order("o.position asc").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	variantValues := make([]*pb.PosProductVariantValue, len(rows))
+	for i, row := range rows {
+		variantValues[i] = &pb.PosProductVariantValue{
+			OptionName: row.OptionName,
+			Value:      row.Value,
+		}
+	}
+
+	return variantValues, nil
+}
+
+// UpdatePosProductVariantPrices gives the new price of a parent product to its variants that do
+// not override it
+func (r *posProductVariantRepository) UpdatePosProductVariantPrices(parentProductID uuid.UUID, price float64, userID uuid.UUID) error {
+	var posProducts []entity.PosProduct
+	if err := r.db.Where("parent_product_id = ? AND price_override = ?", parentProductID, false).Find(&posProducts).Error; err != nil {
+		return err
+	}
+
+	if len(posProducts) == 0 {
+		return nil
+	}
+
+	err := r.db.Model(&entity.PosProduct{}).Where("parent_product_id = ? AND price_override = ?", parentProductID, false).UpdateColumns(map[string]interface{}{
+		"price":      price,
+		"updated_at": time.Now(),
+		"updated_by": userID,
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	invalidateProductCache(r.redis, posProducts...)
+
+	return nil
+}
+
+// readVariantCombinations returns the option values of every variant of a parent, lower cased and
+// joined in the order of the options
+func readVariantCombinations(tx *gorm.DB, parentProductID uuid.UUID) (map[string]bool, error) {
+	var rows []struct {
+		ProductID uuid.UUID
+		Position  int
+		Value     string
+	}
+
+	err := tx.Table("pos_product_variant_values vv").
+		Select("vv.product_id, o.position, vv.value").
+		Joins("JOIN pos_product_options o ON o.option_id = vv.option_id").
+		Where("o.product_id = ?", parentProductID).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(rows, func(a, b int) bool {
+		return rows[a].Position < rows[b].Position
+	})
+
+	values := make(map[uuid.UUID][]string)
+	for _, row := range rows {
+		values[row.ProductID] = append(values[row.ProductID], strings.ToLower(row.Value))
+	}
+
+	combinations := make(map[string]bool, len(values))
+	for _, variantValues := range values {
+		combinations[strings.Join(variantValues, "\x00")] = true
+	}
+
+	return combinations, nil
+}
+
+func toPbPosProductOption(posProductOption entity.PosProductOption) *pb.PosProductOption {
+	return &pb.PosProductOption{
+		OptionId:  posProductOption.OptionID.String(),
+		ProductId: posProductOption.ProductID.String(),
+		Name:      posProductOption.Name,
+		Position:  int32(posProductOption.Position),
+	}
+}
+
+func toPbPosProductVariant(posProduct entity.PosProduct) *pb.PosProduct {
+	return &pb.PosProduct{
+		ProductId:          posProduct.ProductID.String(),
+		ProductBarcodeId:   posProduct.ProductBarcodeID,
+		ProductName:        posProduct.ProductName,
+		Price:              posProduct.Price,
+		CostPrice:          posProduct.CostPrice,
+		CategoryId:         posProduct.CategoryID.String(),
+		SubCategoryId:      posProduct.SubCategoryID.String(),
+		StockQuantity:      int32(posProduct.StockQuantity),
+		ReorderLevel:       int32(posProduct.ReorderLevel),
+		SupplierId:         posProduct.SupplierID.String(),
+		ProductDescription: posProduct.ProductDescription,
+		Active:             posProduct.Active,
+		Serialized:         posProduct.Serialized,
+		BaseUnit:           posProduct.BaseUnit,
+		ParentProductId:    utils.UUIDString(posProduct.ParentProductID),
+		HasVariants:        posProduct.HasVariants,
+		PriceOverride:      posProduct.PriceOverride,
+		StoreId:            posProduct.StoreID.String(),
+		BranchId:           posProduct.BranchID.String(),
+		CompanyId:          posProduct.CompanyID.String(),
+		CreatedAt:          timestamppb.New(posProduct.CreatedAt),
+		CreatedBy:          posProduct.CreatedBy.String(),
+		UpdatedAt:          timestamppb.New(posProduct.UpdatedAt),
+		UpdatedBy:          posProduct.UpdatedBy.String(),
+	}
+}
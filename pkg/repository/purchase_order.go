@@ -0,0 +1,460 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PosPurchaseOrderReceiptLine is the quantity of a purchase order item received in one delivery
+type PosPurchaseOrderReceiptLine struct {
+	PurchaseOrderItemID uuid.UUID
+	Quantity            int
+	UnitCost            float64
+	LotCode             string
+	ExpiryDate          *time.Time
+	SerialNumbers       []string
+	Unit                string
+	UnitQuantity        float64
+}
+
+// PosReplenishmentCandidate is a low stock product of a store with the quantity still to be
+// delivered by the open purchase orders of the store
+type PosReplenishmentCandidate struct {
+	ProductID    uuid.UUID
+	StoreID      uuid.UUID
+	BranchID     uuid.UUID
+	CompanyID    uuid.UUID
+	SupplierID   uuid.UUID
+	CostPrice    float64
+	Quantity     int
+	ReorderLevel int
+	MaxLevel     int
+	OpenQuantity int
+}
+
+type PosPurchaseOrderRepository interface {
+	CreatePosPurchaseOrder(posPurchaseOrder *entity.PosPurchaseOrder, items []entity.PosPurchaseOrderItem) error
+	ReadPosPurchaseOrder(purchaseOrderID string) (*pb.PosPurchaseOrder, error)
+	UpdatePosPurchaseOrder(posPurchaseOrder *entity.PosPurchaseOrder, items []entity.PosPurchaseOrderItem) error
+	SubmitPosPurchaseOrder(purchaseOrderID string, userID uuid.UUID) error
+	ReceivePosPurchaseOrder(purchaseOrderID string, lines []PosPurchaseOrderReceiptLine, note string, userID uuid.UUID) error
+	CancelPosPurchaseOrder(purchaseOrderID string, userID uuid.UUID) error
+	ReadAllPosPurchaseOrders(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, status string, supplierID string) (*dto.PaginationResult, error)
+	ReadReplenishmentCandidates(branchID string, storeID string) ([]PosReplenishmentCandidate, error)
+	CreatePosPurchaseOrders(posPurchaseOrders []entity.PosPurchaseOrder, items []entity.PosPurchaseOrderItem) error
+}
+
+type posPurchaseOrderRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+func NewPosPurchaseOrderRepository(db *gorm.DB, redis *redis.Client) PosPurchaseOrderRepository {
+	return &posPurchaseOrderRepository{
+		db:    db,
+		redis: redis,
+	}
+}
+
+func (r *posPurchaseOrderRepository) CreatePosPurchaseOrder(posPurchaseOrder *entity.PosPurchaseOrder, items []entity.PosPurchaseOrderItem) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(posPurchaseOrder).Error; err != nil {
+			return err
+		}
+
+		for i := range items {
+			if err := tx.Create(&items[i]).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *posPurchaseOrderRepository) ReadPosPurchaseOrder(purchaseOrderID string) (*pb.PosPurchaseOrder, error) {
+	var posPurchaseOrder entity.PosPurchaseOrder
+	if err := r.db.Where("purchase_order_id = ?", purchaseOrderID).First(&posPurchaseOrder).Error; err != nil {
+		return nil, err
+	}
+
+	var items []entity.PosPurchaseOrderItem
+	if err := r.db.Where("purchase_order_id = ?", purchaseOrderID).Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	return toPbPosPurchaseOrder(posPurchaseOrder, items), nil
+}
+
+// UpdatePosPurchaseOrder replaces the header and the lines of a draft purchase order
+func (r *posPurchaseOrderRepository) UpdatePosPurchaseOrder(posPurchaseOrder *entity.PosPurchaseOrder, items []entity.PosPurchaseOrderItem) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockPurchaseOrder(tx, posPurchaseOrder.PurchaseOrderID.String(), []string{entity.PurchaseOrderStatusDraft}, "updated"); err != nil {
+			return err
+		}
+
+		err := tx.Model(&entity.PosPurchaseOrder{}).Where("purchase_order_id = ?", posPurchaseOrder.PurchaseOrderID).UpdateColumns(map[string]interface{}{
+			"supplier_id": posPurchaseOrder.SupplierID,
+			"store_id":    posPurchaseOrder.StoreID,
+			"note":        posPurchaseOrder.Note,
+			"updated_at":  posPurchaseOrder.UpdatedAt,
+			"updated_by":  posPurchaseOrder.UpdatedBy,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Where("purchase_order_id = ?", posPurchaseOrder.PurchaseOrderID).Delete(&entity.PosPurchaseOrderItem{}).Error; err != nil {
+			return err
+		}
+
+		for i := range items {
+			if err := tx.Create(&items[i]).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *posPurchaseOrderRepository) SubmitPosPurchaseOrder(purchaseOrderID string, userID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockPurchaseOrder(tx, purchaseOrderID, []string{entity.PurchaseOrderStatusDraft}, entity.PurchaseOrderStatusSubmitted); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		return tx.Model(&entity.PosPurchaseOrder{}).Where("purchase_order_id = ?", purchaseOrderID).UpdateColumns(map[string]interface{}{
+			"status":       entity.PurchaseOrderStatusSubmitted,
+			"submitted_at": now,
+			"submitted_by": userID,
+			"updated_at":   now,
+			"updated_by":   userID,
+		}).Error
+	})
+}
+
+// ReceivePosPurchaseOrder posts a purchase receipt for every received line at the order store.
+// Each receipt opens a cost layer at the received unit cost and moves the product cost price to
+// the new average cost, see applyCostMovement. The order is received once every line is fully
+// delivered, partially received otherwise.
+func (r *posPurchaseOrderRepository) ReceivePosPurchaseOrder(purchaseOrderID string, lines []PosPurchaseOrderReceiptLine, note string, userID uuid.UUID) error {
+	var posProducts []entity.PosProduct
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		posPurchaseOrder, err := lockPurchaseOrder(tx, purchaseOrderID, []string{entity.PurchaseOrderStatusSubmitted, entity.PurchaseOrderStatusPartiallyReceived}, entity.PurchaseOrderStatusReceived)
+		if err != nil {
+			return err
+		}
+
+		var items []entity.PosPurchaseOrderItem
+		if err := tx.Where("purchase_order_id = ?", purchaseOrderID).Find(&items).Error; err != nil {
+			return err
+		}
+
+		itemsByID := make(map[uuid.UUID]*entity.PosPurchaseOrderItem, len(items))
+		for i := range items {
+			itemsByID[items[i].PurchaseOrderItemID] = &items[i]
+		}
+
+		if note == "" {
+			note = posPurchaseOrder.Note
+		}
+
+		now := time.Now()
+		for _, line := range lines {
+			item, ok := itemsByID[line.PurchaseOrderItemID]
+			if !ok {
+				return fmt.Errorf("purchase order item %s is not part of the purchase order", line.PurchaseOrderItemID)
+			}
+
+			// Receive a quantity given in another unit in the base unit, at the unit cost per base unit
+			if line.Unit != "" {
+				quantity, factor, err := convertToBaseUnit(tx, item.ProductID, line.Unit, line.UnitQuantity)
+				if err != nil {
+					return err
+				}
+				if quantity <= 0 {
+					return fmt.Errorf("purchase order item %s must receive a positive quantity", line.PurchaseOrderItemID)
+				}
+				line.Quantity = quantity
+				line.UnitCost = line.UnitCost / factor
+			}
+
+			if item.ReceivedQuantity+line.Quantity > item.Quantity {
+				return fmt.Errorf("purchase order item %s can not receive more than the ordered quantity", line.PurchaseOrderItemID)
+			}
+
+			unitCost := item.UnitCost
+			if line.UnitCost > 0 {
+				unitCost = line.UnitCost
+			}
+
+			posProduct, err := applyInventoryMovement(tx, &entity.PosInventoryHistory{
+				InventoryID:     uuid.New(),
+				ProductID:       item.ProductID,
+				StoreID:         &posPurchaseOrder.StoreID,
+				Date:            now,
+				Quantity:        line.Quantity,
+				MovementType:    entity.MovementTypePurchaseReceipt,
+				Note:            note,
+				ReferenceNo:     posPurchaseOrder.PurchaseOrderID.String(),
+				LotCode:         line.LotCode,
+				ExpiryDate:      line.ExpiryDate,
+				UnitCost:        unitCost,
+				SerialNumbers:   line.SerialNumbers,
+				Unit:            line.Unit,
+				UnitQuantity:    line.UnitQuantity,
+				PurchaseOrderID: &posPurchaseOrder.PurchaseOrderID,
+				BranchID:        &posPurchaseOrder.BranchID,
+				CompanyID:       posPurchaseOrder.CompanyID,
+				CreatedAt:       now,
+				CreatedBy:       userID,
+				UpdatedAt:       now,
+				UpdatedBy:       userID,
+			})
+			if err != nil {
+				return err
+			}
+			posProducts = append(posProducts, *posProduct)
+
+			err = tx.Model(&entity.PosPurchaseOrderItem{}).Where("purchase_order_item_id = ?", item.PurchaseOrderItemID).UpdateColumns(map[string]interface{}{
+				"received_quantity": gorm.Expr("received_quantity + ?", line.Quantity),
+			}).Error
+			if err != nil {
+				return err
+			}
+			item.ReceivedQuantity += line.Quantity
+		}
+
+		updates := map[string]interface{}{
+			"status":      entity.PurchaseOrderStatusReceived,
+			"received_at": now,
+			"updated_at":  now,
+			"updated_by":  userID,
+		}
+		for _, item := range items {
+			if item.ReceivedQuantity < item.Quantity {
+				updates["status"] = entity.PurchaseOrderStatusPartiallyReceived
+				delete(updates, "received_at")
+				break
+			}
+		}
+
+		return tx.Model(&entity.PosPurchaseOrder{}).Where("purchase_order_id = ?", purchaseOrderID).UpdateColumns(updates).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	// Invalidate the cached products only after the transaction is committed
+	invalidateProductCache(r.redis, posProducts...)
+
+	return nil
+}
+
+// CancelPosPurchaseOrder cancels an order that did not receive any delivery yet
+func (r *posPurchaseOrderRepository) CancelPosPurchaseOrder(purchaseOrderID string, userID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockPurchaseOrder(tx, purchaseOrderID, []string{entity.PurchaseOrderStatusDraft, entity.PurchaseOrderStatusSubmitted}, entity.PurchaseOrderStatusCancelled); err != nil {
+			return err
+		}
+
+		return tx.Model(&entity.PosPurchaseOrder{}).Where("purchase_order_id = ?", purchaseOrderID).UpdateColumns(map[string]interface{}{
+			"status":     entity.PurchaseOrderStatusCancelled,
+			"updated_at": time.Now(),
+			"updated_by": userID,
+		}).Error
+	})
+}
+
+func (r *posPurchaseOrderRepository) ReadAllPosPurchaseOrders(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, status string, supplierID string) (*dto.PaginationResult, error) {
+	var posPurchaseOrders []entity.PosPurchaseOrder
+	var totalRecords int64
+
+	query := r.db.Model(&entity.PosPurchaseOrder{})
+
+	companyRole := os.Getenv("COMPANY_USER_ROLE")
+	branchRole := os.Getenv("BRANCH_USER_ROLE")
+	storeRole := os.Getenv("STORE_USER_ROLE")
+
+	switch roleName {
+	case companyRole:
+		query = query.Where("company_id = ?", jwtPayload.CompanyId)
+	case branchRole:
+		query = query.Where("branch_id = ?", jwtPayload.BranchId)
+	case storeRole:
+		query = query.Where("store_id = ?", jwtPayload.StoreId)
+	default:
+		return nil, errors.New("invalid role")
+	}
+
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	if supplierID != "" {
+		query = query.Where("supplier_id = ?", supplierID)
+	}
+
+	if err := query.Count(&totalRecords).Error; err != nil {
+		return nil, err
+	}
+
+	if pagination.Limit > 0 && pagination.Page > 0 {
+		offset := (pagination.Page - 1) * pagination.Limit
+		query = query.Offset(offset).Limit(pagination.Limit)
+	}
+
+	if err := query.Order("created_at desc").Find(&posPurchaseOrders).Error; err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalRecords) / float64(pagination.Limit)))
+
+	// Load the items of every purchase order on the page
+	purchaseOrderIDs := make([]uuid.UUID, len(posPurchaseOrders))
+	for i, posPurchaseOrder := range posPurchaseOrders {
+		purchaseOrderIDs[i] = posPurchaseOrder.PurchaseOrderID
+	}
+
+	var items []entity.PosPurchaseOrderItem
+	if len(purchaseOrderIDs) > 0 {
+		if err := r.db.Where("purchase_order_id IN (?)", purchaseOrderIDs).Find(&items).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	itemsByPurchaseOrder := make(map[uuid.UUID][]entity.PosPurchaseOrderItem)
+	for _, item := range items {
+		itemsByPurchaseOrder[item.PurchaseOrderID] = append(itemsByPurchaseOrder[item.PurchaseOrderID], item)
+	}
+
+	pbPosPurchaseOrders := make([]*pb.PosPurchaseOrder, len(posPurchaseOrders))
+	for i, posPurchaseOrder := range posPurchaseOrders {
+		pbPosPurchaseOrders[i] = toPbPosPurchaseOrder(posPurchaseOrder, itemsByPurchaseOrder[posPurchaseOrder.PurchaseOrderID])
+	}
+
+	return &dto.PaginationResult{
+		TotalRecords: totalRecords,
+		Records:      pbPosPurchaseOrders,
+		CurrentPage:  pagination.Page,
+		TotalPages:   totalPages,
+	}, nil
+}
+
+// ReadReplenishmentCandidates returns the low stock products of a branch, or of one store when
+// storeID is set, that have a supplier within the branch
+func (r *posPurchaseOrderRepository) ReadReplenishmentCandidates(branchID string, storeID string) ([]PosReplenishmentCandidate, error) {
+	var candidates []PosReplenishmentCandidate
+
+	// Draft orders are counted too, so generating twice does not order the same quantity again
+	openStatuses := []string{entity.PurchaseOrderStatusDraft, entity.PurchaseOrderStatusSubmitted, entity.PurchaseOrderStatusPartiallyReceived}
+
+	query := r.db.Table("pos_stock_levels sl").
+		Select(`sl.product_id, sl.store_id, sl.branch_id, sl.company_id, p.supplier_id, p.cost_price, sl.quantity, sl.reorder_level, sl.max_level,
+			COALESCE((SELECT SUM(i.quantity - i.received_quantity) FROM pos_purchase_order_items i
+				JOIN pos_purchase_orders po ON po.purchase_order_id = i.purchase_order_id
+				WHERE po.store_id = sl.store_id AND i.product_id = sl.product_id AND po.status IN (?)), 0) AS open_quantity`, openStatuses).
+		Joins("JOIN pos_products p ON p.product_id = sl.product_id").
+		Joins("JOIN pos_suppliers s ON s.supplier_id = p.supplier_id AND s.branch_id = sl.branch_id").
+		Where(lowStockCondition).
+		Where("p.active = ?", true).
+		Where("sl.branch_id = ?", branchID)
+
+	if storeID != "" {
+		query = query.Where("sl.store_id = ?", storeID)
+	}
+
+	if err := query.Order("sl.store_id, p.supplier_id").Scan(&candidates).Error; err != nil {
+		return nil, err
+	}
+
+	return candidates, nil
+}
+
+// CreatePosPurchaseOrders creates several purchase orders in one transaction
+func (r *posPurchaseOrderRepository) CreatePosPurchaseOrders(posPurchaseOrders []entity.PosPurchaseOrder, items []entity.PosPurchaseOrderItem) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for i := range posPurchaseOrders {
+			if err := tx.Create(&posPurchaseOrders[i]).Error; err != nil {
+				return err
+			}
+		}
+
+		for i := range items {
+			if err := tx.Create(&items[i]).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// lockPurchaseOrder locks the purchase order row until commit and checks its current status
+func lockPurchaseOrder(tx *gorm.DB, purchaseOrderID string, allowedStatuses []string, nextStatus string) (*entity.PosPurchaseOrder, error) {
+	var posPurchaseOrder entity.PosPurchaseOrder
+	if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("purchase_order_id = ?", purchaseOrderID).First(&posPurchaseOrder).Error; err != nil {
+		return nil, err
+	}
+
+	if !containsStatus(allowedStatuses, posPurchaseOrder.Status) {
+		return nil, fmt.Errorf("purchase order with status %s can not be %s", posPurchaseOrder.Status, nextStatus)
+	}
+
+	return &posPurchaseOrder, nil
+}
+
+func toPbPosPurchaseOrder(posPurchaseOrder entity.PosPurchaseOrder, items []entity.PosPurchaseOrderItem) *pb.PosPurchaseOrder {
+	pbItems := make([]*pb.PosPurchaseOrderItem, len(items))
+	for i, item := range items {
+		pbItems[i] = &pb.PosPurchaseOrderItem{
+			PurchaseOrderItemId: item.PurchaseOrderItemID.String(),
+			PurchaseOrderId:     item.PurchaseOrderID.String(),
+			ProductId:           item.ProductID.String(),
+			Quantity:            int32(item.Quantity),
+			ReceivedQuantity:    int32(item.ReceivedQuantity),
+			UnitCost:            item.UnitCost,
+		}
+	}
+
+	pbPosPurchaseOrder := &pb.PosPurchaseOrder{
+		PurchaseOrderId: posPurchaseOrder.PurchaseOrderID.String(),
+		SupplierId:      posPurchaseOrder.SupplierID.String(),
+		StoreId:         posPurchaseOrder.StoreID.String(),
+		BranchId:        posPurchaseOrder.BranchID.String(),
+		Status:          posPurchaseOrder.Status,
+		Note:            posPurchaseOrder.Note,
+		Items:           pbItems,
+		SubmittedBy:     utils.UUIDString(posPurchaseOrder.SubmittedBy),
+		CompanyId:       posPurchaseOrder.CompanyID.String(),
+		CreatedAt:       timestamppb.New(posPurchaseOrder.CreatedAt),
+		CreatedBy:       posPurchaseOrder.CreatedBy.String(),
+		UpdatedAt:       timestamppb.New(posPurchaseOrder.UpdatedAt),
+		UpdatedBy:       posPurchaseOrder.UpdatedBy.String(),
+	}
+
+	if posPurchaseOrder.SubmittedAt != nil {
+		pbPosPurchaseOrder.SubmittedAt = timestamppb.New(*posPurchaseOrder.SubmittedAt)
+	}
+	if posPurchaseOrder.ReceivedAt != nil {
+		pbPosPurchaseOrder.ReceivedAt = timestamppb.New(*posPurchaseOrder.ReceivedAt)
+	}
+
+	return pbPosPurchaseOrder
+}
@@ -0,0 +1,282 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+)
+
+type PosInventoryReconciliationRepository interface {
+	ReconcilePosInventory(companyID string, branchID string, storeID string, productID string, apply bool, userID uuid.UUID) ([]*pb.PosInventoryDiscrepancy, error)
+}
+
+type posInventoryReconciliationRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+func NewPosInventoryReconciliationRepository(db *gorm.DB, redis *redis.Client) PosInventoryReconciliationRepository {
+	return &posInventoryReconciliationRepository{
+		db:    db,
+		redis: redis,
+	}
+}
+
+// inventoryDiscrepancy is a store or product whose recorded stock differs from its ledger
+type inventoryDiscrepancy struct {
+	ProductID      uuid.UUID
+	StoreID        *uuid.UUID
+	BranchID       *uuid.UUID
+	CompanyID      uuid.UUID
+	LedgerQuantity int64
+	StockQuantity  int64
+}
+
+// ReconcilePosInventory compares the ledger with the stock levels of every store, and with the
+// stock quantity of every product when the whole company is reconciled. Empty IDs do not filter,
+// so the scheduled run covers all companies. With apply the store stock level is taken as the
+// truth: a reconciliation entry posts the difference to the ledger without moving any stock, and
+// the product stock quantity is reset to the sum of its store stock levels. Cost layers are left
+// as they are.
+func (r *posInventoryReconciliationRepository) ReconcilePosInventory(companyID string, branchID string, storeID string, productID string, apply bool, userID uuid.UUID) ([]*pb.PosInventoryDiscrepancy, error) {
+	var storeDiscrepancies []inventoryDiscrepancy
+	var productDiscrepancies []inventoryDiscrepancy
+
+	conditions := []string{"COALESCE(l.quantity, 0) <> COALESCE(sl.quantity, 0)"}
+	var values []interface{}
+	if companyID != "" {
+		conditions = append(conditions, "COALESCE(sl.company_id, l.company_id) = ?")
+		values = append(values, companyID)
+	}
+	if branchID != "" {
+		conditions = append(conditions, "COALESCE(sl.branch_id, l.branch_id) = ?")
+		values = append(values, branchID)
+	}
+	if storeID != "" {
+		conditions = append(conditions, "COALESCE(sl.store_id, l.store_id) = ?")
+		values = append(values, storeID)
+	}
+	if productID != "" {
+		conditions = append(conditions, "COALESCE(sl.product_id, l.product_id) = ?")
+		values = append(values, productID)
+	}
+
+	err := r.db.Raw(`SELECT COALESCE(sl.product_id, l.product_id) AS product_id, COALESCE(sl.store_id, l.store_id) AS store_id,
+			COALESCE(sl.branch_id, l.branch_id) AS branch_id, COALESCE(sl.company_id, l.company_id) AS company_id,
+			COALESCE(l.quantity, 0) AS ledger_quantity, COALESCE(sl.quantity, 0) AS stock_quantity
+		FROM pos_stock_levels sl
+		FULL OUTER JOIN (
+			SELECT product_id, store_id, (array_agg(branch_id))[1] AS branch_id, company_id, SUM(quantity) AS quantity
+			FROM pos_inventory_histories
+			WHERE store_id IS NOT NULL
+			GROUP BY product_id, store_id, company_id
+		) l ON l.product_id = sl.product_id AND l.store_id = sl.store_id
+		WHERE `+strings.Join(conditions, " AND ")+`
+		ORDER BY 4, 1, 2`, values...).Scan(&storeDiscrepancies).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// The product stock quantity spans every store, it is only reconciled over the whole company
+	if branchID == "" && storeID == "" {
+		conditions = []string{"COALESCE(l.quantity, 0) <> p.stock_quantity"}
+		values = nil
+		if companyID != "" {
+			conditions = append(conditions, "p.company_id = ?")
+			values = append(values, companyID)
+		}
+		if productID != "" {
+			conditions = append(conditions, "p.product_id = ?")
+			values = append(values, productID)
+		}
+
+		err := r.db.Raw(`SELECT p.product_id, p.branch_id, p.company_id, COALESCE(l.quantity, 0) AS ledger_quantity, p.stock_quantity AS stock_quantity
+			FROM pos_products p
+			LEFT JOIN (
+				SELECT product_id, SUM(quantity) AS quantity FROM pos_inventory_histories GROUP BY product_id
+			) l ON l.product_id = p.product_id
+			WHERE `+strings.Join(conditions, " AND ")+`
+			ORDER BY p.company_id, p.product_id`, values...).Scan(&productDiscrepancies).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pbStoreDiscrepancies := make([]*pb.PosInventoryDiscrepancy, len(storeDiscrepancies))
+	for i, discrepancy := range storeDiscrepancies {
+		pbStoreDiscrepancies[i] = toPbPosInventoryDiscrepancy(discrepancy)
+	}
+	pbProductDiscrepancies := make([]*pb.PosInventoryDiscrepancy, len(productDiscrepancies))
+	for i, discrepancy := range productDiscrepancies {
+		pbProductDiscrepancies[i] = toPbPosInventoryDiscrepancy(discrepancy)
+	}
+
+	if apply {
+		// Correct one product at a time, each in its own transaction
+		productIDs := make([]uuid.UUID, 0)
+		seen := make(map[uuid.UUID]bool)
+		for _, discrepancy := range append(append([]inventoryDiscrepancy{}, storeDiscrepancies...), productDiscrepancies...) {
+			if !seen[discrepancy.ProductID] {
+				seen[discrepancy.ProductID] = true
+				productIDs = append(productIDs, discrepancy.ProductID)
+			}
+		}
+
+		for _, id := range productIDs {
+			if err := r.correctProduct(id, storeDiscrepancies, pbStoreDiscrepancies, productDiscrepancies, pbProductDiscrepancies, userID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return append(pbStoreDiscrepancies, pbProductDiscrepancies...), nil
+}
+
+// correctProduct posts the reconciliation entries of one product and resets its stock quantity,
+// recomputing every difference under lock so movements made since the report are not undone
+func (r *posInventoryReconciliationRepository) correctProduct(productID uuid.UUID, storeDiscrepancies []inventoryDiscrepancy, pbStoreDiscrepancies []*pb.PosInventoryDiscrepancy,
+	productDiscrepancies []inventoryDiscrepancy, pbProductDiscrepancies []*pb.PosInventoryDiscrepancy, userID uuid.UUID) error {
+	var posProduct entity.PosProduct
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Lock the product row first, like every inventory movement
+		if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("product_id = ?", productID).First(&posProduct).Error; err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for i, discrepancy := range storeDiscrepancies {
+			if discrepancy.ProductID != productID {
+				continue
+			}
+
+			var posStockLevel entity.PosStockLevel
+			err := tx.Set("gorm:query_option", "FOR UPDATE").Where("product_id = ? AND store_id = ?", productID, *discrepancy.StoreID).First(&posStockLevel).Error
+			if err != nil && !gorm.IsRecordNotFoundError(err) {
+				return err
+			}
+
+			ledgerQuantity, err := readLedgerQuantity(tx, productID, discrepancy.StoreID)
+			if err != nil {
+				return err
+			}
+
+			difference := posStockLevel.Quantity - ledgerQuantity
+			if difference == 0 {
+				continue
+			}
+
+			branchID := discrepancy.BranchID
+			if posStockLevel.BranchID != uuid.Nil {
+				branchID = &posStockLevel.BranchID
+			}
+
+			note := fmt.Sprintf("reconciliation of the ledger (%d) with the store stock level (%d)", ledgerQuantity, posStockLevel.Quantity)
+			if userID == entity.SystemUserID {
+				note = "scheduled " + note
+			}
+
+			reconciliation := &entity.PosInventoryHistory{
+				InventoryID:  uuid.New(),
+				ProductID:    productID,
+				StoreID:      discrepancy.StoreID,
+				Date:         now,
+				Quantity:     difference,
+				MovementType: entity.MovementTypeReconciliation,
+				Note:         note,
+				UnitCost:     posProduct.CostPrice,
+				CostAmount:   roundCost(float64(difference) * posProduct.CostPrice),
+				BranchID:     branchID,
+				CompanyID:    discrepancy.CompanyID,
+				CreatedAt:    now,
+				CreatedBy:    userID,
+				UpdatedAt:    now,
+				UpdatedBy:    userID,
+			}
+			if err := tx.Create(reconciliation).Error; err != nil {
+				return err
+			}
+
+			pbStoreDiscrepancies[i].Corrected = true
+			pbStoreDiscrepancies[i].InventoryId = reconciliation.InventoryID.String()
+		}
+
+		// The product stock quantity is kept as the sum of its store stock levels
+		var stockTotal struct {
+			Quantity int
+		}
+		err := tx.Model(&entity.PosStockLevel{}).Select("COALESCE(SUM(quantity), 0) AS quantity").Where("product_id = ?", productID).Scan(&stockTotal).Error
+		if err != nil {
+			return err
+		}
+
+		if stockTotal.Quantity != posProduct.StockQuantity {
+			err := tx.Model(&entity.PosProduct{}).Where("product_id = ?", productID).UpdateColumns(map[string]interface{}{
+				"stock_quantity": stockTotal.Quantity,
+				"updated_at":     now,
+				"updated_by":     userID,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		for i, discrepancy := range productDiscrepancies {
+			if discrepancy.ProductID == productID {
+				pbProductDiscrepancies[i].Corrected = true
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Invalidate the cached product only after the transaction is committed
+	invalidateProductCache(r.redis, posProduct)
+
+	return nil
+}
+
+// readLedgerQuantity returns the sum of the ledger entries of a product in a store
+func readLedgerQuantity(tx *gorm.DB, productID uuid.UUID, storeID *uuid.UUID) (int, error) {
+	var ledger struct {
+		Quantity int
+	}
+
+	err := tx.Model(&entity.PosInventoryHistory{}).
+		Select("COALESCE(SUM(quantity), 0) AS quantity").
+		Where("product_id = ? AND store_id = ?", productID, *storeID).
+		Scan(&ledger).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return ledger.Quantity, nil
+}
+
+func toPbPosInventoryDiscrepancy(discrepancy inventoryDiscrepancy) *pb.PosInventoryDiscrepancy {
+	pbDiscrepancy := &pb.PosInventoryDiscrepancy{
+		ProductId:      discrepancy.ProductID.String(),
+		CompanyId:      discrepancy.CompanyID.String(),
+		LedgerQuantity: discrepancy.LedgerQuantity,
+		StockQuantity:  discrepancy.StockQuantity,
+		Difference:     discrepancy.StockQuantity - discrepancy.LedgerQuantity,
+	}
+	if discrepancy.StoreID != nil {
+		pbDiscrepancy.StoreId = discrepancy.StoreID.String()
+	}
+	if discrepancy.BranchID != nil {
+		pbDiscrepancy.BranchId = discrepancy.BranchID.String()
+	}
+
+	return pbDiscrepancy
+}
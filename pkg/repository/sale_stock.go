@@ -0,0 +1,355 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+)
+
+type PosSaleStockRepository interface {
+	DeductStockForSale(saleID uuid.UUID, lines []*pb.PosSaleStockLine, partial bool, movement entity.PosInventoryHistory) ([]*pb.PosSaleStockLineResult, error)
+	ReturnStockForSale(saleID uuid.UUID, lines []*pb.PosSaleStockLine, movement entity.PosInventoryHistory) ([]*pb.PosSaleStockLineResult, error)
+}
+
+type posSaleStockRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+func NewPosSaleStockRepository(db *gorm.DB, redis *redis.Client) PosSaleStockRepository {
+	return &posSaleStockRepository{
+		db:    db,
+		redis: redis,
+	}
+}
+
+// DeductStockForSale applies the sale movements of every basket line in one transaction, the given
+// movement carries the store, branch, company, reference and user shared by the lines. Unless
+// partial, the first line that can not be deducted fails the whole basket; with partial the line
+// is rolled back to its savepoint and rejected while the other lines are deducted.
+func (r *posSaleStockRepository) DeductStockForSale(saleID uuid.UUID, lines []*pb.PosSaleStockLine, partial bool, movement entity.PosInventoryHistory) ([]*pb.PosSaleStockLineResult, error) {
+	results := make([]*pb.PosSaleStockLineResult, len(lines))
+	var posProducts []entity.PosProduct
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockSale(tx, saleID); err != nil {
+			return err
+		}
+
+		var saleCount int
+		if err := tx.Model(&entity.PosInventoryHistory{}).Where("sale_id = ? AND movement_type = ?", saleID, entity.MovementTypeSale).Count(&saleCount).Error; err != nil {
+			return err
+		}
+		if saleCount > 0 {
+			return errors.New("error deduct stock for sale, the stock of the sale is already deducted")
+		}
+
+		lineProducts := make([]*entity.PosProduct, len(lines))
+		lineQuantities := make([]int, len(lines))
+		for i, line := range lines {
+			results[i] = &pb.PosSaleStockLineResult{
+				LineIndex: int32(i),
+				ProductId: line.ProductId,
+				Quantity:  line.Quantity,
+			}
+
+			posProduct, quantity, err := resolveSaleLineProduct(tx, movement.CompanyID, line)
+			if err != nil {
+				if !partial {
+					return fmt.Errorf("error deduct stock for sale, line %d: %v", i+1, err)
+				}
+				results[i].Error = err.Error()
+				continue
+			}
+
+			results[i].ProductId = posProduct.ProductID.String()
+			results[i].Quantity = int32(quantity)
+			lineProducts[i] = posProduct
+			lineQuantities[i] = quantity
+		}
+
+		for _, i := range saleLinesInProductOrder(lineProducts) {
+			line := lines[i]
+
+			posInventoryHistory := movement
+			posInventoryHistory.InventoryID = uuid.New()
+			posInventoryHistory.ProductID = lineProducts[i].ProductID
+			posInventoryHistory.Quantity = -lineQuantities[i]
+			posInventoryHistory.MovementType = entity.MovementTypeSale
+			posInventoryHistory.LotCode = line.LotCode
+			posInventoryHistory.SerialNumbers = line.SerialNumbers
+			posInventoryHistory.SaleID = &saleID
+
+			if partial {
+				if err := tx.Exec("SAVEPOINT sale_line").Error; err != nil {
+					return err
+				}
+			}
+
+			posProduct, err := applyInventoryMovement(tx, &posInventoryHistory)
+			if err != nil {
+				if !partial {
+					return fmt.Errorf("error deduct stock for sale, line %d: %v", i+1, err)
+				}
+
+				// Undo what the line wrote before it failed, the transaction stays usable
+				if err := tx.Exec("ROLLBACK TO SAVEPOINT sale_line").Error; err != nil {
+					return err
+				}
+				results[i].Error = err.Error()
+				continue
+			}
+
+			if partial {
+				if err := tx.Exec("RELEASE SAVEPOINT sale_line").Error; err != nil {
+					return err
+				}
+			}
+
+			results[i].Accepted = true
+			results[i].Warning = posInventoryHistory.NegativeStockWarning
+			results[i].PosInventoryHistory = toPbPosInventoryHistory(posInventoryHistory)
+			posProducts = append(posProducts, *posProduct)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate the cached products only after the transaction is committed
+	invalidateProductCache(r.redis, posProducts...)
+
+	return results, nil
+}
+
+// ReturnStockForSale restocks the given lines of a sale into the store they were sold from, at the
+// cost they left with, in one transaction. A product can be returned up to the quantity sold less
+// what was already returned, and serial numbers only if they were sold in the sale. The given
+// movement carries the branch and store the user is limited to, if any.
+func (r *posSaleStockRepository) ReturnStockForSale(saleID uuid.UUID, lines []*pb.PosSaleStockLine, movement entity.PosInventoryHistory) ([]*pb.PosSaleStockLineResult, error) {
+	results := make([]*pb.PosSaleStockLineResult, len(lines))
+	var posProducts []entity.PosProduct
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockSale(tx, saleID); err != nil {
+			return err
+		}
+
+		var saleEntries []entity.PosInventoryHistory
+		if err := tx.Where("sale_id = ? AND company_id = ?", saleID, movement.CompanyID).Order("created_at").Find(&saleEntries).Error; err != nil {
+			return err
+		}
+
+		// What is left to return per product, and the sale entry of the product for its store and cost
+		remaining := make(map[uuid.UUID]int)
+		soldEntries := make(map[uuid.UUID]entity.PosInventoryHistory)
+		soldQuantity := make(map[uuid.UUID]int)
+		soldCost := make(map[uuid.UUID]float64)
+		for _, saleEntry := range saleEntries {
+			remaining[saleEntry.ProductID] -= saleEntry.Quantity
+			if saleEntry.MovementType == entity.MovementTypeSale {
+				soldEntries[saleEntry.ProductID] = saleEntry
+				soldQuantity[saleEntry.ProductID] -= saleEntry.Quantity
+				soldCost[saleEntry.ProductID] -= saleEntry.CostAmount
+			}
+		}
+
+		if len(soldEntries) == 0 {
+			return errors.New("error return stock for sale, the sale is not found")
+		}
+
+		for _, saleEntry := range soldEntries {
+			if movement.BranchID != nil && (saleEntry.BranchID == nil || *saleEntry.BranchID != *movement.BranchID) {
+				return errors.New("error return stock for sale, the sale is not within the branch of the user")
+			}
+			if movement.StoreID != nil && (saleEntry.StoreID == nil || *saleEntry.StoreID != *movement.StoreID) {
+				return errors.New("error return stock for sale, the sale is not within the store of the user")
+			}
+		}
+
+		lineProducts := make([]*entity.PosProduct, len(lines))
+		lineQuantities := make([]int, len(lines))
+		for i, line := range lines {
+			posProduct, quantity, err := resolveSaleLineProduct(tx, movement.CompanyID, line)
+			if err != nil {
+				return fmt.Errorf("error return stock for sale, line %d: %v", i+1, err)
+			}
+
+			if _, ok := soldEntries[posProduct.ProductID]; !ok {
+				return fmt.Errorf("error return stock for sale, line %d: the product is not sold in the sale", i+1)
+			}
+
+			if quantity > remaining[posProduct.ProductID] {
+				return fmt.Errorf("error return stock for sale, line %d: only %d left to return", i+1, remaining[posProduct.ProductID])
+			}
+			remaining[posProduct.ProductID] -= quantity
+
+			if len(line.SerialNumbers) > 0 {
+				soldSerialNumbers, err := readSaleSerialNumbers(tx, saleID, posProduct.ProductID)
+				if err != nil {
+					return err
+				}
+				if err := validateReturnSerialNumbers(line.SerialNumbers, soldSerialNumbers); err != nil {
+					return fmt.Errorf("error return stock for sale, line %d: %v", i+1, err)
+				}
+			}
+
+			lineProducts[i] = posProduct
+			lineQuantities[i] = quantity
+		}
+
+		for _, i := range saleLinesInProductOrder(lineProducts) {
+			line := lines[i]
+			soldEntry := soldEntries[lineProducts[i].ProductID]
+
+			posInventoryHistory := movement
+			posInventoryHistory.InventoryID = uuid.New()
+			posInventoryHistory.ProductID = soldEntry.ProductID
+			posInventoryHistory.StoreID = soldEntry.StoreID
+			posInventoryHistory.BranchID = soldEntry.BranchID
+			posInventoryHistory.Quantity = lineQuantities[i]
+			posInventoryHistory.MovementType = entity.MovementTypeReturn
+			posInventoryHistory.LotCode = line.LotCode
+			posInventoryHistory.SerialNumbers = line.SerialNumbers
+			posInventoryHistory.SaleID = &saleID
+
+			// Put the stock back at the average cost it left with
+			if soldQuantity[soldEntry.ProductID] > 0 {
+				posInventoryHistory.UnitCost = soldCost[soldEntry.ProductID] / float64(soldQuantity[soldEntry.ProductID])
+			}
+
+			posProduct, err := applyInventoryMovement(tx, &posInventoryHistory)
+			if err != nil {
+				return fmt.Errorf("error return stock for sale, line %d: %v", i+1, err)
+			}
+
+			results[i] = &pb.PosSaleStockLineResult{
+				LineIndex:           int32(i),
+				ProductId:           posInventoryHistory.ProductID.String(),
+				Quantity:            int32(lineQuantities[i]),
+				Accepted:            true,
+				PosInventoryHistory: toPbPosInventoryHistory(posInventoryHistory),
+			}
+			posProducts = append(posProducts, *posProduct)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate the cached products only after the transaction is committed
+	invalidateProductCache(r.redis, posProducts...)
+
+	return results, nil
+}
+
+// lockSale serializes the deductions and returns of a sale until the transaction ends
+func lockSale(tx *gorm.DB, saleID uuid.UUID) error {
+	return tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", saleID.String()).Error
+}
+
+// resolveSaleLineProduct returns the product of a basket line within the company, by product ID
+// or else by barcode, with the line quantity in base units. A line scanned by a case barcode takes
+// the units in the case, and a weight label its weight.
+func resolveSaleLineProduct(tx *gorm.DB, companyID uuid.UUID, line *pb.PosSaleStockLine) (*entity.PosProduct, int, error) {
+	if line.Quantity <= 0 {
+		return nil, 0, errors.New("quantity must be positive")
+	}
+
+	productID := line.ProductId
+	quantity := int(line.Quantity)
+	switch {
+	case productID != "":
+		if _, err := uuid.Parse(productID); err != nil {
+			return nil, 0, fmt.Errorf("%s is not a valid product id", productID)
+		}
+	case line.Barcode != "":
+		barcode, err := utils.NormalizeBarcode(line.Barcode)
+		if err != nil {
+			return nil, 0, err
+		}
+		posProductBarcode, _, barcodeQuantity, err := resolveProductBarcode(tx, companyID, barcode)
+		if err != nil {
+			if gorm.IsRecordNotFoundError(err) {
+				return nil, 0, errors.New("product not found")
+			}
+			return nil, 0, err
+		}
+		productID = posProductBarcode.ProductID.String()
+		quantity *= barcodeQuantity
+	default:
+		return nil, 0, errors.New("product id or barcode could not be empty")
+	}
+
+	var posProduct entity.PosProduct
+	if err := tx.Where("company_id = ? AND product_id = ?", companyID, productID).First(&posProduct).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, 0, errors.New("product not found")
+		}
+		return nil, 0, err
+	}
+
+	return &posProduct, quantity, nil
+}
+
+// saleLinesInProductOrder returns the indexes of the resolved lines ordered by product, so
+// concurrent baskets lock the products in the same order
+func saleLinesInProductOrder(lineProducts []*entity.PosProduct) []int {
+	indexes := make([]int, 0, len(lineProducts))
+	for i, posProduct := range lineProducts {
+		if posProduct != nil {
+			indexes = append(indexes, i)
+		}
+	}
+
+	sort.SliceStable(indexes, func(a, b int) bool {
+		return lineProducts[indexes[a]].ProductID.String() < lineProducts[indexes[b]].ProductID.String()
+	})
+
+	return indexes
+}
+
+// readSaleSerialNumbers returns the serial numbers of a product that left the store in a sale
+func readSaleSerialNumbers(tx *gorm.DB, saleID uuid.UUID, productID uuid.UUID) ([]string, error) {
+	var serialNumbers []string
+
+	err := tx.Table("pos_inventory_history_serials hs").
+		Joins("JOIN pos_inventory_histories h ON h.inventory_id = hs.inventory_id").
+		Joins("JOIN pos_serial_numbers s ON s.serial_id = hs.serial_id").
+		Where("h.sale_id = ? AND h.product_id = ? AND h.movement_type = ?", saleID, productID, entity.MovementTypeSale).
+		Order("s.serial_number").
+		Pluck("s.serial_number", &serialNumbers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return serialNumbers, nil
+}
+
+// validateReturnSerialNumbers checks that every returned serial number was sold
+func validateReturnSerialNumbers(serialNumbers []string, soldSerialNumbers []string) error {
+	sold := make(map[string]bool, len(soldSerialNumbers))
+	for _, serialNumber := range soldSerialNumbers {
+		sold[serialNumber] = true
+	}
+
+	for _, serialNumber := range serialNumbers {
+		if !sold[serialNumber] {
+			return fmt.Errorf("serial number %s is not sold in the sale", serialNumber)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,290 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosSerialNumberRepository interface {
+	ReadPosSerialNumber(companyID string, serialNumber string, productID string) (*pb.PosSerialNumber, error)
+	ReadPosSerialNumberHistory(serialID string) ([]*pb.PosInventoryHistory, error)
+}
+
+type posSerialNumberRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+func NewPosSerialNumberRepository(db *gorm.DB, redis *redis.Client) PosSerialNumberRepository {
+	return &posSerialNumberRepository{
+		db:    db,
+		redis: redis,
+	}
+}
+
+// ReadPosSerialNumber finds a serial number within the company, the product is required only when
+// several products share the serial number
+func (r *posSerialNumberRepository) ReadPosSerialNumber(companyID string, serialNumber string, productID string) (*pb.PosSerialNumber, error) {
+	var posSerialNumbers []entity.PosSerialNumber
+
+	query := r.db.Where("company_id = ? AND serial_number = ?", companyID, serialNumber)
+	if productID != "" {
+		query = query.Where("product_id = ?", productID)
+	}
+
+	if err := query.Find(&posSerialNumbers).Error; err != nil {
+		return nil, err
+	}
+
+	if len(posSerialNumbers) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	if len(posSerialNumbers) > 1 {
+		return nil, errors.New("serial number is used by several products, product id is required")
+	}
+
+	return toPbPosSerialNumber(posSerialNumbers[0]), nil
+}
+
+// ReadPosSerialNumberHistory returns every inventory movement of the serial number, oldest first
+func (r *posSerialNumberRepository) ReadPosSerialNumberHistory(serialID string) ([]*pb.PosInventoryHistory, error) {
+	var posInventoryHistories []entity.PosInventoryHistory
+
+	err := r.db.Table("pos_inventory_histories h").
+		Select("h.*").
+		Joins("JOIN pos_inventory_history_serials hs ON hs.inventory_id = h.inventory_id").
+		Where("hs.serial_id = ?", serialID).
+		Order("h.date asc, h.created_at asc").
+		Scan(&posInventoryHistories).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if err := loadSerialNumbers(r.db, posInventoryHistories); err != nil {
+		return nil, err
+	}
+
+	pbPosInventoryHistories := make([]*pb.PosInventoryHistory, len(posInventoryHistories))
+	for i, posInventoryHistory := range posInventoryHistories {
+		pbPosInventoryHistories[i] = toPbPosInventoryHistory(posInventoryHistory)
+	}
+
+	return pbPosInventoryHistories, nil
+}
+
+// applySerialMovement books an inventory movement on the serial numbers of a serialized product.
+// Every unit moved must be listed: increases put the serials into the movement store, a return
+// only takes back a sold serial and a transfer in only takes a serial that is in transit.
+// Decreases take serials that are in the movement store and mark them as sold, transferred or
+// removed. Reversals move the serials of the reversed movement when none are given. It must run
+// inside the transaction of applyInventoryMovement, after the ledger entry is inserted.
+func applySerialMovement(tx *gorm.DB, posProduct *entity.PosProduct, posInventoryHistory *entity.PosInventoryHistory) error {
+	if !posProduct.Serialized {
+		if len(posInventoryHistory.SerialNumbers) > 0 {
+			return fmt.Errorf("error inventory movement, product %s is not serialized", posProduct.ProductID)
+		}
+		return nil
+	}
+
+	if len(posInventoryHistory.SerialNumbers) == 0 && posInventoryHistory.ReversalOfID != nil {
+		serialNumbers, err := readMovementSerialNumbers(tx, *posInventoryHistory.ReversalOfID)
+		if err != nil {
+			return err
+		}
+		posInventoryHistory.SerialNumbers = serialNumbers
+	}
+
+	quantity := posInventoryHistory.Quantity
+	if quantity < 0 {
+		quantity = -quantity
+	}
+
+	if len(posInventoryHistory.SerialNumbers) != quantity {
+		return fmt.Errorf("error inventory movement, product %s is serialized and needs %d serial numbers, got %d", posProduct.ProductID, quantity, len(posInventoryHistory.SerialNumbers))
+	}
+
+	seen := make(map[string]bool, len(posInventoryHistory.SerialNumbers))
+	for _, serialNumber := range posInventoryHistory.SerialNumbers {
+		if serialNumber == "" {
+			return errors.New("error inventory movement, serial number could not be empty")
+		}
+		if seen[serialNumber] {
+			return fmt.Errorf("error inventory movement, serial number %s is listed more than once", serialNumber)
+		}
+		seen[serialNumber] = true
+
+		var posSerialNumber entity.PosSerialNumber
+		err := tx.Set("gorm:query_option", "FOR UPDATE").Where("product_id = ? AND serial_number = ?", posInventoryHistory.ProductID, serialNumber).First(&posSerialNumber).Error
+		exists := err == nil
+		if err != nil && !gorm.IsRecordNotFoundError(err) {
+			return err
+		}
+
+		inStock := exists && (posSerialNumber.Status == entity.SerialStatusInStock || posSerialNumber.Status == entity.SerialStatusReturned)
+
+		var status string
+		if posInventoryHistory.Quantity > 0 {
+			if inStock {
+				return fmt.Errorf("error inventory movement, serial number %s is already in stock", serialNumber)
+			}
+
+			switch posInventoryHistory.MovementType {
+			case entity.MovementTypeReturn:
+				if !exists || posSerialNumber.Status != entity.SerialStatusSold {
+					return fmt.Errorf("error inventory movement, serial number %s was not sold", serialNumber)
+				}
+				status = entity.SerialStatusReturned
+			case entity.MovementTypeTransferIn:
+				if !exists || posSerialNumber.Status != entity.SerialStatusTransferred {
+					return fmt.Errorf("error inventory movement, serial number %s is not in transit", serialNumber)
+				}
+				status = entity.SerialStatusInStock
+			default:
+				status = entity.SerialStatusInStock
+			}
+		} else {
+			if !inStock || posSerialNumber.StoreID != *posInventoryHistory.StoreID {
+				return fmt.Errorf("error inventory movement, serial number %s is not in stock in the store", serialNumber)
+			}
+
+			switch posInventoryHistory.MovementType {
+			case entity.MovementTypeSale:
+				status = entity.SerialStatusSold
+			case entity.MovementTypeTransferOut:
+				status = entity.SerialStatusTransferred
+			default:
+				status = entity.SerialStatusRemoved
+			}
+		}
+
+		if exists {
+			err = tx.Model(&entity.PosSerialNumber{}).Where("serial_id = ?", posSerialNumber.SerialID).UpdateColumns(map[string]interface{}{
+				"store_id":   *posInventoryHistory.StoreID,
+				"status":     status,
+				"branch_id":  *posInventoryHistory.BranchID,
+				"updated_at": posInventoryHistory.UpdatedAt,
+			}).Error
+		} else {
+			posSerialNumber = entity.PosSerialNumber{
+				SerialID:     uuid.New(),
+				ProductID:    posInventoryHistory.ProductID,
+				SerialNumber: serialNumber,
+				StoreID:      *posInventoryHistory.StoreID,
+				Status:       status,
+				BranchID:     *posInventoryHistory.BranchID,
+				CompanyID:    posInventoryHistory.CompanyID,
+				CreatedAt:    posInventoryHistory.CreatedAt,
+				UpdatedAt:    posInventoryHistory.UpdatedAt,
+			}
+			err = tx.Create(&posSerialNumber).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create(&entity.PosInventoryHistorySerial{
+			InventoryID: posInventoryHistory.InventoryID,
+			SerialID:    posSerialNumber.SerialID,
+		}).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readMovementSerialNumbers returns the serial numbers moved by an inventory movement
+func readMovementSerialNumbers(db *gorm.DB, inventoryID uuid.UUID) ([]string, error) {
+	var serialNumbers []string
+
+	err := db.Table("pos_inventory_history_serials hs").
+		Joins("JOIN pos_serial_numbers s ON s.serial_id = hs.serial_id").
+		Where("hs.inventory_id = ?", inventoryID).
+		Order("s.serial_number").
+		Pluck("s.serial_number", &serialNumbers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return serialNumbers, nil
+}
+
+// readTransferOutSerialNumbers returns the serial numbers that left the source store when a
+// transfer item was dispatched
+func readTransferOutSerialNumbers(tx *gorm.DB, transferID uuid.UUID, productID uuid.UUID) ([]string, error) {
+	var serialNumbers []string
+
+	err := tx.Table("pos_inventory_history_serials hs").
+		Joins("JOIN pos_inventory_histories h ON h.inventory_id = hs.inventory_id").
+		Joins("JOIN pos_serial_numbers s ON s.serial_id = hs.serial_id").
+		Where("h.transfer_id = ? AND h.product_id = ? AND h.movement_type = ?", transferID, productID, entity.MovementTypeTransferOut).
+		Order("s.serial_number").
+		Pluck("s.serial_number", &serialNumbers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return serialNumbers, nil
+}
+
+// loadSerialNumbers fills the serial numbers of the given inventory movements
+func loadSerialNumbers(db *gorm.DB, posInventoryHistories []entity.PosInventoryHistory) error {
+	if len(posInventoryHistories) == 0 {
+		return nil
+	}
+
+	inventoryIDs := make([]uuid.UUID, len(posInventoryHistories))
+	for i, posInventoryHistory := range posInventoryHistories {
+		inventoryIDs[i] = posInventoryHistory.InventoryID
+	}
+
+	var movementSerials []struct {
+		InventoryID  uuid.UUID
+		SerialNumber string
+	}
+
+	err := db.Table("pos_inventory_history_serials hs").
+		Select("hs.inventory_id, s.serial_number").
+		Joins("JOIN pos_serial_numbers s ON s.serial_id = hs.serial_id").
+		Where("hs.inventory_id IN (?)", inventoryIDs).
+		Order("s.serial_number").
+		Scan(&movementSerials).Error
+	if err != nil {
+		return err
+	}
+
+	serialNumbersByInventory := make(map[uuid.UUID][]string)
+	for _, movementSerial := range movementSerials {
+		serialNumbersByInventory[movementSerial.InventoryID] = append(serialNumbersByInventory[movementSerial.InventoryID], movementSerial.SerialNumber)
+	}
+
+	for i := range posInventoryHistories {
+		posInventoryHistories[i].SerialNumbers = serialNumbersByInventory[posInventoryHistories[i].InventoryID]
+	}
+
+	return nil
+}
+
+func toPbPosSerialNumber(posSerialNumber entity.PosSerialNumber) *pb.PosSerialNumber {
+	return &pb.PosSerialNumber{
+		SerialId:     posSerialNumber.SerialID.String(),
+		ProductId:    posSerialNumber.ProductID.String(),
+		SerialNumber: posSerialNumber.SerialNumber,
+		StoreId:      posSerialNumber.StoreID.String(),
+		Status:       posSerialNumber.Status,
+		BranchId:     posSerialNumber.BranchID.String(),
+		CompanyId:    posSerialNumber.CompanyID.String(),
+		CreatedAt:    timestamppb.New(posSerialNumber.CreatedAt),
+		UpdatedAt:    timestamppb.New(posSerialNumber.UpdatedAt),
+	}
+}
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"errors"
+	"math"
+	"os"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosStockLevelRepository interface {
+	ReadPosStockLevel(productID string, storeID string) (*pb.PosStockLevel, error)
+	ReadPosStockLevelsByProducts(storeID string, productIDs []string) (map[string]entity.PosStockLevel, error)
+	UpdatePosStockLevel(posStockLevel *entity.PosStockLevel) error
+	ReadAllPosStockLevels(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, productID string) (*dto.PaginationResult, error)
+}
+
+type posStockLevelRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+func NewPosStockLevelRepository(db *gorm.DB, redis *redis.Client) PosStockLevelRepository {
+	return &posStockLevelRepository{
+		db:    db,
+		redis: redis,
+	}
+}
+
+// ReadPosStockLevel returns the stock level of a product in a store, a store that never had any
+// movement of the product gets an empty stock level instead of an error
+func (r *posStockLevelRepository) ReadPosStockLevel(productID string, storeID string) (*pb.PosStockLevel, error) {
+	// Stock levels change with every movement, so they are always read from PostgreSQL
+	var posStockLevelEntity entity.PosStockLevel
+	err := r.db.Where("product_id = ? AND store_id = ?", productID, storeID).First(&posStockLevelEntity).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return &pb.PosStockLevel{
+			ProductId: productID,
+			StoreId:   storeID,
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return toPbPosStockLevel(posStockLevelEntity), nil
+}
+
+// ReadPosStockLevelsByProducts returns the stock levels of a store keyed by product ID
+func (r *posStockLevelRepository) ReadPosStockLevelsByProducts(storeID string, productIDs []string) (map[string]entity.PosStockLevel, error) {
+	posStockLevels := make(map[string]entity.PosStockLevel)
+	if len(productIDs) == 0 {
+		return posStockLevels, nil
+	}
+
+	var posStockLevelEntities []entity.PosStockLevel
+	if err := r.db.Where("store_id = ? AND product_id IN (?)", storeID, productIDs).Find(&posStockLevelEntities).Error; err != nil {
+		return nil, err
+	}
+
+	for _, posStockLevel := range posStockLevelEntities {
+		posStockLevels[posStockLevel.ProductID.String()] = posStockLevel
+	}
+
+	return posStockLevels, nil
+}
+
+// UpdatePosStockLevel sets the reorder and max level of a store, the quantity is only ever
+// changed through inventory movements
+func (r *posStockLevelRepository) UpdatePosStockLevel(posStockLevel *entity.PosStockLevel) error {
+	return r.db.Exec(`INSERT INTO pos_stock_levels (product_id, store_id, quantity, reorder_level, max_level, branch_id, company_id, created_at, created_by, updated_at, updated_by)
+		VALUES (?, ?, 0, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (product_id, store_id) DO UPDATE SET reorder_level = EXCLUDED.reorder_level, max_level = EXCLUDED.max_level, updated_at = EXCLUDED.updated_at, updated_by = EXCLUDED.updated_by`,
+		posStockLevel.ProductID, posStockLevel.StoreID, posStockLevel.ReorderLevel, posStockLevel.MaxLevel, posStockLevel.BranchID, posStockLevel.CompanyID,
+		posStockLevel.CreatedAt, posStockLevel.CreatedBy, posStockLevel.UpdatedAt, posStockLevel.UpdatedBy).Error
+}
+
+func (r *posStockLevelRepository) ReadAllPosStockLevels(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, productID string) (*dto.PaginationResult, error) {
+	var posStockLevels []entity.PosStockLevel
+	var totalRecords int64
+
+	query := r.db.Model(&entity.PosStockLevel{})
+
+	companyRole := os.Getenv("COMPANY_USER_ROLE")
+	branchRole := os.Getenv("BRANCH_USER_ROLE")
+	storeRole := os.Getenv("STORE_USER_ROLE")
+
+	switch roleName {
+	case companyRole:
+		query = query.Where("company_id = ?", jwtPayload.CompanyId)
+	case branchRole:
+		query = query.Where("branch_id = ?", jwtPayload.BranchId)
+	case storeRole:
+		query = query.Where("store_id = ?", jwtPayload.StoreId)
+	default:
+		return nil, errors.New("invalid role")
+	}
+
+	if productID != "" {
+		query = query.Where("product_id = ?", productID)
+	}
+
+	if err := query.Count(&totalRecords).Error; err != nil {
+		return nil, err
+	}
+
+	if pagination.Limit > 0 && pagination.Page > 0 {
+		offset := (pagination.Page - 1) * pagination.Limit
+		query = query.Offset(offset).Limit(pagination.Limit)
+	}
+
+	if err := query.Find(&posStockLevels).Error; err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalRecords) / float64(pagination.Limit)))
+
+	return &dto.PaginationResult{
+		TotalRecords: totalRecords,
+		Records:      posStockLevels,
+		CurrentPage:  pagination.Page,
+		TotalPages:   totalPages,
+	}, nil
+}
+
+func toPbPosStockLevel(posStockLevel entity.PosStockLevel) *pb.PosStockLevel {
+	return &pb.PosStockLevel{
+		ProductId:    posStockLevel.ProductID.String(),
+		StoreId:      posStockLevel.StoreID.String(),
+		Quantity:     int32(posStockLevel.Quantity),
+		ReorderLevel: int32(posStockLevel.ReorderLevel),
+		MaxLevel:     int32(posStockLevel.MaxLevel),
+		BranchId:     posStockLevel.BranchID.String(),
+		CompanyId:    posStockLevel.CompanyID.String(),
+		CreatedAt:    timestamppb.New(posStockLevel.CreatedAt),
+		CreatedBy:    posStockLevel.CreatedBy.String(),
+		UpdatedAt:    timestamppb.New(posStockLevel.UpdatedAt),
+		UpdatedBy:    posStockLevel.UpdatedBy.String(),
+	}
+}
@@ -0,0 +1,282 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosStockLotRepository interface {
+	ReadAllPosStockLots(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, productID string, storeID string, includeEmpty bool) (*dto.PaginationResult, error)
+	ListExpiringPosStockLots(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, expiresBefore time.Time, storeID string) (*dto.PaginationResult, error)
+}
+
+type posStockLotRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+func NewPosStockLotRepository(db *gorm.DB, redis *redis.Client) PosStockLotRepository {
+	return &posStockLotRepository{
+		db:    db,
+		redis: redis,
+	}
+}
+
+func (r *posStockLotRepository) ReadAllPosStockLots(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, productID string, storeID string, includeEmpty bool) (*dto.PaginationResult, error) {
+	query, err := r.scopedStockLotQuery(roleName, jwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if productID != "" {
+		query = query.Where("product_id = ?", productID)
+	}
+
+	if storeID != "" {
+		query = query.Where("store_id = ?", storeID)
+	}
+
+	if !includeEmpty {
+		query = query.Where("quantity > 0")
+	}
+
+	return r.paginateStockLots(query, pagination)
+}
+
+// ListExpiringPosStockLots returns the lots with stock left that expire before the given time,
+// lots that are already expired included
+func (r *posStockLotRepository) ListExpiringPosStockLots(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload, expiresBefore time.Time, storeID string) (*dto.PaginationResult, error) {
+	query, err := r.scopedStockLotQuery(roleName, jwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	query = query.Where("quantity > 0 AND expiry_date IS NOT NULL AND expiry_date <= ?", expiresBefore)
+
+	if storeID != "" {
+		query = query.Where("store_id = ?", storeID)
+	}
+
+	return r.paginateStockLots(query, pagination)
+}
+
+func (r *posStockLotRepository) scopedStockLotQuery(roleName string, jwtPayload *pb.JWTPayload) (*gorm.DB, error) {
+	query := r.db.Model(&entity.PosStockLot{})
+
+	companyRole := os.Getenv("COMPANY_USER_ROLE")
+	branchRole := os.Getenv("BRANCH_USER_ROLE")
+	storeRole := os.Getenv("STORE_USER_ROLE")
+
+	switch roleName {
+	case companyRole:
+		query = query.Where("company_id = ?", jwtPayload.CompanyId)
+	case branchRole:
+		query = query.Where("branch_id = ?", jwtPayload.BranchId)
+	case storeRole:
+		query = query.Where("store_id = ?", jwtPayload.StoreId)
+	default:
+		return nil, errors.New("invalid role")
+	}
+
+	return query, nil
+}
+
+func (r *posStockLotRepository) paginateStockLots(query *gorm.DB, pagination dto.Pagination) (*dto.PaginationResult, error) {
+	var posStockLots []entity.PosStockLot
+	var totalRecords int64
+
+	if pagination.Limit > 0 && pagination.Page > 0 {
+		offset := (pagination.Page - 1) * pagination.Limit
+		query = query.Offset(offset).Limit(pagination.Limit)
+	}
+
+	query.Order("expiry_date asc nulls last, created_at asc").Find(&posStockLots)
+	query.Count(&totalRecords)
+
+	totalPages := int(math.Ceil(float64(totalRecords) / float64(pagination.Limit)))
+
+	pbPosStockLots := make([]*pb.PosStockLot, len(posStockLots))
+	for i, posStockLot := range posStockLots {
+		pbPosStockLots[i] = toPbPosStockLot(posStockLot)
+	}
+
+	return &dto.PaginationResult{
+		TotalRecords: totalRecords,
+		Records:      pbPosStockLots,
+		CurrentPage:  pagination.Page,
+		TotalPages:   totalPages,
+	}, nil
+}
+
+// applyLotMovement books an inventory movement on the lots of its store. Receipts with a lot code
+// are added to that lot. Decrements take from the chosen lot, or from the lots that expire first
+// (FEFO) when no lot code is given; whatever is not covered by lots comes from untracked stock.
+// Reversals of a decrement put the quantity back into the lots it was taken from.
+// It must run inside the transaction of applyInventoryMovement, which already holds the lock on
+// the stock level of the product and store.
+func applyLotMovement(tx *gorm.DB, posInventoryHistory *entity.PosInventoryHistory) error {
+	if posInventoryHistory.Quantity > 0 && posInventoryHistory.ReversalOfID != nil {
+		return restoreReversedLots(tx, posInventoryHistory)
+	}
+
+	if posInventoryHistory.Quantity > 0 {
+		if posInventoryHistory.LotCode == "" {
+			return nil
+		}
+
+		err := tx.Exec(`INSERT INTO pos_stock_lots (lot_id, product_id, store_id, lot_code, expiry_date, quantity, branch_id, company_id, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (product_id, store_id, lot_code) DO UPDATE SET quantity = pos_stock_lots.quantity + EXCLUDED.quantity,
+			expiry_date = COALESCE(EXCLUDED.expiry_date, pos_stock_lots.expiry_date), updated_at = EXCLUDED.updated_at`,
+			uuid.New(), posInventoryHistory.ProductID, *posInventoryHistory.StoreID, posInventoryHistory.LotCode, posInventoryHistory.ExpiryDate,
+			posInventoryHistory.Quantity, *posInventoryHistory.BranchID, posInventoryHistory.CompanyID, posInventoryHistory.CreatedAt, posInventoryHistory.UpdatedAt).Error
+		if err != nil {
+			return err
+		}
+
+		var posStockLot entity.PosStockLot
+		if err := tx.Where("product_id = ? AND store_id = ? AND lot_code = ?", posInventoryHistory.ProductID, *posInventoryHistory.StoreID, posInventoryHistory.LotCode).First(&posStockLot).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&entity.PosInventoryHistoryLot{
+			InventoryID: posInventoryHistory.InventoryID,
+			LotID:       posStockLot.LotID,
+			Quantity:    posInventoryHistory.Quantity,
+		}).Error
+	}
+
+	var posStockLots []entity.PosStockLot
+	query := tx.Where("product_id = ? AND store_id = ? AND quantity > 0", posInventoryHistory.ProductID, *posInventoryHistory.StoreID)
+	if posInventoryHistory.LotCode != "" {
+		query = query.Where("lot_code = ?", posInventoryHistory.LotCode)
+	}
+	if err := query.Order("expiry_date asc nulls last, created_at asc").Find(&posStockLots).Error; err != nil {
+		return err
+	}
+
+	remaining := -posInventoryHistory.Quantity
+	for _, posStockLot := range posStockLots {
+		if remaining == 0 {
+			break
+		}
+
+		taken := posStockLot.Quantity
+		if taken > remaining {
+			taken = remaining
+		}
+
+		err := tx.Model(&entity.PosStockLot{}).Where("lot_id = ?", posStockLot.LotID).UpdateColumns(map[string]interface{}{
+			"quantity":   gorm.Expr("quantity - ?", taken),
+			"updated_at": posInventoryHistory.UpdatedAt,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create(&entity.PosInventoryHistoryLot{
+			InventoryID: posInventoryHistory.InventoryID,
+			LotID:       posStockLot.LotID,
+			Quantity:    -taken,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		remaining -= taken
+	}
+
+	if posInventoryHistory.LotCode != "" && remaining > 0 {
+		return fmt.Errorf("error cant decrease stock quantity, lot %s does not have enough stock quantity", posInventoryHistory.LotCode)
+	}
+
+	return nil
+}
+
+// restoreReversedLots adds the quantities taken by the reversed movement back to their lots
+func restoreReversedLots(tx *gorm.DB, posInventoryHistory *entity.PosInventoryHistory) error {
+	var reversedLots []entity.PosInventoryHistoryLot
+	if err := tx.Where("inventory_id = ?", *posInventoryHistory.ReversalOfID).Find(&reversedLots).Error; err != nil {
+		return err
+	}
+
+	for _, reversedLot := range reversedLots {
+		err := tx.Model(&entity.PosStockLot{}).Where("lot_id = ?", reversedLot.LotID).UpdateColumns(map[string]interface{}{
+			"quantity":   gorm.Expr("quantity + ?", -reversedLot.Quantity),
+			"updated_at": posInventoryHistory.UpdatedAt,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create(&entity.PosInventoryHistoryLot{
+			InventoryID: posInventoryHistory.InventoryID,
+			LotID:       reversedLot.LotID,
+			Quantity:    -reversedLot.Quantity,
+		}).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// posMovementLot is the quantity of one lot taken by an inventory movement
+type posMovementLot struct {
+	LotCode    string
+	ExpiryDate *time.Time
+	Quantity   int
+}
+
+// readTransferOutLots returns the lots taken from the source store when a transfer item was
+// dispatched, so the same lots can be put into the destination store
+func readTransferOutLots(tx *gorm.DB, transferID uuid.UUID, productID uuid.UUID) ([]posMovementLot, error) {
+	var posMovementLots []posMovementLot
+
+	err := tx.Table("pos_inventory_history_lots hl").
+		Select("l.lot_code, l.expiry_date, -SUM(hl.quantity) AS quantity").
+		Joins("JOIN pos_inventory_histories h ON h.inventory_id = hl.inventory_id").
+		Joins("JOIN pos_stock_lots l ON l.lot_id = hl.lot_id").
+		Where("h.transfer_id = ? AND h.product_id = ? AND h.movement_type = ?", transferID, productID, entity.MovementTypeTransferOut).
+		Group("l.lot_code, l.expiry_date").
+		Order("l.expiry_date asc nulls last").
+		Scan(&posMovementLots).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return posMovementLots, nil
+}
+
+func toPbPosStockLot(posStockLot entity.PosStockLot) *pb.PosStockLot {
+	pbPosStockLot := &pb.PosStockLot{
+		LotId:     posStockLot.LotID.String(),
+		ProductId: posStockLot.ProductID.String(),
+		StoreId:   posStockLot.StoreID.String(),
+		LotCode:   posStockLot.LotCode,
+		Quantity:  int32(posStockLot.Quantity),
+		BranchId:  posStockLot.BranchID.String(),
+		CompanyId: posStockLot.CompanyID.String(),
+		CreatedAt: timestamppb.New(posStockLot.CreatedAt),
+		UpdatedAt: timestamppb.New(posStockLot.UpdatedAt),
+	}
+
+	if posStockLot.ExpiryDate != nil {
+		pbPosStockLot.ExpiryDate = timestamppb.New(*posStockLot.ExpiryDate)
+	}
+
+	return pbPosStockLot
+}
@@ -0,0 +1,242 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// activeReservationCondition matches the reservations still holding stock, a reservation past
+// its expiry stops holding stock even before the expiry job marks it as expired
+const activeReservationCondition = "status = 'active' AND expires_at > ?"
+
+type PosStockReservationRepository interface {
+	ReservePosStock(posStockReservation *entity.PosStockReservation) error
+	ReadPosStockReservation(reservationID string) (*pb.PosStockReservation, error)
+	CommitPosStockReservation(reservationID string, note string, serialNumbers []string, userID uuid.UUID) error
+	ReleasePosStockReservation(reservationID string, userID uuid.UUID) error
+	ReadReservedQuantities(storeID string, productIDs []string) (map[string]int, error)
+	ExpirePosStockReservations() (int64, error)
+}
+
+type posStockReservationRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+func NewPosStockReservationRepository(db *gorm.DB, redis *redis.Client) PosStockReservationRepository {
+	return &posStockReservationRepository{
+		db:    db,
+		redis: redis,
+	}
+}
+
+// ReservePosStock holds the quantity when the store has enough stock that is not held yet. The
+// stock level row is locked so concurrent reservations and sales of the product are serialized.
+func (r *posStockReservationRepository) ReservePosStock(posStockReservation *entity.PosStockReservation) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var posStockLevel entity.PosStockLevel
+		err := tx.Set("gorm:query_option", "FOR UPDATE").Where("product_id = ? AND store_id = ?", posStockReservation.ProductID, posStockReservation.StoreID).First(&posStockLevel).Error
+		if gorm.IsRecordNotFoundError(err) {
+			return errors.New("error reserve stock, the product has no stock in the store")
+		} else if err != nil {
+			return err
+		}
+
+		reserved, err := readReservedQuantity(tx, posStockReservation.ProductID, posStockReservation.StoreID)
+		if err != nil {
+			return err
+		}
+
+		if posStockLevel.Quantity-reserved < posStockReservation.Quantity {
+			return fmt.Errorf("error reserve stock, only %d available in the store", posStockLevel.Quantity-reserved)
+		}
+
+		return tx.Create(posStockReservation).Error
+	})
+}
+
+func (r *posStockReservationRepository) ReadPosStockReservation(reservationID string) (*pb.PosStockReservation, error) {
+	var posStockReservation entity.PosStockReservation
+	if err := r.db.Where("reservation_id = ?", reservationID).First(&posStockReservation).Error; err != nil {
+		return nil, err
+	}
+
+	return toPbPosStockReservation(posStockReservation), nil
+}
+
+// CommitPosStockReservation posts the held quantity as a sale and closes the reservation in one
+// transaction, serialized products list the serial numbers sold
+func (r *posStockReservationRepository) CommitPosStockReservation(reservationID string, note string, serialNumbers []string, userID uuid.UUID) error {
+	var posProduct *entity.PosProduct
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		posStockReservation, err := lockActiveReservation(tx, reservationID)
+		if err != nil {
+			return err
+		}
+
+		// Close the reservation first so its own hold does not block the sale
+		now := time.Now()
+		inventoryID := uuid.New()
+		err = tx.Model(&entity.PosStockReservation{}).Where("reservation_id = ?", reservationID).UpdateColumns(map[string]interface{}{
+			"status":       entity.ReservationStatusCommitted,
+			"inventory_id": inventoryID,
+			"updated_at":   now,
+			"updated_by":   userID,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		posProduct, err = applyInventoryMovement(tx, &entity.PosInventoryHistory{
+			InventoryID:   inventoryID,
+			ProductID:     posStockReservation.ProductID,
+			StoreID:       &posStockReservation.StoreID,
+			Date:          now,
+			Quantity:      -posStockReservation.Quantity,
+			MovementType:  entity.MovementTypeSale,
+			Note:          note,
+			ReferenceNo:   posStockReservation.ReferenceNo,
+			SerialNumbers: serialNumbers,
+			BranchID:      &posStockReservation.BranchID,
+			CompanyID:     posStockReservation.CompanyID,
+			CreatedAt:     now,
+			CreatedBy:     userID,
+			UpdatedAt:     now,
+			UpdatedBy:     userID,
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// Invalidate the cached product only after the transaction is committed
+	invalidateProductCache(r.redis, *posProduct)
+
+	return nil
+}
+
+func (r *posStockReservationRepository) ReleasePosStockReservation(reservationID string, userID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockActiveReservation(tx, reservationID); err != nil {
+			return err
+		}
+
+		return tx.Model(&entity.PosStockReservation{}).Where("reservation_id = ?", reservationID).UpdateColumns(map[string]interface{}{
+			"status":     entity.ReservationStatusReleased,
+			"updated_at": time.Now(),
+			"updated_by": userID,
+		}).Error
+	})
+}
+
+// ReadReservedQuantities returns the quantity held by active reservations keyed by product ID,
+// over all stores when no store is given
+func (r *posStockReservationRepository) ReadReservedQuantities(storeID string, productIDs []string) (map[string]int, error) {
+	reservedQuantities := make(map[string]int)
+	if len(productIDs) == 0 {
+		return reservedQuantities, nil
+	}
+
+	var reservations []struct {
+		ProductID uuid.UUID
+		Quantity  int
+	}
+
+	query := r.db.Model(&entity.PosStockReservation{}).
+		Select("product_id, SUM(quantity) AS quantity").
+		Where(activeReservationCondition, time.Now()).
+		Where("product_id IN (?)", productIDs)
+
+	if storeID != "" {
+		query = query.Where("store_id = ?", storeID)
+	}
+
+	if err := query.Group("product_id").Scan(&reservations).Error; err != nil {
+		return nil, err
+	}
+
+	for _, reservation := range reservations {
+		reservedQuantities[reservation.ProductID.String()] = reservation.Quantity
+	}
+
+	return reservedQuantities, nil
+}
+
+// ExpirePosStockReservations marks the active reservations past their expiry as expired
+func (r *posStockReservationRepository) ExpirePosStockReservations() (int64, error) {
+	now := time.Now()
+	result := r.db.Model(&entity.PosStockReservation{}).
+		Where("status = ? AND expires_at <= ?", entity.ReservationStatusActive, now).
+		UpdateColumns(map[string]interface{}{
+			"status":     entity.ReservationStatusExpired,
+			"updated_at": now,
+		})
+
+	return result.RowsAffected, result.Error
+}
+
+// readReservedQuantity returns the quantity of a product held by active reservations in a store
+func readReservedQuantity(tx *gorm.DB, productID uuid.UUID, storeID uuid.UUID) (int, error) {
+	var reserved struct {
+		Quantity int
+	}
+
+	err := tx.Model(&entity.PosStockReservation{}).
+		Select("COALESCE(SUM(quantity), 0) AS quantity").
+		Where(activeReservationCondition, time.Now()).
+		Where("product_id = ? AND store_id = ?", productID, storeID).
+		Scan(&reserved).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return reserved.Quantity, nil
+}
+
+func lockActiveReservation(tx *gorm.DB, reservationID string) (*entity.PosStockReservation, error) {
+	var posStockReservation entity.PosStockReservation
+	if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("reservation_id = ?", reservationID).First(&posStockReservation).Error; err != nil {
+		return nil, err
+	}
+
+	if posStockReservation.Status != entity.ReservationStatusActive {
+		return nil, fmt.Errorf("stock reservation with status %s is no longer active", posStockReservation.Status)
+	}
+
+	if !posStockReservation.ExpiresAt.After(time.Now()) {
+		return nil, errors.New("stock reservation is expired")
+	}
+
+	return &posStockReservation, nil
+}
+
+func toPbPosStockReservation(posStockReservation entity.PosStockReservation) *pb.PosStockReservation {
+	return &pb.PosStockReservation{
+		ReservationId: posStockReservation.ReservationID.String(),
+		ProductId:     posStockReservation.ProductID.String(),
+		StoreId:       posStockReservation.StoreID.String(),
+		Quantity:      int32(posStockReservation.Quantity),
+		Status:        posStockReservation.Status,
+		ReferenceNo:   posStockReservation.ReferenceNo,
+		ExpiresAt:     timestamppb.New(posStockReservation.ExpiresAt),
+		InventoryId:   utils.UUIDString(posStockReservation.InventoryID),
+		BranchId:      posStockReservation.BranchID.String(),
+		CompanyId:     posStockReservation.CompanyID.String(),
+		CreatedAt:     timestamppb.New(posStockReservation.CreatedAt),
+		CreatedBy:     posStockReservation.CreatedBy.String(),
+		UpdatedAt:     timestamppb.New(posStockReservation.UpdatedAt),
+		UpdatedBy:     posStockReservation.UpdatedBy.String(),
+	}
+}
@@ -0,0 +1,330 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosStockTakeRepository interface {
+	CreatePosStockTake(posStockTake *entity.PosStockTake, productIDs []uuid.UUID) error
+	ReadPosStockTake(stockTakeID string) (*pb.PosStockTake, error)
+	SubmitPosStockTakeCounts(stockTakeID string, counts map[uuid.UUID]int, accumulate bool, userID uuid.UUID) error
+	ApprovePosStockTake(stockTakeID string, userID uuid.UUID) error
+	CancelPosStockTake(stockTakeID string, userID uuid.UUID) error
+	ReadAllPosStockTakes(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error)
+}
+
+type posStockTakeRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+func NewPosStockTakeRepository(db *gorm.DB, redis *redis.Client) PosStockTakeRepository {
+	return &posStockTakeRepository{
+		db:    db,
+		redis: redis,
+	}
+}
+
+// CreatePosStockTake creates the count session together with a snapshot of the expected
+// quantities of the store. Explicit product IDs are only used by the products scope.
+func (r *posStockTakeRepository) CreatePosStockTake(posStockTake *entity.PosStockTake, productIDs []uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var snapshots []struct {
+			ProductID        uuid.UUID
+			ExpectedQuantity int
+		}
+
+		query := tx.Table("pos_products p").
+			Select("p.product_id, COALESCE(sl.quantity, 0) AS expected_quantity").
+			Joins("LEFT JOIN pos_stock_levels sl ON sl.product_id = p.product_id AND sl.store_id = ?", posStockTake.StoreID).
+			Where("p.company_id = ?", posStockTake.CompanyID)
+
+		switch posStockTake.Scope {
+		case entity.StockTakeScopeStore:
+			query = query.Where("p.branch_id = ? OR sl.product_id IS NOT NULL", posStockTake.BranchID)
+		case entity.StockTakeScopeCategory:
+			query = query.Where("p.branch_id = ? OR sl.product_id IS NOT NULL", posStockTake.BranchID).Where("p.category_id = ?", posStockTake.CategoryID)
+		case entity.StockTakeScopeProducts:
+			query = query.Where("p.product_id IN (?)", productIDs)
+		default:
+			return fmt.Errorf("invalid stock take scope %q", posStockTake.Scope)
+		}
+
+		if err := query.Scan(&snapshots).Error; err != nil {
+			return err
+		}
+
+		if len(snapshots) == 0 {
+			return errors.New("error created stock take, there is no product to count")
+		}
+
+		if posStockTake.Scope == entity.StockTakeScopeProducts && len(snapshots) != len(productIDs) {
+			return errors.New("error created stock take, some products are not found within the company")
+		}
+
+		if err := tx.Create(posStockTake).Error; err != nil {
+			return err
+		}
+
+		for _, snapshot := range snapshots {
+			item := entity.PosStockTakeItem{
+				StockTakeItemID:  uuid.New(),
+				StockTakeID:      posStockTake.StockTakeID,
+				ProductID:        snapshot.ProductID,
+				ExpectedQuantity: snapshot.ExpectedQuantity,
+			}
+			if err := tx.Create(&item).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *posStockTakeRepository) ReadPosStockTake(stockTakeID string) (*pb.PosStockTake, error) {
+	var posStockTake entity.PosStockTake
+	if err := r.db.Where("stock_take_id = ?", stockTakeID).First(&posStockTake).Error; err != nil {
+		return nil, err
+	}
+
+	var items []entity.PosStockTakeItem
+	if err := r.db.Where("stock_take_id = ?", stockTakeID).Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	return toPbPosStockTake(posStockTake, items), nil
+}
+
+// SubmitPosStockTakeCounts records counted quantities, either replacing the previous count or
+// adding to it when the same product is scanned more than once
+func (r *posStockTakeRepository) SubmitPosStockTakeCounts(stockTakeID string, counts map[uuid.UUID]int, accumulate bool, userID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockOpenStockTake(tx, stockTakeID); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for productID, countedQuantity := range counts {
+			var counted interface{} = countedQuantity
+			if accumulate {
+				counted = gorm.Expr("COALESCE(counted_quantity, 0) + ?", countedQuantity)
+			}
+
+			result := tx.Model(&entity.PosStockTakeItem{}).Where("stock_take_id = ? AND product_id = ?", stockTakeID, productID).UpdateColumns(map[string]interface{}{
+				"counted_quantity": counted,
+				"counted_at":       now,
+				"counted_by":       userID,
+			})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return fmt.Errorf("product %s is not part of the stock take", productID)
+			}
+		}
+
+		return nil
+	})
+}
+
+// ApprovePosStockTake posts a count correction for every counted product whose quantity differs
+// from the snapshot, products that were never counted are left untouched. Serialized products
+// are skipped as a count does not tell which serial numbers are missing or found, their variance
+// is settled with serial adjustments through the inventory history instead.
+func (r *posStockTakeRepository) ApprovePosStockTake(stockTakeID string, userID uuid.UUID) error {
+	var posProducts []entity.PosProduct
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		posStockTake, err := lockOpenStockTake(tx, stockTakeID)
+		if err != nil {
+			return err
+		}
+
+		var items []entity.PosStockTakeItem
+		nonSerialized := tx.Model(&entity.PosProduct{}).Select("product_id").Where("serialized = ?", false).QueryExpr()
+		if err := tx.Where("stock_take_id = ? AND counted_quantity IS NOT NULL AND product_id IN (?)", stockTakeID, nonSerialized).Find(&items).Error; err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for _, item := range items {
+			variance := *item.CountedQuantity - item.ExpectedQuantity
+			if variance == 0 {
+				continue
+			}
+
+			posProduct, err := applyInventoryMovement(tx, &entity.PosInventoryHistory{
+				InventoryID:  uuid.New(),
+				ProductID:    item.ProductID,
+				StoreID:      &posStockTake.StoreID,
+				Date:         now,
+				Quantity:     variance,
+				MovementType: entity.MovementTypeCountCorrection,
+				Note:         posStockTake.Note,
+				ReferenceNo:  posStockTake.StockTakeID.String(),
+				BranchID:     &posStockTake.BranchID,
+				CompanyID:    posStockTake.CompanyID,
+				CreatedAt:    now,
+				CreatedBy:    userID,
+				UpdatedAt:    now,
+				UpdatedBy:    userID,
+			})
+			if err != nil {
+				return err
+			}
+			posProducts = append(posProducts, *posProduct)
+		}
+
+		return tx.Model(&entity.PosStockTake{}).Where("stock_take_id = ?", stockTakeID).UpdateColumns(map[string]interface{}{
+			"status":      entity.StockTakeStatusApproved,
+			"approved_at": now,
+			"approved_by": userID,
+			"updated_at":  now,
+			"updated_by":  userID,
+		}).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	// Invalidate the cached products only after the transaction is committed
+	invalidateProductCache(r.redis, posProducts...)
+
+	return nil
+}
+
+func (r *posStockTakeRepository) CancelPosStockTake(stockTakeID string, userID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockOpenStockTake(tx, stockTakeID); err != nil {
+			return err
+		}
+
+		return tx.Model(&entity.PosStockTake{}).Where("stock_take_id = ?", stockTakeID).UpdateColumns(map[string]interface{}{
+			"status":     entity.StockTakeStatusCancelled,
+			"updated_at": time.Now(),
+			"updated_by": userID,
+		}).Error
+	})
+}
+
+func (r *posStockTakeRepository) ReadAllPosStockTakes(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error) {
+	var posStockTakes []entity.PosStockTake
+	var totalRecords int64
+
+	query := r.db.Model(&entity.PosStockTake{})
+
+	companyRole := os.Getenv("COMPANY_USER_ROLE")
+	branchRole := os.Getenv("BRANCH_USER_ROLE")
+	storeRole := os.Getenv("STORE_USER_ROLE")
+
+	switch roleName {
+	case companyRole:
+		query = query.Where("company_id = ?", jwtPayload.CompanyId)
+	case branchRole:
+		query = query.Where("branch_id = ?", jwtPayload.BranchId)
+	case storeRole:
+		query = query.Where("store_id = ?", jwtPayload.StoreId)
+	default:
+		return nil, errors.New("invalid role")
+	}
+
+	if err := query.Count(&totalRecords).Error; err != nil {
+		return nil, err
+	}
+
+	if pagination.Limit > 0 && pagination.Page > 0 {
+		offset := (pagination.Page - 1) * pagination.Limit
+		query = query.Offset(offset).Limit(pagination.Limit)
+	}
+
+	if err := query.Order("created_at desc").Find(&posStockTakes).Error; err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalRecords) / float64(pagination.Limit)))
+
+	// Items are left out of the list, they are returned by ReadPosStockTake
+	pbPosStockTakes := make([]*pb.PosStockTake, len(posStockTakes))
+	for i, posStockTake := range posStockTakes {
+		pbPosStockTakes[i] = toPbPosStockTake(posStockTake, nil)
+	}
+
+	return &dto.PaginationResult{
+		TotalRecords: totalRecords,
+		Records:      pbPosStockTakes,
+		CurrentPage:  pagination.Page,
+		TotalPages:   totalPages,
+	}, nil
+}
+
+// lockOpenStockTake locks the stock take row until commit and checks it is still open
+func lockOpenStockTake(tx *gorm.DB, stockTakeID string) (*entity.PosStockTake, error) {
+	var posStockTake entity.PosStockTake
+	if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("stock_take_id = ?", stockTakeID).First(&posStockTake).Error; err != nil {
+		return nil, err
+	}
+
+	if posStockTake.Status != entity.StockTakeStatusOpen {
+		return nil, fmt.Errorf("stock take with status %s can not be changed", posStockTake.Status)
+	}
+
+	return &posStockTake, nil
+}
+
+func toPbPosStockTake(posStockTake entity.PosStockTake, items []entity.PosStockTakeItem) *pb.PosStockTake {
+	pbItems := make([]*pb.PosStockTakeItem, len(items))
+	for i, item := range items {
+		pbItems[i] = &pb.PosStockTakeItem{
+			StockTakeItemId:  item.StockTakeItemID.String(),
+			StockTakeId:      item.StockTakeID.String(),
+			ProductId:        item.ProductID.String(),
+			ExpectedQuantity: int32(item.ExpectedQuantity),
+			Counted:          item.CountedQuantity != nil,
+			CountedBy:        utils.UUIDString(item.CountedBy),
+		}
+		if item.CountedQuantity != nil {
+			pbItems[i].CountedQuantity = int32(*item.CountedQuantity)
+		}
+		if item.CountedAt != nil {
+			pbItems[i].CountedAt = timestamppb.New(*item.CountedAt)
+		}
+	}
+
+	pbPosStockTake := &pb.PosStockTake{
+		StockTakeId: posStockTake.StockTakeID.String(),
+		StoreId:     posStockTake.StoreID.String(),
+		BranchId:    posStockTake.BranchID.String(),
+		Scope:       posStockTake.Scope,
+		CategoryId:  utils.UUIDString(posStockTake.CategoryID),
+		Status:      posStockTake.Status,
+		Note:        posStockTake.Note,
+		Items:       pbItems,
+		ApprovedBy:  utils.UUIDString(posStockTake.ApprovedBy),
+		CompanyId:   posStockTake.CompanyID.String(),
+		CreatedAt:   timestamppb.New(posStockTake.CreatedAt),
+		CreatedBy:   posStockTake.CreatedBy.String(),
+		UpdatedAt:   timestamppb.New(posStockTake.UpdatedAt),
+		UpdatedBy:   posStockTake.UpdatedBy.String(),
+	}
+
+	if posStockTake.ApprovedAt != nil {
+		pbPosStockTake.ApprovedAt = timestamppb.New(*posStockTake.ApprovedAt)
+	}
+
+	return pbPosStockTake
+}
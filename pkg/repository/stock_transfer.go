@@ -0,0 +1,413 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosStockTransferRepository interface {
+	CreatePosStockTransfer(posStockTransfer *entity.PosStockTransfer, items []entity.PosStockTransferItem) error
+	ReadPosStockTransfer(transferID string) (*pb.PosStockTransfer, error)
+	DispatchPosStockTransfer(transferID string, userID uuid.UUID) error
+	ReceivePosStockTransfer(transferID string, userID uuid.UUID) error
+	CancelPosStockTransfer(transferID string, userID uuid.UUID) error
+	ReadAllPosStockTransfers(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error)
+}
+
+type posStockTransferRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+func NewPosStockTransferRepository(db *gorm.DB, redis *redis.Client) PosStockTransferRepository {
+	return &posStockTransferRepository{
+		db:    db,
+		redis: redis,
+	}
+}
+
+func (r *posStockTransferRepository) CreatePosStockTransfer(posStockTransfer *entity.PosStockTransfer, items []entity.PosStockTransferItem) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(posStockTransfer).Error; err != nil {
+			return err
+		}
+
+		for i := range items {
+			if err := tx.Create(&items[i]).Error; err != nil {
+				return err
+			}
+
+			for _, serialNumber := range items[i].SerialNumbers {
+				err := tx.Create(&entity.PosStockTransferItemSerial{
+					TransferItemID: items[i].TransferItemID,
+					SerialNumber:   serialNumber,
+				}).Error
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *posStockTransferRepository) ReadPosStockTransfer(transferID string) (*pb.PosStockTransfer, error) {
+	var posStockTransfer entity.PosStockTransfer
+	if err := r.db.Where("transfer_id = ?", transferID).First(&posStockTransfer).Error; err != nil {
+		return nil, err
+	}
+
+	var items []entity.PosStockTransferItem
+	if err := r.db.Where("transfer_id = ?", transferID).Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	if err := loadTransferItemSerialNumbers(r.db, items); err != nil {
+		return nil, err
+	}
+
+	return toPbPosStockTransfer(posStockTransfer, items), nil
+}
+
+// DispatchPosStockTransfer writes the outbound entries at the source store
+func (r *posStockTransferRepository) DispatchPosStockTransfer(transferID string, userID uuid.UUID) error {
+	return r.changeTransferStatus(transferID, userID, []string{entity.TransferStatusCreated}, entity.TransferStatusDispatched,
+		func(tx *gorm.DB, posStockTransfer *entity.PosStockTransfer, item entity.PosStockTransferItem) ([]*entity.PosInventoryHistory, error) {
+			movement := newTransferMovement(posStockTransfer, item, userID, entity.MovementTypeTransferOut, posStockTransfer.FromStoreID, posStockTransfer.FromBranchID, -item.Quantity)
+			movement.SerialNumbers = item.SerialNumbers
+			return []*entity.PosInventoryHistory{movement}, nil
+		})
+}
+
+// ReceivePosStockTransfer writes the inbound entries at the destination store
+func (r *posStockTransferRepository) ReceivePosStockTransfer(transferID string, userID uuid.UUID) error {
+	return r.changeTransferStatus(transferID, userID, []string{entity.TransferStatusDispatched}, entity.TransferStatusReceived,
+		func(tx *gorm.DB, posStockTransfer *entity.PosStockTransfer, item entity.PosStockTransferItem) ([]*entity.PosInventoryHistory, error) {
+			return newTransferInMovements(tx, posStockTransfer, item, userID, posStockTransfer.ToStoreID, posStockTransfer.ToBranchID)
+		})
+}
+
+// CancelPosStockTransfer returns dispatched stock to the source store, a transfer that was
+// never dispatched is cancelled without any inventory movement
+func (r *posStockTransferRepository) CancelPosStockTransfer(transferID string, userID uuid.UUID) error {
+	return r.changeTransferStatus(transferID, userID, []string{entity.TransferStatusCreated, entity.TransferStatusDispatched}, entity.TransferStatusCancelled,
+		func(tx *gorm.DB, posStockTransfer *entity.PosStockTransfer, item entity.PosStockTransferItem) ([]*entity.PosInventoryHistory, error) {
+			if posStockTransfer.Status != entity.TransferStatusDispatched {
+				return nil, nil
+			}
+			return newTransferInMovements(tx, posStockTransfer, item, userID, posStockTransfer.FromStoreID, posStockTransfer.FromBranchID)
+		})
+}
+
+// changeTransferStatus moves a transfer to the next status and posts the inventory movements of
+// all its items in one transaction, so a transfer can never be half applied
+func (r *posStockTransferRepository) changeTransferStatus(transferID string, userID uuid.UUID, allowedStatuses []string, nextStatus string, buildMovements func(tx *gorm.DB, posStockTransfer *entity.PosStockTransfer, item entity.PosStockTransferItem) ([]*entity.PosInventoryHistory, error)) error {
+	var posProducts []entity.PosProduct
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var posStockTransfer entity.PosStockTransfer
+
+		// Lock the transfer row so the status can only change once
+		if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("transfer_id = ?", transferID).First(&posStockTransfer).Error; err != nil {
+			return err
+		}
+
+		if !containsStatus(allowedStatuses, posStockTransfer.Status) {
+			return fmt.Errorf("stock transfer with status %s can not be changed to %s", posStockTransfer.Status, nextStatus)
+		}
+
+		var items []entity.PosStockTransferItem
+		if err := tx.Where("transfer_id = ?", transferID).Find(&items).Error; err != nil {
+			return err
+		}
+
+		if err := loadTransferItemSerialNumbers(tx, items); err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			movements, err := buildMovements(tx, &posStockTransfer, item)
+			if err != nil {
+				return err
+			}
+
+			for _, movement := range movements {
+				posProduct, err := applyInventoryMovement(tx, movement)
+				if err != nil {
+					return err
+				}
+				posProducts = append(posProducts, *posProduct)
+			}
+		}
+
+		now := time.Now()
+		updates := map[string]interface{}{
+			"status":     nextStatus,
+			"updated_at": now,
+			"updated_by": userID,
+		}
+
+		switch nextStatus {
+		case entity.TransferStatusDispatched:
+			updates["dispatched_at"] = now
+			updates["dispatched_by"] = userID
+		case entity.TransferStatusReceived:
+			updates["received_at"] = now
+			updates["received_by"] = userID
+		}
+
+		return tx.Model(&entity.PosStockTransfer{}).Where("transfer_id = ?", transferID).UpdateColumns(updates).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	// Invalidate the cached products only after the transaction is committed
+	invalidateProductCache(r.redis, posProducts...)
+
+	return nil
+}
+
+func (r *posStockTransferRepository) ReadAllPosStockTransfers(pagination dto.Pagination, roleName string, jwtPayload *pb.JWTPayload) (*dto.PaginationResult, error) {
+	var posStockTransfers []entity.PosStockTransfer
+	var totalRecords int64
+
+	query := r.db.Model(&entity.PosStockTransfer{})
+
+	companyRole := os.Getenv("COMPANY_USER_ROLE")
+	branchRole := os.Getenv("BRANCH_USER_ROLE")
+	storeRole := os.Getenv("STORE_USER_ROLE")
+
+	switch roleName {
+	case companyRole:
+		query = query.Where("company_id = ?", jwtPayload.CompanyId)
+	case branchRole:
+		query = query.Where("from_branch_id = ? OR to_branch_id = ?", jwtPayload.BranchId, jwtPayload.BranchId)
+	case storeRole:
+		query = query.Where("from_store_id = ? OR to_store_id = ?", jwtPayload.StoreId, jwtPayload.StoreId)
+	default:
+		return nil, errors.New("invalid role")
+	}
+
+	if err := query.Count(&totalRecords).Error; err != nil {
+		return nil, err
+	}
+
+	if pagination.Limit > 0 && pagination.Page > 0 {
+		offset := (pagination.Page - 1) * pagination.Limit
+		query = query.Offset(offset).Limit(pagination.Limit)
+	}
+
+	if err := query.Order("created_at desc").Find(&posStockTransfers).Error; err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalRecords) / float64(pagination.Limit)))
+
+	// Load the items of every transfer on the page
+	transferIDs := make([]uuid.UUID, len(posStockTransfers))
+	for i, posStockTransfer := range posStockTransfers {
+		transferIDs[i] = posStockTransfer.TransferID
+	}
+
+	var items []entity.PosStockTransferItem
+	if len(transferIDs) > 0 {
+		if err := r.db.Where("transfer_id IN (?)", transferIDs).Find(&items).Error; err != nil {
+			return nil, err
+		}
+
+		if err := loadTransferItemSerialNumbers(r.db, items); err != nil {
+			return nil, err
+		}
+	}
+
+	itemsByTransfer := make(map[uuid.UUID][]entity.PosStockTransferItem)
+	for _, item := range items {
+		itemsByTransfer[item.TransferID] = append(itemsByTransfer[item.TransferID], item)
+	}
+
+	pbPosStockTransfers := make([]*pb.PosStockTransfer, len(posStockTransfers))
+	for i, posStockTransfer := range posStockTransfers {
+		pbPosStockTransfers[i] = toPbPosStockTransfer(posStockTransfer, itemsByTransfer[posStockTransfer.TransferID])
+	}
+
+	return &dto.PaginationResult{
+		TotalRecords: totalRecords,
+		Records:      pbPosStockTransfers,
+		CurrentPage:  pagination.Page,
+		TotalPages:   totalPages,
+	}, nil
+}
+
+func newTransferMovement(posStockTransfer *entity.PosStockTransfer, item entity.PosStockTransferItem, userID uuid.UUID, movementType string, storeID uuid.UUID, branchID uuid.UUID, quantity int) *entity.PosInventoryHistory {
+	now := time.Now()
+	transferID := posStockTransfer.TransferID
+
+	return &entity.PosInventoryHistory{
+		InventoryID:  uuid.New(),
+		ProductID:    item.ProductID,
+		StoreID:      &storeID,
+		Date:         now,
+		Quantity:     quantity,
+		MovementType: movementType,
+		Note:         posStockTransfer.Note,
+		ReferenceNo:  transferID.String(),
+		TransferID:   &transferID,
+		BranchID:     &branchID,
+		CompanyID:    posStockTransfer.CompanyID,
+		CreatedAt:    now,
+		CreatedBy:    userID,
+		UpdatedAt:    now,
+		UpdatedBy:    userID,
+	}
+}
+
+// newTransferInMovements puts a dispatched transfer item into a store with one entry per lot that
+// was taken at dispatch, the quantity that did not come from a lot is put in without a lot.
+// All entries carry the unit cost the item was dispatched at, and the serial numbers that left
+// the source store are split over the entries.
+func newTransferInMovements(tx *gorm.DB, posStockTransfer *entity.PosStockTransfer, item entity.PosStockTransferItem, userID uuid.UUID, storeID uuid.UUID, branchID uuid.UUID) ([]*entity.PosInventoryHistory, error) {
+	posMovementLots, err := readTransferOutLots(tx, posStockTransfer.TransferID, item.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	unitCost, err := readTransferOutUnitCost(tx, posStockTransfer.TransferID, item.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	serialNumbers, err := readTransferOutSerialNumbers(tx, posStockTransfer.TransferID, item.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	var movements []*entity.PosInventoryHistory
+	remaining := item.Quantity
+	for _, posMovementLot := range posMovementLots {
+		quantity := posMovementLot.Quantity
+		if quantity > remaining {
+			quantity = remaining
+		}
+		if quantity <= 0 {
+			break
+		}
+
+		movement := newTransferMovement(posStockTransfer, item, userID, entity.MovementTypeTransferIn, storeID, branchID, quantity)
+		movement.LotCode = posMovementLot.LotCode
+		movement.ExpiryDate = posMovementLot.ExpiryDate
+		movement.UnitCost = unitCost
+		movement.SerialNumbers, serialNumbers = takeSerialNumbers(serialNumbers, quantity)
+		movements = append(movements, movement)
+		remaining -= quantity
+	}
+
+	if remaining > 0 {
+		movement := newTransferMovement(posStockTransfer, item, userID, entity.MovementTypeTransferIn, storeID, branchID, remaining)
+		movement.UnitCost = unitCost
+		movement.SerialNumbers, _ = takeSerialNumbers(serialNumbers, remaining)
+		movements = append(movements, movement)
+	}
+
+	return movements, nil
+}
+
+// takeSerialNumbers splits off the serial numbers of the given quantity, if there are any
+func takeSerialNumbers(serialNumbers []string, quantity int) ([]string, []string) {
+	if quantity > len(serialNumbers) {
+		quantity = len(serialNumbers)
+	}
+
+	return serialNumbers[:quantity], serialNumbers[quantity:]
+}
+
+// loadTransferItemSerialNumbers fills the serial numbers of the given transfer items
+func loadTransferItemSerialNumbers(db *gorm.DB, items []entity.PosStockTransferItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	transferItemIDs := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		transferItemIDs[i] = item.TransferItemID
+	}
+
+	var itemSerials []entity.PosStockTransferItemSerial
+	if err := db.Where("transfer_item_id IN (?)", transferItemIDs).Order("serial_number").Find(&itemSerials).Error; err != nil {
+		return err
+	}
+
+	serialNumbersByItem := make(map[uuid.UUID][]string)
+	for _, itemSerial := range itemSerials {
+		serialNumbersByItem[itemSerial.TransferItemID] = append(serialNumbersByItem[itemSerial.TransferItemID], itemSerial.SerialNumber)
+	}
+
+	for i := range items {
+		items[i].SerialNumbers = serialNumbersByItem[items[i].TransferItemID]
+	}
+
+	return nil
+}
+
+func toPbPosStockTransfer(posStockTransfer entity.PosStockTransfer, items []entity.PosStockTransferItem) *pb.PosStockTransfer {
+	pbItems := make([]*pb.PosStockTransferItem, len(items))
+	for i, item := range items {
+		pbItems[i] = &pb.PosStockTransferItem{
+			TransferItemId: item.TransferItemID.String(),
+			TransferId:     item.TransferID.String(),
+			ProductId:      item.ProductID.String(),
+			Quantity:       int32(item.Quantity),
+			SerialNumbers:  item.SerialNumbers,
+		}
+	}
+
+	pbPosStockTransfer := &pb.PosStockTransfer{
+		TransferId:   posStockTransfer.TransferID.String(),
+		FromStoreId:  posStockTransfer.FromStoreID.String(),
+		FromBranchId: posStockTransfer.FromBranchID.String(),
+		ToStoreId:    posStockTransfer.ToStoreID.String(),
+		ToBranchId:   posStockTransfer.ToBranchID.String(),
+		Status:       posStockTransfer.Status,
+		Note:         posStockTransfer.Note,
+		Items:        pbItems,
+		DispatchedBy: utils.UUIDString(posStockTransfer.DispatchedBy),
+		ReceivedBy:   utils.UUIDString(posStockTransfer.ReceivedBy),
+		CompanyId:    posStockTransfer.CompanyID.String(),
+		CreatedAt:    timestamppb.New(posStockTransfer.CreatedAt),
+		CreatedBy:    posStockTransfer.CreatedBy.String(),
+		UpdatedAt:    timestamppb.New(posStockTransfer.UpdatedAt),
+		UpdatedBy:    posStockTransfer.UpdatedBy.String(),
+	}
+
+	if posStockTransfer.DispatchedAt != nil {
+		pbPosStockTransfer.DispatchedAt = timestamppb.New(*posStockTransfer.DispatchedAt)
+	}
+	if posStockTransfer.ReceivedAt != nil {
+		pbPosStockTransfer.ReceivedAt = timestamppb.New(*posStockTransfer.ReceivedAt)
+	}
+
+	return pbPosStockTransfer
+}
+
+func containsStatus(statuses []string, status string) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
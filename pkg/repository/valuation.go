@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"errors"
+	"math"
+	"os"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+)
+
+// Inventory valuation groupings
+const (
+	ValuationGroupByCompany = "company"
+	ValuationGroupByBranch  = "branch"
+	ValuationGroupByStore   = "store"
+)
+
+// valuationCostExpression values a ledger entry at its recorded cost, entries posted before
+// movements were valued fall back to the current product cost price
+const valuationCostExpression = "SUM(COALESCE(NULLIF(h.cost_amount, 0), h.quantity * p.cost_price))"
+
+type PosInventoryValuationRepository interface {
+	ReadPosInventoryValuation(roleName string, jwtPayload *pb.JWTPayload, asOf time.Time, groupBy string, branchID string, storeID string) ([]*pb.PosInventoryValuationLine, error)
+}
+
+type posInventoryValuationRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+func NewPosInventoryValuationRepository(db *gorm.DB, redis *redis.Client) PosInventoryValuationRepository {
+	return &posInventoryValuationRepository{
+		db:    db,
+		redis: redis,
+	}
+}
+
+// ReadPosInventoryValuation rebuilds the stock on hand at the given time from the inventory ledger
+// and values it at cost and at the current retail price, per category and sub category of the
+// company, branch or store grouping
+func (r *posInventoryValuationRepository) ReadPosInventoryValuation(roleName string, jwtPayload *pb.JWTPayload, asOf time.Time, groupBy string, branchID string, storeID string) ([]*pb.PosInventoryValuationLine, error) {
+	var groupColumns string
+	switch groupBy {
+	case ValuationGroupByCompany:
+		groupColumns = "h.company_id"
+	case ValuationGroupByBranch:
+		groupColumns = "h.company_id, h.branch_id"
+	case ValuationGroupByStore:
+		groupColumns = "h.company_id, h.branch_id, h.store_id"
+	default:
+		return nil, errors.New("invalid valuation group")
+	}
+	groupColumns += ", p.category_id, c.category_name, p.sub_category_id, sc.sub_category_name"
+
+	var valuationLines []struct {
+		CompanyID       uuid.UUID
+		BranchID        uuid.UUID
+		StoreID         uuid.UUID
+		CategoryID      uuid.UUID
+		CategoryName    string
+		SubCategoryID   uuid.UUID
+		SubCategoryName string
+		Quantity        int64
+		CostValue       float64
+		RetailValue     float64
+	}
+
+	query := r.db.Table("pos_inventory_histories h").
+		Select(groupColumns+", SUM(h.quantity) AS quantity, "+valuationCostExpression+" AS cost_value, SUM(h.quantity * p.price) AS retail_value").
+		Joins("JOIN pos_products p ON p.product_id = h.product_id").
+		Joins("LEFT JOIN pos_product_categories c ON c.category_id = p.category_id").
+		Joins("LEFT JOIN pos_product_sub_categories sc ON sc.sub_category_id = p.sub_category_id").
+		Where("h.date <= ?", asOf)
+
+	companyRole := os.Getenv("COMPANY_USER_ROLE")
+	branchRole := os.Getenv("BRANCH_USER_ROLE")
+	storeRole := os.Getenv("STORE_USER_ROLE")
+
+	switch roleName {
+	case companyRole:
+		query = query.Where("h.company_id = ?", jwtPayload.CompanyId)
+	case branchRole:
+		query = query.Where("h.branch_id = ?", jwtPayload.BranchId)
+	case storeRole:
+		query = query.Where("h.store_id = ?", jwtPayload.StoreId)
+	default:
+		return nil, errors.New("invalid role")
+	}
+
+	if branchID != "" {
+		query = query.Where("h.branch_id = ?", branchID)
+	}
+
+	if storeID != "" {
+		query = query.Where("h.store_id = ?", storeID)
+	}
+
+	err := query.Group(groupColumns).
+		Having("SUM(h.quantity) <> 0").
+		Order(groupColumns).
+		Scan(&valuationLines).Error
+	if err != nil {
+		return nil, err
+	}
+
+	pbValuationLines := make([]*pb.PosInventoryValuationLine, len(valuationLines))
+	for i, valuationLine := range valuationLines {
+		pbValuationLine := &pb.PosInventoryValuationLine{
+			CompanyId:       valuationLine.CompanyID.String(),
+			CategoryId:      valuationLine.CategoryID.String(),
+			CategoryName:    valuationLine.CategoryName,
+			SubCategoryId:   valuationLine.SubCategoryID.String(),
+			SubCategoryName: valuationLine.SubCategoryName,
+			Quantity:        valuationLine.Quantity,
+			CostValue:       roundMoney(valuationLine.CostValue),
+			RetailValue:     roundMoney(valuationLine.RetailValue),
+		}
+		pbValuationLine.MarginValue, pbValuationLine.MarginPercent = valuationMargin(pbValuationLine.CostValue, pbValuationLine.RetailValue)
+
+		if groupBy != ValuationGroupByCompany {
+			pbValuationLine.BranchId = valuationLine.BranchID.String()
+		}
+		if groupBy == ValuationGroupByStore {
+			pbValuationLine.StoreId = valuationLine.StoreID.String()
+		}
+
+		pbValuationLines[i] = pbValuationLine
+	}
+
+	return pbValuationLines, nil
+}
+
+// valuationMargin returns the margin of the retail value over the cost value and its percentage
+// of the retail value
+func valuationMargin(costValue float64, retailValue float64) (float64, float64) {
+	marginValue := roundMoney(retailValue - costValue)
+	if retailValue == 0 {
+		return marginValue, 0
+	}
+
+	return marginValue, roundMoney(marginValue / retailValue * 100)
+}
+
+func roundMoney(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+type PosCostingSettingService interface {
+	ReadPosCostingSetting(ctx context.Context, req *pb.ReadPosCostingSettingRequest) (*pb.ReadPosCostingSettingResponse, error)
+	UpdatePosCostingSetting(ctx context.Context, req *pb.UpdatePosCostingSettingRequest) (*pb.UpdatePosCostingSettingResponse, error)
+}
+
+type posCostingSettingService struct {
+	pb.UnimplementedPosCostingSettingServiceServer
+	repo               repository.PosCostingSettingRepository
+	CompanyServiceConn *grpc.ClientConn
+}
+
+func NewPosCostingSettingService(repo repository.PosCostingSettingRepository, companyServiceConn *grpc.ClientConn) *posCostingSettingService {
+	return &posCostingSettingService{
+		repo:               repo,
+		CompanyServiceConn: companyServiceConn,
+	}
+}
+
+func (s *posCostingSettingService) ReadPosCostingSetting(ctx context.Context, req *pb.ReadPosCostingSettingRequest) (*pb.ReadPosCostingSettingResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read costing setting")
+	}
+
+	posCostingSetting, err := s.repo.ReadPosCostingSetting(req.JwtPayload.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadPosCostingSettingResponse{
+		PosCostingSetting: posCostingSetting,
+	}, nil
+}
+
+func (s *posCostingSettingService) UpdatePosCostingSetting(ctx context.Context, req *pb.UpdatePosCostingSettingRequest) (*pb.UpdatePosCostingSettingResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if loginRole.PosRole.RoleName != os.Getenv("COMPANY_USER_ROLE") {
+		return nil, errors.New("only company users are allowed to update costing setting")
+	}
+
+	if req.PosCostingSetting.CostingMethod != entity.CostingMethodWeightedAverage && req.PosCostingSetting.CostingMethod != entity.CostingMethodFIFO {
+		return nil, errors.New("error update costing setting, costing method must be weighted_average or fifo")
+	}
+
+	now := time.Now()
+	posCostingSetting := &entity.PosCostingSetting{
+		CompanyID:     uuid.MustParse(req.JwtPayload.CompanyId),
+		CostingMethod: req.PosCostingSetting.CostingMethod,
+		CreatedAt:     now,
+		CreatedBy:     uuid.MustParse(req.JwtPayload.UserId),
+		UpdatedAt:     now,
+		UpdatedBy:     uuid.MustParse(req.JwtPayload.UserId),
+	}
+
+	err = s.repo.UpdatePosCostingSetting(posCostingSetting)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedCostingSetting, err := s.repo.ReadPosCostingSetting(req.JwtPayload.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdatePosCostingSettingResponse{
+		PosCostingSetting: updatedCostingSetting,
+	}, nil
+}
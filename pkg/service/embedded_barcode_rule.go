@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+// defaultEmbeddedWeightUnit is the unit of the weight labels of rules that do not set one
+const defaultEmbeddedWeightUnit = "kg"
+
+type PosEmbeddedBarcodeRuleService interface {
+	CreatePosEmbeddedBarcodeRule(ctx context.Context, req *pb.CreatePosEmbeddedBarcodeRuleRequest) (*pb.CreatePosEmbeddedBarcodeRuleResponse, error)
+	UpdatePosEmbeddedBarcodeRule(ctx context.Context, req *pb.UpdatePosEmbeddedBarcodeRuleRequest) (*pb.UpdatePosEmbeddedBarcodeRuleResponse, error)
+	DeletePosEmbeddedBarcodeRule(ctx context.Context, req *pb.DeletePosEmbeddedBarcodeRuleRequest) (*pb.DeletePosEmbeddedBarcodeRuleResponse, error)
+	ReadAllPosEmbeddedBarcodeRules(ctx context.Context, req *pb.ReadAllPosEmbeddedBarcodeRulesRequest) (*pb.ReadAllPosEmbeddedBarcodeRulesResponse, error)
+}
+
+type posEmbeddedBarcodeRuleService struct {
+	pb.UnimplementedPosEmbeddedBarcodeRuleServiceServer
+	repo               repository.PosEmbeddedBarcodeRuleRepository
+	CompanyServiceConn *grpc.ClientConn
+}
+
+func NewPosEmbeddedBarcodeRuleService(repo repository.PosEmbeddedBarcodeRuleRepository, companyServiceConn *grpc.ClientConn) *posEmbeddedBarcodeRuleService {
+	return &posEmbeddedBarcodeRuleService{
+		repo:               repo,
+		CompanyServiceConn: companyServiceConn,
+	}
+}
+
+func (s *posEmbeddedBarcodeRuleService) CreatePosEmbeddedBarcodeRule(ctx context.Context, req *pb.CreatePosEmbeddedBarcodeRuleRequest) (*pb.CreatePosEmbeddedBarcodeRuleResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	// Scale labels are read the same way in every branch of the company
+	if loginRole.PosRole.RoleName != os.Getenv("COMPANY_USER_ROLE") {
+		return nil, errors.New("users are not allowed to create new embedded barcode rule")
+	}
+
+	if req.PosEmbeddedBarcodeRule == nil {
+		return nil, errors.New("error create embedded barcode rule, embedded barcode rule could not be empty")
+	}
+
+	unit, err := validateEmbeddedBarcodeRule(req.PosEmbeddedBarcodeRule)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	entityEmbeddedBarcodeRule := &entity.PosEmbeddedBarcodeRule{
+		RuleID:      uuid.New(),                                  // auto
+		Prefix:      req.PosEmbeddedBarcodeRule.Prefix,           // required
+		PluDigits:   int(req.PosEmbeddedBarcodeRule.PluDigits),   // required
+		ValueDigits: int(req.PosEmbeddedBarcodeRule.ValueDigits), // required
+		ValueType:   req.PosEmbeddedBarcodeRule.ValueType,        // required
+		Decimals:    int(req.PosEmbeddedBarcodeRule.Decimals),    // optional
+		Unit:        unit,                                        // optional
+		CompanyID:   uuid.MustParse(req.JwtPayload.CompanyId),    // auto
+		CreatedAt:   now,                                         // auto
+		CreatedBy:   uuid.MustParse(req.JwtPayload.UserId),       // auto
+		UpdatedAt:   now,                                         // auto
+		UpdatedBy:   uuid.MustParse(req.JwtPayload.UserId),       // auto
+	}
+
+	err = s.repo.CreatePosEmbeddedBarcodeRule(entityEmbeddedBarcodeRule)
+	if err != nil {
+		return nil, err
+	}
+
+	posEmbeddedBarcodeRule, err := s.repo.ReadPosEmbeddedBarcodeRule(entityEmbeddedBarcodeRule.RuleID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreatePosEmbeddedBarcodeRuleResponse{
+		PosEmbeddedBarcodeRule: posEmbeddedBarcodeRule,
+	}, nil
+}
+
+func (s *posEmbeddedBarcodeRuleService) UpdatePosEmbeddedBarcodeRule(ctx context.Context, req *pb.UpdatePosEmbeddedBarcodeRuleRequest) (*pb.UpdatePosEmbeddedBarcodeRuleResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if loginRole.PosRole.RoleName != os.Getenv("COMPANY_USER_ROLE") {
+		return nil, errors.New("users are not allowed to update embedded barcode rule")
+	}
+
+	if req.PosEmbeddedBarcodeRule == nil {
+		return nil, errors.New("error update embedded barcode rule, embedded barcode rule could not be empty")
+	}
+
+	// Get the embedded barcode rule to be updated
+	posEmbeddedBarcodeRuleData, err := s.repo.ReadPosEmbeddedBarcodeRule(req.PosEmbeddedBarcodeRule.RuleId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.VerifyCompanyUserAccess(loginRole.PosRole.RoleName, posEmbeddedBarcodeRuleData.CompanyId, req.JwtPayload.CompanyId) {
+		return nil, errors.New("company users can only update embedded barcode rule within their company")
+	}
+
+	unit, err := validateEmbeddedBarcodeRule(req.PosEmbeddedBarcodeRule)
+	if err != nil {
+		return nil, err
+	}
+
+	entityEmbeddedBarcodeRule := &entity.PosEmbeddedBarcodeRule{
+		RuleID:      uuid.MustParse(posEmbeddedBarcodeRuleData.RuleId), // auto
+		Prefix:      req.PosEmbeddedBarcodeRule.Prefix,
+		PluDigits:   int(req.PosEmbeddedBarcodeRule.PluDigits),
+		ValueDigits: int(req.PosEmbeddedBarcodeRule.ValueDigits),
+		ValueType:   req.PosEmbeddedBarcodeRule.ValueType,
+		Decimals:    int(req.PosEmbeddedBarcodeRule.Decimals),
+		Unit:        unit,
+		CompanyID:   uuid.MustParse(posEmbeddedBarcodeRuleData.CompanyId), // auto
+		CreatedAt:   posEmbeddedBarcodeRuleData.CreatedAt.AsTime(),        // auto
+		CreatedBy:   uuid.MustParse(posEmbeddedBarcodeRuleData.CreatedBy), // auto
+		UpdatedAt:   time.Now(),                                           // auto
+		UpdatedBy:   uuid.MustParse(req.JwtPayload.UserId),                // auto
+	}
+
+	err = s.repo.UpdatePosEmbeddedBarcodeRule(entityEmbeddedBarcodeRule)
+	if err != nil {
+		return nil, err
+	}
+
+	posEmbeddedBarcodeRule, err := s.repo.ReadPosEmbeddedBarcodeRule(entityEmbeddedBarcodeRule.RuleID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdatePosEmbeddedBarcodeRuleResponse{
+		PosEmbeddedBarcodeRule: posEmbeddedBarcodeRule,
+	}, nil
+}
+
+func (s *posEmbeddedBarcodeRuleService) DeletePosEmbeddedBarcodeRule(ctx context.Context, req *pb.DeletePosEmbeddedBarcodeRuleRequest) (*pb.DeletePosEmbeddedBarcodeRuleResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if loginRole.PosRole.RoleName != os.Getenv("COMPANY_USER_ROLE") {
+		return nil, errors.New("users are not allowed to delete embedded barcode rule")
+	}
+
+	// Get the embedded barcode rule to be deleted
+	posEmbeddedBarcodeRuleData, err := s.repo.ReadPosEmbeddedBarcodeRule(req.RuleId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.VerifyCompanyUserAccess(loginRole.PosRole.RoleName, posEmbeddedBarcodeRuleData.CompanyId, req.JwtPayload.CompanyId) {
+		return nil, errors.New("company users can only delete embedded barcode rule within their company")
+	}
+
+	err = s.repo.DeletePosEmbeddedBarcodeRule(req.RuleId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeletePosEmbeddedBarcodeRuleResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *posEmbeddedBarcodeRuleService) ReadAllPosEmbeddedBarcodeRules(ctx context.Context, req *pb.ReadAllPosEmbeddedBarcodeRulesRequest) (*pb.ReadAllPosEmbeddedBarcodeRulesResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read embedded barcode rules")
+	}
+
+	posEmbeddedBarcodeRules, err := s.repo.ReadAllPosEmbeddedBarcodeRules(req.JwtPayload.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadAllPosEmbeddedBarcodeRulesResponse{
+		PosEmbeddedBarcodeRules: posEmbeddedBarcodeRules,
+	}, nil
+}
+
+// validateEmbeddedBarcodeRule checks that the prefix, PLU and value of a rule fit in an EAN-13
+// before its check digit, and returns the unit of its weight labels
+func validateEmbeddedBarcodeRule(posEmbeddedBarcodeRule *pb.PosEmbeddedBarcodeRule) (string, error) {
+	posEmbeddedBarcodeRule.Prefix = strings.TrimSpace(posEmbeddedBarcodeRule.Prefix)
+	if posEmbeddedBarcodeRule.Prefix == "" || strings.Trim(posEmbeddedBarcodeRule.Prefix, "0123456789") != "" {
+		return "", errors.New("error embedded barcode rule, prefix must be numeric")
+	}
+
+	if posEmbeddedBarcodeRule.PluDigits <= 0 || posEmbeddedBarcodeRule.ValueDigits <= 0 {
+		return "", errors.New("error embedded barcode rule, plu digits and value digits must be positive")
+	}
+
+	if len(posEmbeddedBarcodeRule.Prefix)+int(posEmbeddedBarcodeRule.PluDigits)+int(posEmbeddedBarcodeRule.ValueDigits) > 12 {
+		return "", errors.New("error embedded barcode rule, prefix, plu digits and value digits must fit in the 12 digits before the check digit")
+	}
+
+	if posEmbeddedBarcodeRule.Decimals < 0 || posEmbeddedBarcodeRule.Decimals > posEmbeddedBarcodeRule.ValueDigits {
+		return "", errors.New("error embedded barcode rule, decimals must be between 0 and the value digits")
+	}
+
+	switch posEmbeddedBarcodeRule.ValueType {
+	case entity.EmbeddedValueTypePrice:
+		return "", nil
+	case entity.EmbeddedValueTypeWeight:
+		unit := utils.NormalizeUnit(posEmbeddedBarcodeRule.Unit)
+		if unit == "" {
+			unit = defaultEmbeddedWeightUnit
+		}
+		return unit, nil
+	default:
+		return "", errors.New("error embedded barcode rule, value type must be price or weight")
+	}
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// idempotentMethods are the methods that create records or move stock, a retry of them would
+// apply the change again
+var idempotentMethods = map[string]bool{
+	"CreatePosEmbeddedBarcodeRule":        true,
+	"CreatePosInventoryHistory":           true,
+	"CreatePosProduct":                    true,
+	"CreatePosProductBarcode":             true,
+	"CreatePosProductCategory":            true,
+	"CreatePosProductSubCategory":         true,
+	"CreatePosProductUnit":                true,
+	"CreatePosProductVariant":             true,
+	"CreatePosPromotion":                  true,
+	"CreatePosPurchaseOrder":              true,
+	"CreatePosStockTake":                  true,
+	"CreatePosStockTransfer":              true,
+	"CreatePosSupplier":                   true,
+	"GenerateInternalBarcode":             true,
+	"GenerateReplenishmentPurchaseOrders": true,
+	"DispatchPosStockTransfer":            true,
+	"ReceivePosStockTransfer":             true,
+	"CancelPosStockTransfer":              true,
+	"SubmitPosPurchaseOrder":              true,
+	"ReceivePosPurchaseOrder":             true,
+	"CancelPosPurchaseOrder":              true,
+	"ReversePosInventoryHistory":          true,
+	"DeductStockForSale":                  true,
+	"ReturnStockForSale":                  true,
+	"SubmitPosStockTakeCounts":            true,
+	"ApprovePosStockTake":                 true,
+	"CancelPosStockTake":                  true,
+	"ReservePosStock":                     true,
+	"CommitPosStockReservation":           true,
+	"ReleasePosStockReservation":          true,
+	"ReconcilePosInventory":               true,
+}
+
+// NewIdempotencyInterceptor returns a gRPC interceptor that applies a call of idempotentMethods
+// carrying an idempotency key only once within the ttl: a replay with the same key and request
+// returns the stored response of the first call. Keys are scoped to the company and user of the
+// JWT payload.
+func NewIdempotencyInterceptor(repo repository.PosIdempotencyRepository, ttl time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+		if !idempotentMethods[method] {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		idempotencyKeys := md.Get(utils.IdempotencyKeyMetadata)
+		if len(idempotencyKeys) == 0 || idempotencyKeys[0] == "" {
+			return handler(ctx, req)
+		}
+
+		reqMessage, ok := req.(proto.Message)
+		jwtRequest, hasJwt := req.(interface{ GetJwtPayload() *pb.JWTPayload })
+		if !ok || !hasJwt || jwtRequest.GetJwtPayload() == nil {
+			return handler(ctx, req)
+		}
+		jwtPayload := jwtRequest.GetJwtPayload()
+
+		key := jwtPayload.CompanyId + "_" + jwtPayload.UserId + "_" + idempotencyKeys[0]
+		fingerprint, err := idempotencyFingerprint(info.FullMethod, reqMessage)
+		if err != nil {
+			return nil, err
+		}
+
+		idempotencyRecord, err := repo.ReserveIdempotencyKey(key, fingerprint, ttl)
+		if err != nil {
+			return nil, err
+		}
+
+		if idempotencyRecord != nil {
+			if idempotencyRecord.Fingerprint != fingerprint {
+				return nil, errors.New("idempotency key was already used for a different request")
+			}
+			if idempotencyRecord.Status != repository.IdempotencyStatusCompleted {
+				return nil, errors.New("request with this idempotency key is still being processed")
+			}
+
+			var storedResponse anypb.Any
+			if err := proto.Unmarshal(idempotencyRecord.Response, &storedResponse); err != nil {
+				return nil, err
+			}
+
+			return storedResponse.UnmarshalNew()
+		}
+
+		res, err := recoverHandler(ctx, req, info, handler)
+		if err != nil {
+			// The request was not applied, let it be retried with the same key
+			if releaseErr := repo.ReleaseIdempotencyKey(key); releaseErr != nil {
+				log.Printf("failed to release idempotency key %s: %v", key, releaseErr)
+			}
+			return nil, err
+		}
+
+		resMessage, ok := res.(proto.Message)
+		if !ok {
+			return res, nil
+		}
+
+		storedResponse, err := anypb.New(resMessage)
+		if err == nil {
+			var responseData []byte
+			responseData, err = proto.Marshal(storedResponse)
+			if err == nil {
+				err = repo.CompleteIdempotencyKey(key, fingerprint, responseData, ttl)
+			}
+		}
+		if err != nil {
+			// The request was applied, so the response is returned even if it could not be stored
+			log.Printf("failed to store the response of idempotency key %s: %v", key, err)
+		}
+
+		return res, nil
+	}
+}
+
+// recoverHandler runs the handler and returns a panic as an internal error, so the reserved key
+// is released like for any other failed request instead of staying in processing until the ttl
+func recoverHandler(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (res interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("panic in %s: %v\n%s", info.FullMethod, r, debug.Stack())
+			res, err = nil, status.Error(codes.Internal, "internal error")
+		}
+	}()
+
+	return handler(ctx, req)
+}
+
+// idempotencyFingerprint hashes the method and request without its JWT, which changes when the
+// token is refreshed between retries
+func idempotencyFingerprint(fullMethod string, req proto.Message) (string, error) {
+	reqClone := proto.Clone(req)
+	fields := reqClone.ProtoReflect().Descriptor().Fields()
+	for _, name := range []protoreflect.Name{"jwt_payload", "jwt_token"} {
+		if field := fields.ByName(name); field != nil {
+			reqClone.ProtoReflect().Clear(field)
+		}
+	}
+
+	reqData, err := proto.MarshalOptions{Deterministic: true}.Marshal(reqClone)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(fullMethod))
+	hash.Write(reqData)
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -3,7 +3,6 @@ package service
 import (
 	"context"
 	"errors"
-	"math"
 	"os"
 	"time"
 
@@ -15,15 +14,18 @@ import (
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type PosInventoryHistoryService interface {
 	CreatePosInventoryHistory(ctx context.Context, req *pb.CreatePosInventoryHistoryRequest) (*pb.CreatePosInventoryHistoryResponse, error)
 	ReadPosInventoryHistory(ctx context.Context, req *pb.ReadPosInventoryHistoryRequest) (*pb.ReadPosInventoryHistoryResponse, error)
-	UpdatePosInventoryHistory(ctx context.Context, req *pb.UpdatePosInventoryHistoryRequest) (*pb.UpdatePosInventoryHistoryResponse, error)
-	DeletePosInventoryHistory(ctx context.Context, req *pb.DeletePosInventoryHistoryRequest) (*pb.DeletePosInventoryHistoryResponse, error)
+	ReversePosInventoryHistory(ctx context.Context, req *pb.ReversePosInventoryHistoryRequest) (*pb.ReversePosInventoryHistoryResponse, error)
 	ReadAllPosInventoryHistories(ctx context.Context, req *pb.ReadAllPosInventoryHistoriesRequest) (*pb.ReadAllPosInventoryHistoriesResponse, error)
+	GetStockAsOf(ctx context.Context, req *pb.GetStockAsOfRequest) (*pb.GetStockAsOfResponse, error)
+	ReadPosStockCard(ctx context.Context, req *pb.ReadPosStockCardRequest) (*pb.ReadPosStockCardResponse, error)
 }
 
 type posInventoryHistoryService struct {
@@ -55,6 +57,42 @@ func (s *posInventoryHistoryService) CreatePosInventoryHistory(ctx context.Conte
 		return nil, errors.New("users are not allowed to create new inventory history")
 	}
 
+	productID, err := uuid.Parse(req.PosInventoryHistory.ProductId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "error created inventory history, product id is not valid")
+	}
+
+	// Convert a quantity given in another unit to the base unit of the product, the unit cost is
+	// then given per unit too
+	if req.PosInventoryHistory.Unit != "" {
+		req.PosInventoryHistory.Unit = utils.NormalizeUnit(req.PosInventoryHistory.Unit)
+
+		quantity, factor, err := s.repoProduct.ConvertToBaseUnit(req.PosInventoryHistory.ProductId, req.PosInventoryHistory.Unit, req.PosInventoryHistory.UnitQuantity)
+		if err != nil {
+			return nil, err
+		}
+		req.PosInventoryHistory.Quantity = int32(quantity)
+		req.PosInventoryHistory.UnitCost = req.PosInventoryHistory.UnitCost / factor
+	}
+
+	// Check if quantity sign is allowed for the movement type
+	err = utils.ValidateMovementQuantity(req.PosInventoryHistory.MovementType, int(req.PosInventoryHistory.Quantity))
+	if err != nil {
+		return nil, err
+	}
+
+	if req.PosInventoryHistory.MovementType == entity.MovementTypeReversal {
+		return nil, errors.New("error created inventory history, reversal entries can only be created by reversing a movement")
+	}
+
+	if req.PosInventoryHistory.MovementType == entity.MovementTypeReconciliation {
+		return nil, errors.New("error created inventory history, reconciliation entries can only be created by the inventory reconciliation")
+	}
+
+	if req.PosInventoryHistory.UnitCost < 0 {
+		return nil, errors.New("error created inventory history, unit cost could not be negative")
+	}
+
 	req.PosInventoryHistory.InventoryId = uuid.New().String() // Generate a new UUID for the inventory_id
 
 	now := timestamppb.New(time.Now())
@@ -64,99 +102,80 @@ func (s *posInventoryHistoryService) CreatePosInventoryHistory(ctx context.Conte
 
 	// Convert pb.PosInventoryHistory to entity.PosInventoryHistory
 	gormInventoryHistory := &entity.PosInventoryHistory{
-		InventoryID: uuid.MustParse(req.PosInventoryHistory.InventoryId), // auto
-		ProductID:   uuid.MustParse(req.PosInventoryHistory.ProductId),
-		StoreID:     nil,
-		Date:        req.PosInventoryHistory.Date.AsTime(), // auto
-		Quantity:    int(req.PosInventoryHistory.Quantity),
-		BranchID:    nil,
-		CompanyID:   uuid.MustParse(req.JwtPayload.CompanyId),   // auto
-		CreatedAt:   req.PosInventoryHistory.CreatedAt.AsTime(), // auto
-		CreatedBy:   uuid.MustParse(req.JwtPayload.UserId),      // auto
-		UpdatedAt:   req.PosInventoryHistory.UpdatedAt.AsTime(), // auto
-		UpdatedBy:   uuid.MustParse(req.JwtPayload.UserId),      // auto
+		InventoryID:   uuid.MustParse(req.PosInventoryHistory.InventoryId), // auto
+		ProductID:     productID,
+		StoreID:       nil,
+		Date:          req.PosInventoryHistory.Date.AsTime(), // auto
+		Quantity:      int(req.PosInventoryHistory.Quantity),
+		MovementType:  req.PosInventoryHistory.MovementType,
+		Note:          req.PosInventoryHistory.Note,
+		ReferenceNo:   req.PosInventoryHistory.ReferenceNo,
+		LotCode:       req.PosInventoryHistory.LotCode,
+		ExpiryDate:    utils.TimeFromTimestamp(req.PosInventoryHistory.ExpiryDate),
+		UnitCost:      req.PosInventoryHistory.UnitCost,
+		BranchID:      nil,
+		SerialNumbers: req.PosInventoryHistory.SerialNumbers,
+		Unit:          req.PosInventoryHistory.Unit,
+		UnitQuantity:  req.PosInventoryHistory.UnitQuantity,
+		CompanyID:     uuid.MustParse(req.JwtPayload.CompanyId),   // auto
+		CreatedAt:     req.PosInventoryHistory.CreatedAt.AsTime(), // auto
+		CreatedBy:     uuid.MustParse(req.JwtPayload.UserId),      // auto
+		UpdatedAt:     req.PosInventoryHistory.UpdatedAt.AsTime(), // auto
+		UpdatedBy:     uuid.MustParse(req.JwtPayload.UserId),      // auto
 	}
 
-	companyRole := os.Getenv("COMPANY_USER_ROLE")
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-	storeRole := os.Getenv("STORE_USER_ROLE")
-
 	// set Branch ID Store ID base in login role
+	branchID := req.PosInventoryHistory.BranchId
+	storeID := req.PosInventoryHistory.StoreId
 	switch loginRole.PosRole.RoleName {
-	case companyRole:
-		gormInventoryHistory.BranchID = utils.ParseUUID(req.PosInventoryHistory.BranchId)
-
-		if gormInventoryHistory.BranchID == nil {
-			return nil, errors.New("error created inventory history, branch id could not be empty")
-		}
-
-		if gormInventoryHistory.StoreID == nil {
-			return nil, errors.New("error created inventory history, store id could not be empty")
-		}
-
-	case branchRole:
-		gormInventoryHistory.BranchID = utils.ParseUUID(req.JwtPayload.BranchId)
-
-		if gormInventoryHistory.StoreID == nil {
-			return nil, errors.New("error created inventory history, store id could not be empty")
-		}
-	case storeRole:
-		gormInventoryHistory.BranchID = utils.ParseUUID(req.JwtPayload.BranchId)
-		gormInventoryHistory.StoreID = utils.ParseUUID(req.JwtPayload.StoreId)
+	case os.Getenv("BRANCH_USER_ROLE"):
+		branchID = req.JwtPayload.BranchId
+	case os.Getenv("STORE_USER_ROLE"):
+		branchID = req.JwtPayload.BranchId
+		storeID = req.JwtPayload.StoreId
 	}
 
-	err = s.repoInventory.CreatePosInventoryHistory(gormInventoryHistory)
+	gormInventoryHistory.BranchID, err = utils.ParseOptionalUUID(branchID)
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.InvalidArgument, "error created inventory history, branch "+err.Error())
 	}
 
-	// Get data product
-	getDataProduct, err := s.repoProduct.ReadPosProduct(gormInventoryHistory.ProductID.String())
+	gormInventoryHistory.StoreID, err = utils.ParseOptionalUUID(storeID)
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.InvalidArgument, "error created inventory history, store "+err.Error())
 	}
 
-	// check if decrease the quantity
-	if gormInventoryHistory.Quantity < 0 {
-		countQuantity := getDataProduct.StockQuantity + int32(gormInventoryHistory.Quantity)
-		if countQuantity < 0 {
-			return nil, errors.New("error cant decrease stock quantity, the request quantity is bigger than current avaliable stock quantity")
-		}
+	if gormInventoryHistory.BranchID == nil {
+		return nil, errors.New("error created inventory history, branch id could not be empty")
+	}
+
+	if gormInventoryHistory.StoreID == nil {
+		return nil, errors.New("error created inventory history, store id could not be empty")
+	}
+
+	// The store must belong to the branch of the movement and to the company of the login user
+	err = utils.VerifyStoreBranch(s.CompanyServiceConn, gormInventoryHistory.StoreID.String(), gormInventoryHistory.BranchID.String(), req.JwtPayload)
+	if err != nil {
+		return nil, errors.New("error created inventory history, " + err.Error())
 	}
 
-	getDataProduct.UpdatedAt = now
-	// Update the products quantity
-	updateDataProduct := &entity.PosProduct{
-		ProductID:          uuid.MustParse(getDataProduct.ProductId),                          // auto
-		ProductBarcodeID:   getDataProduct.ProductBarcodeId,                                   // auto
-		ProductName:        getDataProduct.ProductName,                                        // auto
-		Price:              getDataProduct.Price,                                              // auto
-		CostPrice:          getDataProduct.CostPrice,                                          // auto
-		CategoryID:         uuid.MustParse(getDataProduct.CategoryId),                         // auto
-		SubCategoryID:      uuid.MustParse(getDataProduct.SubCategoryId),                      // auto
-		StockQuantity:      int(getDataProduct.StockQuantity) + gormInventoryHistory.Quantity, // auto
-		ReorderLevel:       int(getDataProduct.ReorderLevel),                                  // auto
-		SupplierID:         uuid.MustParse(getDataProduct.SupplierId),                         // auto
-		ProductDescription: getDataProduct.ProductDescription,                                 // auto
-		Active:             getDataProduct.Active,                                             // auto
-		StoreID:            uuid.MustParse(getDataProduct.StoreId),                            // auto
-		BranchID:           nil,                                                               // auto
-		CompanyID:          uuid.MustParse(getDataProduct.CompanyId),                          // auto
-		CreatedAt:          getDataProduct.CreatedAt.AsTime(),                                 // auto
-		CreatedBy:          uuid.MustParse(getDataProduct.CreatedBy),                          // auto
-		UpdatedAt:          getDataProduct.UpdatedAt.AsTime(),                                 // auto
-		UpdatedBy:          uuid.MustParse(req.JwtPayload.UserId),                             // auto
-	}
-
-	updateDataProduct.BranchID = utils.ParseUUID(getDataProduct.BranchId)
-
-	err = s.repoProduct.UpdatePosProduct(updateDataProduct)
+	// Insert the history and adjust the product stock in one transaction
+	err = s.repoInventory.CreatePosInventoryHistoryWithStock(gormInventoryHistory)
 	if err != nil {
 		return nil, err
 	}
 
+	req.PosInventoryHistory.StoreId = gormInventoryHistory.StoreID.String()
+	req.PosInventoryHistory.BranchId = gormInventoryHistory.BranchID.String()
+	req.PosInventoryHistory.CompanyId = gormInventoryHistory.CompanyID.String()
+	req.PosInventoryHistory.CreatedBy = gormInventoryHistory.CreatedBy.String()
+	req.PosInventoryHistory.UpdatedBy = gormInventoryHistory.UpdatedBy.String()
+	req.PosInventoryHistory.UnitCost = gormInventoryHistory.UnitCost
+	req.PosInventoryHistory.CostAmount = gormInventoryHistory.CostAmount
+
 	return &pb.CreatePosInventoryHistoryResponse{
 		PosInventoryHistory: req.PosInventoryHistory,
+		Warning:             gormInventoryHistory.NegativeStockWarning,
 	}, nil
 }
 
@@ -206,7 +225,9 @@ func (s *posInventoryHistoryService) ReadPosInventoryHistory(ctx context.Context
 	}, nil
 }
 
-func (s *posInventoryHistoryService) UpdatePosInventoryHistory(ctx context.Context, req *pb.UpdatePosInventoryHistoryRequest) (*pb.UpdatePosInventoryHistoryResponse, error) {
+// ReversePosInventoryHistory corrects a movement by posting a linked compensating entry, ledger
+// entries are never updated or deleted
+func (s *posInventoryHistoryService) ReversePosInventoryHistory(ctx context.Context, req *pb.ReversePosInventoryHistoryRequest) (*pb.ReversePosInventoryHistoryResponse, error) {
 	// Extract role ID from JWT payload
 	jwtRoleID := req.JwtPayload.Role
 
@@ -217,11 +238,11 @@ func (s *posInventoryHistoryService) UpdatePosInventoryHistory(ctx context.Conte
 	}
 
 	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users are not allowed to read inventory history")
+		return nil, errors.New("users are not allowed to reverse inventory history")
 	}
 
-	// Get the inventory to be updated
-	posInventory, err := s.repoInventory.ReadPosInventoryHistory(req.PosInventoryHistory.InventoryId)
+	// Get the inventory to be reversed
+	posInventory, err := s.repoInventory.ReadPosInventoryHistory(req.InventoryId)
 	if err != nil {
 		return nil, err
 	}
@@ -231,127 +252,36 @@ func (s *posInventoryHistoryService) UpdatePosInventoryHistory(ctx context.Conte
 
 	if loginRole.PosRole.RoleName == companyRole {
 		if !utils.VerifyCompanyUserAccess(loginRole.PosRole.RoleName, posInventory.CompanyId, req.JwtPayload.CompanyId) {
-			return nil, errors.New("company users can only update inventory history within their company")
+			return nil, errors.New("company users can only reverse inventory history within their company")
 		}
 	}
 
 	if loginRole.PosRole.RoleName == branchRole {
 		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posInventory.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("branch users can only update inventory history within their branch")
+			return nil, errors.New("branch users can only reverse inventory history within their branch")
 		}
 	}
 
-	now := timestamppb.New(time.Now())
-	req.PosInventoryHistory.UpdatedAt = now
-
-	// Convert pb.PosInventoryHistory to entity.PosInventoryHistory
-	gormInventoryHistory := &entity.PosInventoryHistory{
-		InventoryID: uuid.MustParse(posInventory.InventoryId), // auto
-		ProductID:   uuid.MustParse(posInventory.ProductId),   // auto
-		StoreID:     nil,
-		Date:        posInventory.Date.AsTime(), // auto
-		Quantity:    int(req.PosInventoryHistory.Quantity),
-		BranchID:    nil,                                        // auto
-		CompanyID:   uuid.MustParse(posInventory.CompanyId),     // auto
-		CreatedAt:   posInventory.CreatedAt.AsTime(),            // auto
-		CreatedBy:   uuid.MustParse(posInventory.CreatedBy),     // auto
-		UpdatedAt:   req.PosInventoryHistory.UpdatedAt.AsTime(), // auto
-		UpdatedBy:   uuid.MustParse(req.JwtPayload.UserId),      // auto
-	}
-
-	gormInventoryHistory.BranchID = utils.ParseUUID(posInventory.BranchId)
-	gormInventoryHistory.StoreID = utils.ParseUUID(posInventory.StoreId)
-
-	err = s.repoInventory.UpdatePosInventoryHistory(gormInventoryHistory)
-	if err != nil {
-		return nil, err
-	}
-
-	// Get data product
-	getDataProduct, err := s.repoProduct.ReadPosProduct(gormInventoryHistory.ProductID.String())
+	reversal, err := s.repoInventory.ReversePosInventoryHistory(req.InventoryId, req.Note, uuid.MustParse(req.JwtPayload.UserId))
 	if err != nil {
 		return nil, err
 	}
 
-	getDataProduct.UpdatedAt = now
-	// Update the products quantity
-	updateDataProduct := &entity.PosProduct{
-		ProductID:          uuid.MustParse(getDataProduct.ProductId),                          // auto
-		ProductBarcodeID:   getDataProduct.ProductBarcodeId,                                   // auto
-		ProductName:        getDataProduct.ProductName,                                        // auto
-		Price:              getDataProduct.Price,                                              // auto
-		CostPrice:          getDataProduct.CostPrice,                                          // auto
-		CategoryID:         uuid.MustParse(getDataProduct.CategoryId),                         // auto
-		SubCategoryID:      uuid.MustParse(getDataProduct.SubCategoryId),                      // auto
-		StockQuantity:      int(getDataProduct.StockQuantity) + gormInventoryHistory.Quantity, // auto
-		ReorderLevel:       int(getDataProduct.ReorderLevel),                                  // auto
-		SupplierID:         uuid.MustParse(getDataProduct.SupplierId),                         // auto
-		ProductDescription: getDataProduct.ProductDescription,                                 // auto
-		Active:             getDataProduct.Active,                                             // auto
-		StoreID:            uuid.MustParse(getDataProduct.StoreId),                            // auto
-		BranchID:           nil,                                                               // auto
-		CompanyID:          uuid.MustParse(getDataProduct.CompanyId),                          // auto
-		CreatedAt:          getDataProduct.CreatedAt.AsTime(),                                 // auto
-		CreatedBy:          uuid.MustParse(getDataProduct.CreatedBy),                          // auto
-		UpdatedAt:          getDataProduct.UpdatedAt.AsTime(),                                 // auto
-		UpdatedBy:          uuid.MustParse(req.JwtPayload.UserId),                             // auto
-	}
-	// set Branch ID base in login role
-	switch loginRole.PosRole.RoleName {
-	case companyRole:
-		// fmt.Println("Product DAta :", getDataProduct.BranchId)
-		updateDataProduct.BranchID = utils.ParseUUID(getDataProduct.BranchId)
-
-		if updateDataProduct.BranchID == nil {
-			return nil, errors.New("error update inventory history, branch id could not be empty")
-		}
-	case branchRole:
-		updateDataProduct.BranchID = utils.ParseUUID(req.JwtPayload.BranchId)
-	}
-
-	// if update req is increase
-	if req.PosInventoryHistory.Quantity >= 0 && getDataProduct.StockQuantity > req.PosInventoryHistory.Quantity {
-		// Check if current inventory quantity is +
-		if posInventory.Quantity > 0 {
-			undoCurrentQuantity := getDataProduct.StockQuantity - posInventory.Quantity
-			repairedQuantity := undoCurrentQuantity + req.PosInventoryHistory.Quantity
-			updateDataProduct.StockQuantity = int(repairedQuantity)
-			// Check if current inventory quantity is -
-		} else if posInventory.Quantity < 0 {
-			undoCurrentQuantity := getDataProduct.StockQuantity + int32(math.Abs(float64(posInventory.Quantity)))
-			repairedQuantity := undoCurrentQuantity + req.PosInventoryHistory.Quantity
-			updateDataProduct.StockQuantity = int(repairedQuantity)
-		}
-
-	}
-
-	// if update req is decrease
-	countCurrentQuantity := getDataProduct.StockQuantity + req.PosInventoryHistory.Quantity
-	if req.PosInventoryHistory.Quantity < 0 && countCurrentQuantity > 0 {
-		// Check if current inventory quantity is +
-		if posInventory.Quantity > 0 {
-			undoCurrentQuantity := getDataProduct.StockQuantity - posInventory.Quantity
-			repairedQuantity := undoCurrentQuantity + req.PosInventoryHistory.Quantity
-			updateDataProduct.StockQuantity = int(repairedQuantity)
-			// Check if current inventory quantity is -
-		} else if posInventory.Quantity < 0 {
-			undoCurrentQuantity := getDataProduct.StockQuantity + int32(math.Abs(float64(posInventory.Quantity)))
-			repairedQuantity := undoCurrentQuantity + req.PosInventoryHistory.Quantity
-			updateDataProduct.StockQuantity = int(repairedQuantity)
-		}
-	}
-
-	err = s.repoProduct.UpdatePosProduct(updateDataProduct)
+	posInventoryHistory, err := s.repoInventory.ReadPosInventoryHistory(reversal.InventoryID.String())
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.UpdatePosInventoryHistoryResponse{
-		PosInventoryHistory: req.PosInventoryHistory,
+	return &pb.ReversePosInventoryHistoryResponse{
+		PosInventoryHistory: posInventoryHistory,
 	}, nil
 }
 
-func (s *posInventoryHistoryService) DeletePosInventoryHistory(ctx context.Context, req *pb.DeletePosInventoryHistoryRequest) (*pb.DeletePosInventoryHistoryResponse, error) {
+func (s *posInventoryHistoryService) ReadAllPosInventoryHistories(ctx context.Context, req *pb.ReadAllPosInventoryHistoriesRequest) (*pb.ReadAllPosInventoryHistoriesResponse, error) {
+	pagination := dto.Pagination{
+		Limit: int(req.Limit),
+		Page:  int(req.Page),
+	}
 	// Extract role ID from JWT payload
 	jwtRoleID := req.JwtPayload.Role
 
@@ -361,94 +291,129 @@ func (s *posInventoryHistoryService) DeletePosInventoryHistory(ctx context.Conte
 		return nil, err
 	}
 
-	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users are not allowed to read inventory history")
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read all inventory history")
 	}
 
-	// Get the inventory to be deleted
-	posInventory, err := s.repoInventory.ReadPosInventoryHistory(req.InventoryId)
-	if err != nil {
-		return nil, err
+	filter := dto.InventoryHistoryFilter{
+		ProductID:    req.ProductId,
+		StoreID:      req.StoreId,
+		BranchID:     req.BranchId,
+		DateFrom:     utils.TimeFromTimestamp(req.DateFrom),
+		DateTo:       utils.TimeFromTimestamp(req.DateTo),
+		QuantitySign: req.QuantitySign,
+		CreatedBy:    req.CreatedBy,
+		SortBy:       req.SortBy,
+		SortOrder:    req.SortOrder,
 	}
 
-	companyRole := os.Getenv("COMPANY_USER_ROLE")
-	branchRole := os.Getenv("BRANCH_USER_ROLE")
-
-	if loginRole.PosRole.RoleName == companyRole {
-		if !utils.VerifyCompanyUserAccess(loginRole.PosRole.RoleName, posInventory.CompanyId, req.JwtPayload.CompanyId) {
-			return nil, errors.New("company users can only delete inventory history within their company")
+	for _, id := range []string{filter.ProductID, filter.StoreID, filter.BranchID, filter.CreatedBy} {
+		if _, err := utils.ParseOptionalUUID(id); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "error read all inventory history, "+err.Error())
 		}
 	}
 
-	if loginRole.PosRole.RoleName == branchRole {
-		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posInventory.BranchId, req.JwtPayload.BranchId) {
-			return nil, errors.New("branch users can only delete inventory history within their branch")
-		}
+	if filter.DateFrom != nil && filter.DateTo != nil && filter.DateFrom.After(*filter.DateTo) {
+		return nil, errors.New("error read all inventory history, date from could not be after date to")
+	}
+
+	if filter.QuantitySign != "" && filter.QuantitySign != "positive" && filter.QuantitySign != "negative" {
+		return nil, errors.New("error read all inventory history, quantity sign must be positive or negative")
+	}
+
+	if filter.SortBy != "" && filter.SortBy != "date" && filter.SortBy != "created_at" && filter.SortBy != "quantity" {
+		return nil, errors.New("error read all inventory history, sort by must be date, created_at or quantity")
+	}
+
+	if filter.SortOrder != "" && filter.SortOrder != "asc" && filter.SortOrder != "desc" {
+		return nil, errors.New("error read all inventory history, sort order must be asc or desc")
 	}
 
-	// Get data product
-	getDataProduct, err := s.repoProduct.ReadPosProduct(posInventory.ProductId)
+	paginationResult, err := s.repoInventory.ReadAllPosInventoryHistories(pagination, loginRole.PosRole.RoleName, req.JwtPayload, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	var setQuantity int
-	// If current inventory history quantity is decreased the product quantity
-	if posInventory.Quantity >= 0 {
-		repairedQuantity := getDataProduct.StockQuantity - posInventory.Quantity
-		if repairedQuantity >= 0 {
-			setQuantity = int(repairedQuantity)
-		} else if repairedQuantity < 0 {
-			return nil, errors.New("cant delete this inventory history, because the current amount of product quantity not sufficient to decrease")
+	posInventoryHistories := paginationResult.Records.([]entity.PosInventoryHistory)
+	pbPosInventoryHistories := make([]*pb.PosInventoryHistory, len(posInventoryHistories))
+
+	for i, posInventoryHistory := range posInventoryHistories {
+		pbPosInventoryHistories[i] = &pb.PosInventoryHistory{
+			InventoryId:     posInventoryHistory.InventoryID.String(),
+			ProductId:       posInventoryHistory.ProductID.String(),
+			StoreId:         posInventoryHistory.StoreID.String(),
+			Date:            timestamppb.New(posInventoryHistory.Date),
+			Quantity:        int32(posInventoryHistory.Quantity),
+			MovementType:    posInventoryHistory.MovementType,
+			Note:            posInventoryHistory.Note,
+			ReferenceNo:     posInventoryHistory.ReferenceNo,
+			LotCode:         posInventoryHistory.LotCode,
+			ExpiryDate:      utils.TimestampFromTime(posInventoryHistory.ExpiryDate),
+			UnitCost:        posInventoryHistory.UnitCost,
+			CostAmount:      posInventoryHistory.CostAmount,
+			TransferId:      utils.UUIDString(posInventoryHistory.TransferID),
+			PurchaseOrderId: utils.UUIDString(posInventoryHistory.PurchaseOrderID),
+			ReversalOfId:    utils.UUIDString(posInventoryHistory.ReversalOfID),
+			SerialNumbers:   posInventoryHistory.SerialNumbers,
+			Unit:            posInventoryHistory.Unit,
+			UnitQuantity:    posInventoryHistory.UnitQuantity,
+			BranchId:        posInventoryHistory.BranchID.String(),
+			CompanyId:       posInventoryHistory.CompanyID.String(),
+			CreatedAt:       timestamppb.New(posInventoryHistory.CreatedAt),
+			CreatedBy:       posInventoryHistory.CreatedBy.String(),
+			UpdatedAt:       timestamppb.New(posInventoryHistory.UpdatedAt),
+			UpdatedBy:       posInventoryHistory.UpdatedBy.String(),
 		}
 	}
-	// if current inventory history quantity is increased the product quantity
-	if posInventory.Quantity < 0 {
-		repairedQuantity := getDataProduct.StockQuantity + int32(math.Abs(float64(posInventory.Quantity)))
-		setQuantity = int(repairedQuantity)
+
+	return &pb.ReadAllPosInventoryHistoriesResponse{
+		PosInventoryHistories: pbPosInventoryHistories,
+		Limit:                 int32(pagination.Limit),
+		Page:                  int32(pagination.Page),
+		MaxPage:               int32(paginationResult.TotalPages),
+		Count:                 paginationResult.TotalRecords,
+	}, nil
+}
+
+func (s *posInventoryHistoryService) GetStockAsOf(ctx context.Context, req *pb.GetStockAsOfRequest) (*pb.GetStockAsOfResponse, error) {
+	pagination := dto.Pagination{
+		Limit: int(req.Limit),
+		Page:  int(req.Page),
 	}
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
 
-	now := timestamppb.New(time.Now())
-	getDataProduct.UpdatedAt = now
-	// Convert pb.PosProduct to entity.PosProduct
-	gormProduct := &entity.PosProduct{
-		ProductID:          uuid.MustParse(getDataProduct.ProductId),
-		ProductBarcodeID:   getDataProduct.ProductBarcodeId,
-		ProductName:        getDataProduct.ProductName,
-		Price:              getDataProduct.Price,
-		CostPrice:          getDataProduct.CostPrice,
-		CategoryID:         uuid.MustParse(getDataProduct.CategoryId),
-		SubCategoryID:      uuid.MustParse(getDataProduct.SubCategoryId),
-		StockQuantity:      setQuantity,
-		ReorderLevel:       int(getDataProduct.ReorderLevel),
-		SupplierID:         uuid.MustParse(getDataProduct.SupplierId),
-		ProductDescription: getDataProduct.ProductDescription,
-		Active:             getDataProduct.Active,
-		StoreID:            uuid.MustParse(getDataProduct.StoreId),
-		BranchID:           utils.ParseUUID(getDataProduct.BranchId),
-		CompanyID:          uuid.MustParse(getDataProduct.CompanyId),
-		CreatedAt:          getDataProduct.CreatedAt.AsTime(),
-		CreatedBy:          uuid.MustParse(getDataProduct.CreatedBy),
-		UpdatedAt:          getDataProduct.UpdatedAt.AsTime(),
-		UpdatedBy:          uuid.MustParse(req.JwtPayload.UserId),
-	}
-
-	err = s.repoProduct.UpdatePosProduct(gormProduct)
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.repoInventory.DeletePosInventoryHistory(req.InventoryId)
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read stock as of a date")
+	}
+
+	asOf := time.Now()
+	if req.AsOf != nil {
+		asOf = req.AsOf.AsTime()
+	}
+
+	paginationResult, err := s.repoInventory.ReadPosStockAsOf(pagination, loginRole.PosRole.RoleName, req.JwtPayload, asOf, req.ProductId, req.StoreId)
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.DeletePosInventoryHistoryResponse{
-		Success: true,
+	return &pb.GetStockAsOfResponse{
+		Stocks:  paginationResult.Records.([]*pb.PosStockAsOf),
+		AsOf:    timestamppb.New(asOf),
+		Limit:   int32(pagination.Limit),
+		Page:    int32(pagination.Page),
+		MaxPage: int32(paginationResult.TotalPages),
+		Count:   paginationResult.TotalRecords,
 	}, nil
 }
 
-func (s *posInventoryHistoryService) ReadAllPosInventoryHistories(ctx context.Context, req *pb.ReadAllPosInventoryHistoriesRequest) (*pb.ReadAllPosInventoryHistoriesResponse, error) {
+func (s *posInventoryHistoryService) ReadPosStockCard(ctx context.Context, req *pb.ReadPosStockCardRequest) (*pb.ReadPosStockCardResponse, error) {
 	pagination := dto.Pagination{
 		Limit: int(req.Limit),
 		Page:  int(req.Page),
@@ -463,38 +428,38 @@ func (s *posInventoryHistoryService) ReadAllPosInventoryHistories(ctx context.Co
 	}
 
 	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
-		return nil, errors.New("users are not allowed to read all inventory history")
+		return nil, errors.New("users are not allowed to read stock card")
 	}
 
-	paginationResult, err := s.repoInventory.ReadAllPosInventoryHistories(pagination, loginRole.PosRole.RoleName, req.JwtPayload)
-	if err != nil {
-		return nil, err
+	if req.ProductId == "" {
+		return nil, errors.New("error read stock card, product id could not be empty")
 	}
 
-	posInventoryHistories := paginationResult.Records.([]entity.PosInventoryHistory)
-	pbPosInventoryHistories := make([]*pb.PosInventoryHistory, len(posInventoryHistories))
+	var from, to *time.Time
+	if req.From != nil {
+		fromTime := req.From.AsTime()
+		from = &fromTime
+	}
+	if req.To != nil {
+		toTime := req.To.AsTime()
+		to = &toTime
+	}
 
-	for i, posInventoryHistory := range posInventoryHistories {
-		pbPosInventoryHistories[i] = &pb.PosInventoryHistory{
-			InventoryId: posInventoryHistory.InventoryID.String(),
-			ProductId:   posInventoryHistory.ProductID.String(),
-			StoreId:     posInventoryHistory.StoreID.String(),
-			Date:        timestamppb.New(posInventoryHistory.Date),
-			Quantity:    int32(posInventoryHistory.Quantity),
-			BranchId:    posInventoryHistory.BranchID.String(),
-			CompanyId:   posInventoryHistory.CompanyID.String(),
-			CreatedAt:   timestamppb.New(posInventoryHistory.CreatedAt),
-			CreatedBy:   posInventoryHistory.CreatedBy.String(),
-			UpdatedAt:   timestamppb.New(posInventoryHistory.UpdatedAt),
-			UpdatedBy:   posInventoryHistory.UpdatedBy.String(),
-		}
+	if from != nil && to != nil && from.After(*to) {
+		return nil, errors.New("error read stock card, from could not be after to")
 	}
 
-	return &pb.ReadAllPosInventoryHistoriesResponse{
-		PosInventoryHistories: pbPosInventoryHistories,
-		Limit:                 int32(pagination.Limit),
-		Page:                  int32(pagination.Page),
-		MaxPage:               int32(paginationResult.TotalPages),
-		Count:                 paginationResult.TotalRecords,
+	paginationResult, openingBalance, err := s.repoInventory.ReadPosStockCard(pagination, loginRole.PosRole.RoleName, req.JwtPayload, req.ProductId, req.StoreId, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadPosStockCardResponse{
+		Entries:        paginationResult.Records.([]*pb.PosStockCardEntry),
+		OpeningBalance: openingBalance,
+		Limit:          int32(pagination.Limit),
+		Page:           int32(pagination.Page),
+		MaxPage:        int32(paginationResult.TotalPages),
+		Count:          paginationResult.TotalRecords,
 	}, nil
 }
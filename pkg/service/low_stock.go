@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+type PosLowStockService interface {
+	ListLowStockProducts(ctx context.Context, req *pb.ListLowStockProductsRequest) (*pb.ListLowStockProductsResponse, error)
+	AcknowledgePosLowStockAlert(ctx context.Context, req *pb.AcknowledgePosLowStockAlertRequest) (*pb.AcknowledgePosLowStockAlertResponse, error)
+	ReadAllPosLowStockAlerts(ctx context.Context, req *pb.ReadAllPosLowStockAlertsRequest) (*pb.ReadAllPosLowStockAlertsResponse, error)
+}
+
+type posLowStockService struct {
+	pb.UnimplementedPosLowStockServiceServer
+	repo               repository.PosLowStockRepository
+	CompanyServiceConn *grpc.ClientConn
+}
+
+func NewPosLowStockService(repo repository.PosLowStockRepository, companyServiceConn *grpc.ClientConn) *posLowStockService {
+	return &posLowStockService{
+		repo:               repo,
+		CompanyServiceConn: companyServiceConn,
+	}
+}
+
+func (s *posLowStockService) ListLowStockProducts(ctx context.Context, req *pb.ListLowStockProductsRequest) (*pb.ListLowStockProductsResponse, error) {
+	pagination := dto.Pagination{
+		Limit: int(req.Limit),
+		Page:  int(req.Page),
+	}
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read low stock products")
+	}
+
+	paginationResult, err := s.repo.ListLowStockProducts(pagination, loginRole.PosRole.RoleName, req.JwtPayload, req.StoreId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListLowStockProductsResponse{
+		PosLowStockProducts: paginationResult.Records.([]*pb.PosLowStockProduct),
+		Limit:               int32(pagination.Limit),
+		Page:                int32(pagination.Page),
+		MaxPage:             int32(paginationResult.TotalPages),
+		Count:               paginationResult.TotalRecords,
+	}, nil
+}
+
+func (s *posLowStockService) AcknowledgePosLowStockAlert(ctx context.Context, req *pb.AcknowledgePosLowStockAlertRequest) (*pb.AcknowledgePosLowStockAlertResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to acknowledge low stock alert")
+	}
+
+	posLowStockAlert, err := s.repo.ReadPosLowStockAlert(req.AlertId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.VerifyStoreScopeAccess(loginRole.PosRole.RoleName, posLowStockAlert.CompanyId, posLowStockAlert.BranchId, posLowStockAlert.StoreId, req.JwtPayload) {
+		return nil, errors.New("users can only acknowledge low stock alert within their company, branch or store")
+	}
+
+	if posLowStockAlert.Status != entity.LowStockAlertStatusOpen {
+		return nil, errors.New("only open low stock alert can be acknowledged")
+	}
+
+	err = s.repo.AcknowledgePosLowStockAlert(req.AlertId, uuid.MustParse(req.JwtPayload.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	posLowStockAlert, err = s.repo.ReadPosLowStockAlert(req.AlertId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AcknowledgePosLowStockAlertResponse{
+		PosLowStockAlert: posLowStockAlert,
+	}, nil
+}
+
+func (s *posLowStockService) ReadAllPosLowStockAlerts(ctx context.Context, req *pb.ReadAllPosLowStockAlertsRequest) (*pb.ReadAllPosLowStockAlertsResponse, error) {
+	pagination := dto.Pagination{
+		Limit: int(req.Limit),
+		Page:  int(req.Page),
+	}
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read all low stock alerts")
+	}
+
+	paginationResult, err := s.repo.ReadAllPosLowStockAlerts(pagination, loginRole.PosRole.RoleName, req.JwtPayload, req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadAllPosLowStockAlertsResponse{
+		PosLowStockAlerts: paginationResult.Records.([]*pb.PosLowStockAlert),
+		Limit:             int32(pagination.Limit),
+		Page:              int32(pagination.Page),
+		MaxPage:           int32(paginationResult.TotalPages),
+		Count:             paginationResult.TotalRecords,
+	}, nil
+}
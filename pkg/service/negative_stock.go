@@ -0,0 +1,331 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+type PosNegativeStockService interface {
+	ReadPosNegativeStockSettings(ctx context.Context, req *pb.ReadPosNegativeStockSettingsRequest) (*pb.ReadPosNegativeStockSettingsResponse, error)
+	ResolvePosNegativeStockPolicy(ctx context.Context, req *pb.ResolvePosNegativeStockPolicyRequest) (*pb.ResolvePosNegativeStockPolicyResponse, error)
+	UpdatePosNegativeStockSetting(ctx context.Context, req *pb.UpdatePosNegativeStockSettingRequest) (*pb.UpdatePosNegativeStockSettingResponse, error)
+	DeletePosNegativeStockSetting(ctx context.Context, req *pb.DeletePosNegativeStockSettingRequest) (*pb.DeletePosNegativeStockSettingResponse, error)
+	ReadAllPosNegativeStockViolations(ctx context.Context, req *pb.ReadAllPosNegativeStockViolationsRequest) (*pb.ReadAllPosNegativeStockViolationsResponse, error)
+	ReviewPosNegativeStockViolation(ctx context.Context, req *pb.ReviewPosNegativeStockViolationRequest) (*pb.ReviewPosNegativeStockViolationResponse, error)
+}
+
+type posNegativeStockService struct {
+	pb.UnimplementedPosNegativeStockServiceServer
+	repo               repository.PosNegativeStockRepository
+	CompanyServiceConn *grpc.ClientConn
+}
+
+func NewPosNegativeStockService(repo repository.PosNegativeStockRepository, companyServiceConn *grpc.ClientConn) *posNegativeStockService {
+	return &posNegativeStockService{
+		repo:               repo,
+		CompanyServiceConn: companyServiceConn,
+	}
+}
+
+func (s *posNegativeStockService) ReadPosNegativeStockSettings(ctx context.Context, req *pb.ReadPosNegativeStockSettingsRequest) (*pb.ReadPosNegativeStockSettingsResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read negative stock settings")
+	}
+
+	// Branch and store users only see the settings that apply to their branch
+	branchID := ""
+	if loginRole.PosRole.RoleName != os.Getenv("COMPANY_USER_ROLE") {
+		branchID = req.JwtPayload.BranchId
+	}
+
+	posNegativeStockSettings, err := s.repo.ReadPosNegativeStockSettings(req.JwtPayload.CompanyId, branchID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadPosNegativeStockSettingsResponse{
+		PosNegativeStockSettings: posNegativeStockSettings,
+	}, nil
+}
+
+func (s *posNegativeStockService) ResolvePosNegativeStockPolicy(ctx context.Context, req *pb.ResolvePosNegativeStockPolicyRequest) (*pb.ResolvePosNegativeStockPolicyResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to resolve negative stock policy")
+	}
+
+	branchRole := os.Getenv("BRANCH_USER_ROLE")
+	storeRole := os.Getenv("STORE_USER_ROLE")
+
+	// set Branch ID Store ID base in login role
+	switch loginRole.PosRole.RoleName {
+	case branchRole:
+		req.BranchId = req.JwtPayload.BranchId
+	case storeRole:
+		req.BranchId = req.JwtPayload.BranchId
+		req.StoreId = req.JwtPayload.StoreId
+	}
+
+	if req.StoreId != "" && req.BranchId == "" {
+		return nil, errors.New("error resolve negative stock policy, branch id of the store could not be empty")
+	}
+
+	return s.repo.ResolveNegativeStockPolicy(req.JwtPayload.CompanyId, req.BranchId, req.StoreId)
+}
+
+func (s *posNegativeStockService) UpdatePosNegativeStockSetting(ctx context.Context, req *pb.UpdatePosNegativeStockSettingRequest) (*pb.UpdatePosNegativeStockSettingResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to update negative stock setting")
+	}
+
+	if req.PosNegativeStockSetting == nil {
+		return nil, errors.New("error update negative stock setting, negative stock setting could not be empty")
+	}
+
+	switch req.PosNegativeStockSetting.Policy {
+	case entity.NegativeStockPolicyForbid, entity.NegativeStockPolicyAllowWarning, entity.NegativeStockPolicyAllowSilently:
+	default:
+		return nil, errors.New("error update negative stock setting, policy must be forbid, allow_warning or allow_silently")
+	}
+
+	companyRole := os.Getenv("COMPANY_USER_ROLE")
+	branchRole := os.Getenv("BRANCH_USER_ROLE")
+
+	now := time.Now()
+	posNegativeStockSetting := &entity.PosNegativeStockSetting{
+		ScopeType: req.PosNegativeStockSetting.ScopeType,
+		Policy:    req.PosNegativeStockSetting.Policy,
+		CompanyID: uuid.MustParse(req.JwtPayload.CompanyId),
+		CreatedAt: now,
+		CreatedBy: uuid.MustParse(req.JwtPayload.UserId),
+		UpdatedAt: now,
+		UpdatedBy: uuid.MustParse(req.JwtPayload.UserId),
+	}
+
+	// Branch users can only set their branch and its stores
+	branchID := req.PosNegativeStockSetting.BranchId
+	if loginRole.PosRole.RoleName == branchRole {
+		branchID = req.JwtPayload.BranchId
+	}
+
+	switch req.PosNegativeStockSetting.ScopeType {
+	case entity.SettingScopeCompany:
+		if loginRole.PosRole.RoleName != companyRole {
+			return nil, errors.New("only company users are allowed to update the company negative stock setting")
+		}
+		posNegativeStockSetting.ScopeID = posNegativeStockSetting.CompanyID
+	case entity.SettingScopeBranch:
+		if loginRole.PosRole.RoleName == branchRole {
+			posNegativeStockSetting.ScopeID = uuid.MustParse(branchID)
+		} else {
+			scopeID := utils.ParseUUID(req.PosNegativeStockSetting.ScopeId)
+			if scopeID == nil {
+				return nil, errors.New("error update negative stock setting, scope id of the branch could not be empty")
+			}
+			posNegativeStockSetting.ScopeID = *scopeID
+		}
+		posNegativeStockSetting.BranchID = &posNegativeStockSetting.ScopeID
+	case entity.SettingScopeStore:
+		scopeID := utils.ParseUUID(req.PosNegativeStockSetting.ScopeId)
+		if scopeID == nil {
+			return nil, errors.New("error update negative stock setting, scope id of the store could not be empty")
+		}
+		posNegativeStockSetting.ScopeID = *scopeID
+		posNegativeStockSetting.BranchID = utils.ParseUUID(branchID)
+		if posNegativeStockSetting.BranchID == nil {
+			return nil, errors.New("error update negative stock setting, branch id of the store could not be empty")
+		}
+	default:
+		return nil, errors.New("error update negative stock setting, scope type must be company, branch or store")
+	}
+
+	// Check if Branch ID is correct and within the company
+	if posNegativeStockSetting.BranchID != nil && loginRole.PosRole.RoleName == companyRole {
+		posStoreBranch, err := utils.GetPosStoreBranchById(s.CompanyServiceConn, posNegativeStockSetting.BranchID.String(), req.JwtPayload)
+		if err != nil {
+			return nil, err
+		}
+		if posStoreBranch.PosStoreBranch.CompanyId != req.JwtPayload.CompanyId {
+			return nil, errors.New("error update negative stock setting, branch is not found within the company")
+		}
+	}
+
+	// A scope keeps its type, a store setting also stays within its branch
+	existingSetting, err := s.repo.ReadPosNegativeStockSetting(posNegativeStockSetting.ScopeID.String())
+	if err == nil {
+		if existingSetting.CompanyId != req.JwtPayload.CompanyId || existingSetting.ScopeType != posNegativeStockSetting.ScopeType ||
+			existingSetting.BranchId != utils.UUIDString(posNegativeStockSetting.BranchID) {
+			return nil, errors.New("error update negative stock setting, scope id is already used by another scope")
+		}
+	}
+
+	err = s.repo.UpdatePosNegativeStockSetting(posNegativeStockSetting)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedSetting, err := s.repo.ReadPosNegativeStockSetting(posNegativeStockSetting.ScopeID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdatePosNegativeStockSettingResponse{
+		PosNegativeStockSetting: updatedSetting,
+	}, nil
+}
+
+func (s *posNegativeStockService) DeletePosNegativeStockSetting(ctx context.Context, req *pb.DeletePosNegativeStockSettingRequest) (*pb.DeletePosNegativeStockSettingResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to delete negative stock setting")
+	}
+
+	// Get the negative stock setting to be deleted
+	posNegativeStockSetting, err := s.repo.ReadPosNegativeStockSetting(req.ScopeId)
+	if err != nil {
+		return nil, err
+	}
+
+	companyRole := os.Getenv("COMPANY_USER_ROLE")
+	branchRole := os.Getenv("BRANCH_USER_ROLE")
+
+	if loginRole.PosRole.RoleName == companyRole {
+		if !utils.VerifyCompanyUserAccess(loginRole.PosRole.RoleName, posNegativeStockSetting.CompanyId, req.JwtPayload.CompanyId) {
+			return nil, errors.New("company users can only delete negative stock setting within their company")
+		}
+	}
+
+	if loginRole.PosRole.RoleName == branchRole {
+		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posNegativeStockSetting.BranchId, req.JwtPayload.BranchId) {
+			return nil, errors.New("branch users can only delete negative stock setting within their branch")
+		}
+	}
+
+	err = s.repo.DeletePosNegativeStockSetting(req.ScopeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeletePosNegativeStockSettingResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *posNegativeStockService) ReadAllPosNegativeStockViolations(ctx context.Context, req *pb.ReadAllPosNegativeStockViolationsRequest) (*pb.ReadAllPosNegativeStockViolationsResponse, error) {
+	pagination := dto.Pagination{
+		Limit: int(req.Limit),
+		Page:  int(req.Page),
+	}
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read all negative stock violations")
+	}
+
+	paginationResult, err := s.repo.ReadAllPosNegativeStockViolations(pagination, loginRole.PosRole.RoleName, req.JwtPayload, req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadAllPosNegativeStockViolationsResponse{
+		PosNegativeStockViolations: paginationResult.Records.([]*pb.PosNegativeStockViolation),
+		Limit:                      int32(pagination.Limit),
+		Page:                       int32(pagination.Page),
+		MaxPage:                    int32(paginationResult.TotalPages),
+		Count:                      paginationResult.TotalRecords,
+	}, nil
+}
+
+func (s *posNegativeStockService) ReviewPosNegativeStockViolation(ctx context.Context, req *pb.ReviewPosNegativeStockViolationRequest) (*pb.ReviewPosNegativeStockViolationResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to review negative stock violation")
+	}
+
+	posNegativeStockViolation, err := s.repo.ReadPosNegativeStockViolation(req.ViolationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.VerifyStoreScopeAccess(loginRole.PosRole.RoleName, posNegativeStockViolation.CompanyId, posNegativeStockViolation.BranchId, posNegativeStockViolation.StoreId, req.JwtPayload) {
+		return nil, errors.New("users can only review negative stock violation within their company or branch")
+	}
+
+	if posNegativeStockViolation.Status != entity.NegativeStockViolationStatusOpen {
+		return nil, errors.New("only open negative stock violation can be reviewed")
+	}
+
+	err = s.repo.ReviewPosNegativeStockViolation(req.ViolationId, req.Note, uuid.MustParse(req.JwtPayload.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	posNegativeStockViolation, err = s.repo.ReadPosNegativeStockViolation(req.ViolationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReviewPosNegativeStockViolationResponse{
+		PosNegativeStockViolation: posNegativeStockViolation,
+	}, nil
+}
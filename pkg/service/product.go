@@ -33,15 +33,21 @@ type posProductService struct {
 	supplierRepo       repository.PosSupplierRepository
 	categoryRepo       repository.PosProductCategoryRepository
 	subCategory        repository.PosProductSubCategoryRepository
+	stockLevelRepo     repository.PosStockLevelRepository
+	reservationRepo    repository.PosStockReservationRepository
+	variantRepo        repository.PosProductVariantRepository
 	CompanyServiceConn *grpc.ClientConn
 }
 
-func NewPosProductService(productRepo repository.PosProductRepository, supplierRepo repository.PosSupplierRepository, categoryRepo repository.PosProductCategoryRepository, subCategory repository.PosProductSubCategoryRepository, companyServiceConn *grpc.ClientConn) *posProductService {
+func NewPosProductService(productRepo repository.PosProductRepository, supplierRepo repository.PosSupplierRepository, categoryRepo repository.PosProductCategoryRepository, subCategory repository.PosProductSubCategoryRepository, stockLevelRepo repository.PosStockLevelRepository, reservationRepo repository.PosStockReservationRepository, variantRepo repository.PosProductVariantRepository, companyServiceConn *grpc.ClientConn) *posProductService {
 	return &posProductService{
 		productRepo:        productRepo,
 		supplierRepo:       supplierRepo,
 		categoryRepo:       categoryRepo,
 		subCategory:        subCategory,
+		stockLevelRepo:     stockLevelRepo,
+		reservationRepo:    reservationRepo,
+		variantRepo:        variantRepo,
 		CompanyServiceConn: companyServiceConn,
 	}
 }
@@ -60,7 +66,14 @@ func (s *posProductService) CreatePosProduct(ctx context.Context, req *pb.Create
 		return nil, errors.New("users are not allowed to create new product")
 	}
 
+	// Numeric barcodes must have a valid check digit, UPC is stored as EAN-13
+	productBarcodeID, err := utils.NormalizeBarcode(req.PosProduct.ProductBarcodeId)
+	if err != nil {
+		return nil, errors.New("error created product, " + err.Error())
+	}
+
 	req.PosProduct.ProductId = uuid.New().String() // Generate a new UUID for the product_id
+	req.PosProduct.ProductBarcodeId = productBarcodeID
 
 	now := timestamppb.New(time.Now())
 	req.PosProduct.CreatedAt = now
@@ -69,7 +82,7 @@ func (s *posProductService) CreatePosProduct(ctx context.Context, req *pb.Create
 	// Convert pb.PosProduct to entity.PosProduct
 	gormProduct := &entity.PosProduct{
 		ProductID:          uuid.MustParse(req.PosProduct.ProductId),
-		ProductBarcodeID:   strings.ToLower(req.PosProduct.ProductBarcodeId),
+		ProductBarcodeID:   productBarcodeID,
 		ProductName:        req.PosProduct.ProductName,
 		Price:              req.PosProduct.Price,
 		CostPrice:          req.PosProduct.CostPrice,
@@ -80,7 +93,9 @@ func (s *posProductService) CreatePosProduct(ctx context.Context, req *pb.Create
 		SupplierID:         uuid.MustParse(req.PosProduct.SupplierId),
 		ProductDescription: req.PosProduct.ProductDescription,
 		Active:             req.PosProduct.Active,
-		StoreID:            uuid.MustParse(req.PosProduct.StoreId),
+		Serialized:         req.PosProduct.Serialized,
+		BaseUnit:           utils.NormalizeUnit(req.PosProduct.BaseUnit),
+		StoreID:            uuid.Nil,
 		BranchID:           nil,
 		CompanyID:          uuid.MustParse(req.JwtPayload.CompanyId), // auto
 		CreatedAt:          req.PosProduct.CreatedAt.AsTime(),        // auto
@@ -89,6 +104,15 @@ func (s *posProductService) CreatePosProduct(ctx context.Context, req *pb.Create
 		UpdatedBy:          uuid.MustParse(req.JwtPayload.UserId),    // auto
 	}
 
+	if gormProduct.BaseUnit == "" {
+		gormProduct.BaseUnit = entity.DefaultBaseUnit
+	}
+
+	// Store ID is optional, stock is kept per store in the stock levels
+	if req.PosProduct.StoreId != "" {
+		gormProduct.StoreID = uuid.MustParse(req.PosProduct.StoreId)
+	}
+
 	companyRole := os.Getenv("COMPANY_USER_ROLE")
 	branchRole := os.Getenv("BRANCH_USER_ROLE")
 
@@ -168,14 +192,44 @@ func (s *posProductService) ReadPosProduct(ctx context.Context, req *pb.ReadPosP
 	}
 
 	if loginRole.PosRole.RoleName == storeRole {
-		if !utils.VerifyStoreUserAccess(loginRole.PosRole.RoleName, posProduct.StoreId, req.JwtPayload.StoreId) {
+		if !utils.VerifyStoreUserAccess(loginRole.PosRole.RoleName, posProduct.BranchId, req.JwtPayload.BranchId) {
 			return nil, errors.New("store users can only retrieve product within their store")
 		}
 	}
 
-	return &pb.ReadPosProductResponse{
+	// Return the option values of a variant
+	if posProduct.ParentProductId != "" {
+		posProduct.VariantValues, err = s.variantRepo.ReadPosProductVariantValues(posProduct.ProductId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Return the stock of the login user store
+	err = s.applyStoreStockLevel(posProduct, req.JwtPayload.StoreId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the stock that is not held by active reservations
+	err = s.applyAvailableQuantity(req.JwtPayload.StoreId, posProduct)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.ReadPosProductResponse{
 		PosProduct: posProduct,
-	}, nil
+	}
+
+	// Return the options and variants of a parent product on request
+	if req.IncludeVariants && posProduct.HasVariants {
+		res.Variants, res.Options, err = s.readVariants(posProduct, req.JwtPayload.StoreId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
 }
 
 func (s *posProductService) ReadPosProductByBarcode(ctx context.Context, req *pb.ReadPosProductByBarcodeRequest) (*pb.ReadPosProductByBarcodeResponse, error) {
@@ -192,7 +246,14 @@ func (s *posProductService) ReadPosProductByBarcode(ctx context.Context, req *pb
 		return nil, errors.New("users are not allowed to read product")
 	}
 
-	posProduct, err := s.productRepo.ReadPosProductBarcode(strings.ToLower(req.ProductBarcodeId))
+	// A scanned UPC finds the product by the EAN-13 it is stored as
+	productBarcodeID, err := utils.NormalizeBarcode(req.ProductBarcodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Barcodes are unique within the company of the login user
+	posProduct, posProductBarcode, embedded, err := s.productRepo.ReadPosProductBarcode(req.JwtPayload.CompanyId, productBarcodeID)
 	if err != nil {
 		return nil, err
 	}
@@ -219,9 +280,41 @@ func (s *posProductService) ReadPosProductByBarcode(ctx context.Context, req *pb
 		}
 	}
 
-	return &pb.ReadPosProductByBarcodeResponse{
+	// Return the option values of a variant
+	if posProduct.ParentProductId != "" {
+		posProduct.VariantValues, err = s.variantRepo.ReadPosProductVariantValues(posProduct.ProductId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Return the stock of the login user store
+	err = s.applyStoreStockLevel(posProduct, req.JwtPayload.StoreId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the stock that is not held by active reservations
+	err = s.applyAvailableQuantity(req.JwtPayload.StoreId, posProduct)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.ReadPosProductByBarcodeResponse{
 		PosProduct: posProduct,
-	}, nil
+		Barcode:    posProductBarcode,
+		Embedded:   embedded,
+	}
+
+	// The barcode of a parent product resolves to its variants to choose from
+	if posProduct.HasVariants {
+		res.Variants, res.Options, err = s.readVariants(posProduct, req.JwtPayload.StoreId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
 }
 
 func (s *posProductService) UpdatePosProduct(ctx context.Context, req *pb.UpdatePosProductRequest) (*pb.UpdatePosProductResponse, error) {
@@ -259,6 +352,30 @@ func (s *posProductService) UpdatePosProduct(ctx context.Context, req *pb.Update
 		}
 	}
 
+	// Units already in stock have no serial numbers to track
+	if req.PosProduct.Serialized != posProduct.Serialized && posProduct.StockQuantity != 0 {
+		return nil, errors.New("error update product, serialized can only be changed while the product has no stock")
+	}
+
+	// The stock is kept in the base unit, it can not be reinterpreted in another unit
+	baseUnit := utils.NormalizeUnit(req.PosProduct.BaseUnit)
+	if baseUnit == "" {
+		baseUnit = posProduct.BaseUnit
+	}
+	if baseUnit != posProduct.BaseUnit && posProduct.StockQuantity != 0 {
+		return nil, errors.New("error update product, base unit can only be changed while the product has no stock")
+	}
+
+	// A variant overrides the price of its parent once it is given another price
+	priceOverride := false
+	if posProduct.ParentProductId != "" {
+		parent, err := s.productRepo.ReadPosProduct(posProduct.ParentProductId)
+		if err != nil {
+			return nil, err
+		}
+		priceOverride = req.PosProduct.Price != parent.Price
+	}
+
 	now := timestamppb.New(time.Now())
 	req.PosProduct.UpdatedAt = now
 
@@ -268,15 +385,20 @@ func (s *posProductService) UpdatePosProduct(ctx context.Context, req *pb.Update
 		ProductBarcodeID:   strings.ToLower(posProduct.ProductBarcodeId), // auto
 		ProductName:        req.PosProduct.ProductName,
 		Price:              req.PosProduct.Price,
-		CostPrice:          req.PosProduct.CostPrice,
+		CostPrice:          posProduct.CostPrice,                     // auto, not written
 		CategoryID:         uuid.MustParse(posProduct.CategoryId),    // auto
 		SubCategoryID:      uuid.MustParse(posProduct.SubCategoryId), // auto
-		StockQuantity:      int(posProduct.StockQuantity),            // auto
+		StockQuantity:      int(posProduct.StockQuantity),            // auto, not written
 		ReorderLevel:       int(req.PosProduct.ReorderLevel),
 		SupplierID:         uuid.MustParse(req.PosProduct.SupplierId),
 		ProductDescription: req.PosProduct.ProductDescription,
 		Active:             req.PosProduct.Active,
-		StoreID:            uuid.MustParse(req.PosProduct.StoreId),
+		Serialized:         req.PosProduct.Serialized,
+		BaseUnit:           baseUnit,
+		ParentProductID:    utils.ParseUUID(posProduct.ParentProductId), // auto
+		HasVariants:        posProduct.HasVariants,                      // auto
+		PriceOverride:      priceOverride,
+		StoreID:            uuid.Nil,
 		BranchID:           nil,                                   // auto
 		CompanyID:          uuid.MustParse(posProduct.CompanyId),  // auto
 		CreatedAt:          posProduct.CreatedAt.AsTime(),         // auto
@@ -285,6 +407,11 @@ func (s *posProductService) UpdatePosProduct(ctx context.Context, req *pb.Update
 		UpdatedBy:          uuid.MustParse(req.JwtPayload.UserId), // auto
 	}
 
+	// Store ID is optional, stock is kept per store in the stock levels
+	if req.PosProduct.StoreId != "" {
+		gormProduct.StoreID = uuid.MustParse(req.PosProduct.StoreId)
+	}
+
 	// Set Branch ID From Databse
 	gormProduct.BranchID = utils.ParseUUID(posProduct.BranchId)
 	err = s.productRepo.UpdatePosProduct(gormProduct)
@@ -292,6 +419,18 @@ func (s *posProductService) UpdatePosProduct(ctx context.Context, req *pb.Update
 		return nil, err
 	}
 
+	// Variants that do not override the price follow the price of their parent
+	if gormProduct.HasVariants && gormProduct.Price != posProduct.Price {
+		err = s.variantRepo.UpdatePosProductVariantPrices(gormProduct.ProductID, gormProduct.Price, gormProduct.UpdatedBy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The stock quantity and the cost price are not updated by the request
+	req.PosProduct.StockQuantity = posProduct.StockQuantity
+	req.PosProduct.CostPrice = posProduct.CostPrice
+
 	return &pb.UpdatePosProductResponse{
 		PosProduct: req.PosProduct,
 	}, nil
@@ -332,6 +471,16 @@ func (s *posProductService) DeletePosProduct(ctx context.Context, req *pb.Delete
 		}
 	}
 
+	if posProduct.HasVariants {
+		posProductVariants, err := s.variantRepo.ReadPosProductVariants(posProduct.ProductId)
+		if err != nil {
+			return nil, err
+		}
+		if len(posProductVariants) > 0 {
+			return nil, errors.New("error delete product, the variants of the product have to be deleted first")
+		}
+	}
+
 	err = s.productRepo.DeletePosProduct(req.ProductId)
 	if err != nil {
 		return nil, err
@@ -382,6 +531,11 @@ func (s *posProductService) ReadAllPosProducts(ctx context.Context, req *pb.Read
 			SupplierId:         posProduct.SupplierID.String(),
 			ProductDescription: posProduct.ProductDescription,
 			Active:             posProduct.Active,
+			Serialized:         posProduct.Serialized,
+			BaseUnit:           posProduct.BaseUnit,
+			ParentProductId:    utils.UUIDString(posProduct.ParentProductID),
+			HasVariants:        posProduct.HasVariants,
+			PriceOverride:      posProduct.PriceOverride,
 			StoreId:            posProduct.StoreID.String(),
 			BranchId:           posProduct.BranchID.String(),
 			CompanyId:          posProduct.CompanyID.String(),
@@ -392,6 +546,34 @@ func (s *posProductService) ReadAllPosProducts(ctx context.Context, req *pb.Read
 		}
 	}
 
+	// Return the stock of the login user store
+	if req.JwtPayload.StoreId != "" {
+		productIDs := make([]string, len(pbPosProducts))
+		for i, pbPosProduct := range pbPosProducts {
+			productIDs[i] = pbPosProduct.ProductId
+		}
+
+		posStockLevels, err := s.stockLevelRepo.ReadPosStockLevelsByProducts(req.JwtPayload.StoreId, productIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pbPosProduct := range pbPosProducts {
+			posStockLevel := posStockLevels[pbPosProduct.ProductId]
+			pbPosProduct.StockQuantity = int32(posStockLevel.Quantity)
+			if posStockLevel.ReorderLevel > 0 {
+				pbPosProduct.ReorderLevel = int32(posStockLevel.ReorderLevel)
+			}
+			pbPosProduct.StoreId = req.JwtPayload.StoreId
+		}
+	}
+
+	// Return the stock that is not held by active reservations
+	err = s.applyAvailableQuantity(req.JwtPayload.StoreId, pbPosProducts...)
+	if err != nil {
+		return nil, err
+	}
+
 	return &pb.ReadAllPosProductsResponse{
 		PosProducts: pbPosProducts,
 		Limit:       int32(pagination.Limit),
@@ -400,3 +582,69 @@ func (s *posProductService) ReadAllPosProducts(ctx context.Context, req *pb.Read
 		Count:       paginationResult.TotalRecords,
 	}, nil
 }
+
+// applyStoreStockLevel replaces the product total stock with the stock of the given store
+func (s *posProductService) applyStoreStockLevel(posProduct *pb.PosProduct, storeID string) error {
+	if storeID == "" {
+		return nil
+	}
+
+	posStockLevel, err := s.stockLevelRepo.ReadPosStockLevel(posProduct.ProductId, storeID)
+	if err != nil {
+		return err
+	}
+
+	posProduct.StockQuantity = posStockLevel.Quantity
+	if posStockLevel.ReorderLevel > 0 {
+		posProduct.ReorderLevel = posStockLevel.ReorderLevel
+	}
+	posProduct.StoreId = storeID
+
+	return nil
+}
+
+// applyAvailableQuantity sets the stock quantity minus the quantity held by active reservations
+// of the given store, or of all stores when no store is given
+func (s *posProductService) applyAvailableQuantity(storeID string, posProducts ...*pb.PosProduct) error {
+	productIDs := make([]string, len(posProducts))
+	for i, posProduct := range posProducts {
+		productIDs[i] = posProduct.ProductId
+	}
+
+	reservedQuantities, err := s.reservationRepo.ReadReservedQuantities(storeID, productIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, posProduct := range posProducts {
+		posProduct.AvailableQuantity = posProduct.StockQuantity - int32(reservedQuantities[posProduct.ProductId])
+	}
+
+	return nil
+}
+
+// readVariants returns the variants of a parent product with the stock of the given store, and
+// the options of the parent
+func (s *posProductService) readVariants(posProduct *pb.PosProduct, storeID string) ([]*pb.PosProduct, []*pb.PosProductOption, error) {
+	posProductOptions, err := s.variantRepo.ReadPosProductOptions(posProduct.ProductId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	posProductVariants, err := s.variantRepo.ReadPosProductVariants(posProduct.ProductId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, posProductVariant := range posProductVariants {
+		if err := s.applyStoreStockLevel(posProductVariant, storeID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := s.applyAvailableQuantity(storeID, posProductVariants...); err != nil {
+		return nil, nil, err
+	}
+
+	return posProductVariants, posProductOptions, nil
+}
@@ -0,0 +1,310 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+// defaultInternalBarcodePrefixRange is used when INTERNAL_BARCODE_PREFIX_RANGE is not set, GS1
+// keeps the EAN-13 prefixes 040-049 for numbers used within a company
+const defaultInternalBarcodePrefixRange = "040-049"
+
+type PosProductBarcodeService interface {
+	CreatePosProductBarcode(ctx context.Context, req *pb.CreatePosProductBarcodeRequest) (*pb.CreatePosProductBarcodeResponse, error)
+	DeletePosProductBarcode(ctx context.Context, req *pb.DeletePosProductBarcodeRequest) (*pb.DeletePosProductBarcodeResponse, error)
+	ReadAllPosProductBarcodes(ctx context.Context, req *pb.ReadAllPosProductBarcodesRequest) (*pb.ReadAllPosProductBarcodesResponse, error)
+	GenerateInternalBarcode(ctx context.Context, req *pb.GenerateInternalBarcodeRequest) (*pb.GenerateInternalBarcodeResponse, error)
+}
+
+type posProductBarcodeService struct {
+	pb.UnimplementedPosProductBarcodeServiceServer
+	repo               repository.PosProductBarcodeRepository
+	repoProduct        repository.PosProductRepository
+	CompanyServiceConn *grpc.ClientConn
+}
+
+func NewPosProductBarcodeService(repo repository.PosProductBarcodeRepository, repoProduct repository.PosProductRepository, companyServiceConn *grpc.ClientConn) *posProductBarcodeService {
+	return &posProductBarcodeService{
+		repo:               repo,
+		repoProduct:        repoProduct,
+		CompanyServiceConn: companyServiceConn,
+	}
+}
+
+func (s *posProductBarcodeService) CreatePosProductBarcode(ctx context.Context, req *pb.CreatePosProductBarcodeRequest) (*pb.CreatePosProductBarcodeResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to create new product barcode")
+	}
+
+	if req.PosProductBarcode == nil {
+		return nil, errors.New("error create product barcode, product barcode could not be empty")
+	}
+
+	posProduct, err := s.readAccessibleProduct(loginRole.PosRole.RoleName, req.PosProductBarcode.ProductId, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	barcode, barcodeType, quantity, err := validateProductBarcode(req.PosProductBarcode)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	entityProductBarcode := &entity.PosProductBarcode{
+		BarcodeID:   uuid.New(),                            // auto
+		ProductID:   uuid.MustParse(posProduct.ProductId),  // auto
+		Barcode:     barcode,                               // required
+		BarcodeType: barcodeType,                           // optional
+		Quantity:    quantity,                              // required for case barcodes
+		CompanyID:   uuid.MustParse(posProduct.CompanyId),  // auto
+		CreatedAt:   now,                                   // auto
+		CreatedBy:   uuid.MustParse(req.JwtPayload.UserId), // auto
+		UpdatedAt:   now,                                   // auto
+		UpdatedBy:   uuid.MustParse(req.JwtPayload.UserId), // auto
+	}
+
+	err = s.repo.CreatePosProductBarcode(entityProductBarcode)
+	if err != nil {
+		return nil, err
+	}
+
+	posProductBarcode, err := s.repo.ReadPosProductBarcode(entityProductBarcode.BarcodeID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreatePosProductBarcodeResponse{
+		PosProductBarcode: posProductBarcode,
+	}, nil
+}
+
+func (s *posProductBarcodeService) DeletePosProductBarcode(ctx context.Context, req *pb.DeletePosProductBarcodeRequest) (*pb.DeletePosProductBarcodeResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to delete product barcode")
+	}
+
+	// Get the product barcode to be deleted
+	posProductBarcode, err := s.repo.ReadPosProductBarcode(req.BarcodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	posProduct, err := s.readAccessibleProduct(loginRole.PosRole.RoleName, posProductBarcode.ProductId, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if posProductBarcode.Barcode == posProduct.ProductBarcodeId {
+		return nil, errors.New("error delete product barcode, the barcode the product is created with can not be deleted")
+	}
+
+	err = s.repo.DeletePosProductBarcode(req.BarcodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeletePosProductBarcodeResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *posProductBarcodeService) ReadAllPosProductBarcodes(ctx context.Context, req *pb.ReadAllPosProductBarcodesRequest) (*pb.ReadAllPosProductBarcodesResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read product barcodes")
+	}
+
+	if req.ProductId == "" {
+		return nil, errors.New("error read product barcodes, product id could not be empty")
+	}
+
+	posProduct, err := s.readAccessibleProduct(loginRole.PosRole.RoleName, req.ProductId, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	posProductBarcodes, err := s.repo.ReadAllPosProductBarcodes(posProduct.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadAllPosProductBarcodesResponse{
+		PosProductBarcodes: posProductBarcodes,
+	}, nil
+}
+
+func (s *posProductBarcodeService) GenerateInternalBarcode(ctx context.Context, req *pb.GenerateInternalBarcodeRequest) (*pb.GenerateInternalBarcodeResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to generate internal barcode")
+	}
+
+	prefixFrom, prefixTo := internalBarcodePrefixRange()
+	companyID := uuid.MustParse(req.JwtPayload.CompanyId)
+
+	// Without a product the barcode is only allocated, e.g. to print a label before the product is created
+	var entityProductBarcode *entity.PosProductBarcode
+	if req.ProductId != "" {
+		posProduct, err := s.readAccessibleProduct(loginRole.PosRole.RoleName, req.ProductId, req.JwtPayload)
+		if err != nil {
+			return nil, err
+		}
+
+		now := time.Now()
+		companyID = uuid.MustParse(posProduct.CompanyId)
+		entityProductBarcode = &entity.PosProductBarcode{
+			BarcodeID:   uuid.New(),                            // auto
+			ProductID:   uuid.MustParse(posProduct.ProductId),  // auto
+			BarcodeType: entity.BarcodeTypeInternal,            // auto
+			Quantity:    1,                                     // auto
+			CompanyID:   companyID,                             // auto
+			CreatedAt:   now,                                   // auto
+			CreatedBy:   uuid.MustParse(req.JwtPayload.UserId), // auto
+			UpdatedAt:   now,                                   // auto
+			UpdatedBy:   uuid.MustParse(req.JwtPayload.UserId), // auto
+		}
+	}
+
+	barcode, err := s.repo.GenerateInternalBarcode(companyID, prefixFrom, prefixTo, entityProductBarcode)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.GenerateInternalBarcodeResponse{
+		Barcode: barcode,
+	}
+
+	if entityProductBarcode != nil {
+		res.PosProductBarcode, err = s.repo.ReadPosProductBarcode(entityProductBarcode.BarcodeID.String())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// readAccessibleProduct returns the product when it is within the company, or within the branch
+// for branch and store users
+func (s *posProductBarcodeService) readAccessibleProduct(roleName string, productID string, jwtPayload *pb.JWTPayload) (*pb.PosProduct, error) {
+	posProduct, err := s.repoProduct.ReadPosProduct(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch roleName {
+	case os.Getenv("COMPANY_USER_ROLE"):
+		if !utils.VerifyCompanyUserAccess(roleName, posProduct.CompanyId, jwtPayload.CompanyId) {
+			return nil, errors.New("company users can only access product barcodes within their company")
+		}
+	case os.Getenv("BRANCH_USER_ROLE"):
+		if !utils.VerifyBranchUserAccess(roleName, posProduct.BranchId, jwtPayload.BranchId) {
+			return nil, errors.New("branch users can only access product barcodes within their branch")
+		}
+	case os.Getenv("STORE_USER_ROLE"):
+		if !utils.VerifyStoreUserAccess(roleName, posProduct.BranchId, jwtPayload.BranchId) {
+			return nil, errors.New("store users can only access product barcodes within their branch")
+		}
+	}
+
+	return posProduct, nil
+}
+
+// validateProductBarcode checks the barcode, type and quantity of a product barcode and returns
+// them normalized. Only a case barcode stands for more than one unit.
+func validateProductBarcode(posProductBarcode *pb.PosProductBarcode) (string, string, int, error) {
+	barcode, err := utils.NormalizeBarcode(posProductBarcode.Barcode)
+	if err != nil {
+		return "", "", 0, errors.New("error product barcode, " + err.Error())
+	}
+	if barcode == "" {
+		return "", "", 0, errors.New("error product barcode, barcode could not be empty")
+	}
+
+	barcodeType := strings.ToLower(strings.TrimSpace(posProductBarcode.BarcodeType))
+	if barcodeType == "" {
+		barcodeType = entity.BarcodeTypeManufacturer
+	}
+
+	quantity := int(posProductBarcode.Quantity)
+	switch barcodeType {
+	case entity.BarcodeTypeManufacturer, entity.BarcodeTypeInternal:
+		if quantity == 0 {
+			quantity = 1
+		}
+		if quantity != 1 {
+			return "", "", 0, errors.New("error product barcode, only a case barcode can stand for more than one unit")
+		}
+	case entity.BarcodeTypeCase:
+		if quantity <= 1 {
+			return "", "", 0, errors.New("error product barcode, quantity of a case barcode must be more than one")
+		}
+	default:
+		return "", "", 0, errors.New("error product barcode, barcode type must be manufacturer, internal or case")
+	}
+
+	return barcode, barcodeType, quantity, nil
+}
+
+// internalBarcodePrefixRange returns the prefix range configured in INTERNAL_BARCODE_PREFIX_RANGE,
+// e.g. 040-049
+func internalBarcodePrefixRange() (string, string) {
+	value := os.Getenv("INTERNAL_BARCODE_PREFIX_RANGE")
+	if value != "" {
+		from, to, err := utils.ParseBarcodePrefixRange(value)
+		if err == nil {
+			return from, to
+		}
+		log.Printf("invalid INTERNAL_BARCODE_PREFIX_RANGE %q, using %s: %v", value, defaultInternalBarcodePrefixRange, err)
+	}
+
+	from, to, _ := utils.ParseBarcodePrefixRange(defaultInternalBarcodePrefixRange)
+	return from, to
+}
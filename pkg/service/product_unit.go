@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+type PosProductUnitService interface {
+	CreatePosProductUnit(ctx context.Context, req *pb.CreatePosProductUnitRequest) (*pb.CreatePosProductUnitResponse, error)
+	UpdatePosProductUnit(ctx context.Context, req *pb.UpdatePosProductUnitRequest) (*pb.UpdatePosProductUnitResponse, error)
+	DeletePosProductUnit(ctx context.Context, req *pb.DeletePosProductUnitRequest) (*pb.DeletePosProductUnitResponse, error)
+	ReadAllPosProductUnits(ctx context.Context, req *pb.ReadAllPosProductUnitsRequest) (*pb.ReadAllPosProductUnitsResponse, error)
+}
+
+type posProductUnitService struct {
+	pb.UnimplementedPosProductUnitServiceServer
+	repo               repository.PosProductUnitRepository
+	repoProduct        repository.PosProductRepository
+	CompanyServiceConn *grpc.ClientConn
+}
+
+func NewPosProductUnitService(repo repository.PosProductUnitRepository, repoProduct repository.PosProductRepository, companyServiceConn *grpc.ClientConn) *posProductUnitService {
+	return &posProductUnitService{
+		repo:               repo,
+		repoProduct:        repoProduct,
+		CompanyServiceConn: companyServiceConn,
+	}
+}
+
+func (s *posProductUnitService) CreatePosProductUnit(ctx context.Context, req *pb.CreatePosProductUnitRequest) (*pb.CreatePosProductUnitResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to create new product unit")
+	}
+
+	if req.PosProductUnit == nil {
+		return nil, errors.New("error create product unit, product unit could not be empty")
+	}
+
+	posProduct, err := s.readAccessibleProduct(loginRole.PosRole.RoleName, req.PosProductUnit.ProductId, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	unit, err := validateProductUnit(posProduct, req.PosProductUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	entityProductUnit := &entity.PosProductUnit{
+		ProductUnitID: uuid.New(),                            // auto
+		ProductID:     uuid.MustParse(posProduct.ProductId),  // auto
+		Unit:          unit,                                  // required
+		Factor:        req.PosProductUnit.Factor,             // required
+		AllowFraction: req.PosProductUnit.AllowFraction,      // optional
+		CompanyID:     uuid.MustParse(posProduct.CompanyId),  // auto
+		CreatedAt:     now,                                   // auto
+		CreatedBy:     uuid.MustParse(req.JwtPayload.UserId), // auto
+		UpdatedAt:     now,                                   // auto
+		UpdatedBy:     uuid.MustParse(req.JwtPayload.UserId), // auto
+	}
+
+	err = s.repo.CreatePosProductUnit(entityProductUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	posProductUnit, err := s.repo.ReadPosProductUnit(entityProductUnit.ProductUnitID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreatePosProductUnitResponse{
+		PosProductUnit: posProductUnit,
+	}, nil
+}
+
+func (s *posProductUnitService) UpdatePosProductUnit(ctx context.Context, req *pb.UpdatePosProductUnitRequest) (*pb.UpdatePosProductUnitResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to update product unit")
+	}
+
+	if req.PosProductUnit == nil {
+		return nil, errors.New("error update product unit, product unit could not be empty")
+	}
+
+	// Get the product unit to be updated
+	posProductUnitData, err := s.repo.ReadPosProductUnit(req.PosProductUnit.ProductUnitId)
+	if err != nil {
+		return nil, err
+	}
+
+	posProduct, err := s.readAccessibleProduct(loginRole.PosRole.RoleName, posProductUnitData.ProductId, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	unit, err := validateProductUnit(posProduct, req.PosProductUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	entityProductUnit := &entity.PosProductUnit{
+		ProductUnitID: uuid.MustParse(posProductUnitData.ProductUnitId), // auto
+		ProductID:     uuid.MustParse(posProductUnitData.ProductId),     // auto
+		Unit:          unit,
+		Factor:        req.PosProductUnit.Factor,
+		AllowFraction: req.PosProductUnit.AllowFraction,
+		CompanyID:     uuid.MustParse(posProductUnitData.CompanyId), // auto
+		CreatedAt:     posProductUnitData.CreatedAt.AsTime(),        // auto
+		CreatedBy:     uuid.MustParse(posProductUnitData.CreatedBy), // auto
+		UpdatedAt:     time.Now(),                                   // auto
+		UpdatedBy:     uuid.MustParse(req.JwtPayload.UserId),        // auto
+	}
+
+	err = s.repo.UpdatePosProductUnit(entityProductUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	posProductUnit, err := s.repo.ReadPosProductUnit(entityProductUnit.ProductUnitID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdatePosProductUnitResponse{
+		PosProductUnit: posProductUnit,
+	}, nil
+}
+
+func (s *posProductUnitService) DeletePosProductUnit(ctx context.Context, req *pb.DeletePosProductUnitRequest) (*pb.DeletePosProductUnitResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to delete product unit")
+	}
+
+	// Get the product unit to be deleted
+	posProductUnitData, err := s.repo.ReadPosProductUnit(req.ProductUnitId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.readAccessibleProduct(loginRole.PosRole.RoleName, posProductUnitData.ProductId, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.DeletePosProductUnit(req.ProductUnitId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeletePosProductUnitResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *posProductUnitService) ReadAllPosProductUnits(ctx context.Context, req *pb.ReadAllPosProductUnitsRequest) (*pb.ReadAllPosProductUnitsResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read product units")
+	}
+
+	if req.ProductId == "" {
+		return nil, errors.New("error read product units, product id could not be empty")
+	}
+
+	posProduct, err := s.readAccessibleProduct(loginRole.PosRole.RoleName, req.ProductId, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	posProductUnits, err := s.repo.ReadAllPosProductUnits(posProduct.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	baseUnit := posProduct.BaseUnit
+	if baseUnit == "" {
+		baseUnit = entity.DefaultBaseUnit
+	}
+
+	return &pb.ReadAllPosProductUnitsResponse{
+		PosProductUnits: posProductUnits,
+		BaseUnit:        baseUnit,
+	}, nil
+}
+
+// readAccessibleProduct returns the product when it is within the company, or within the branch
+// for branch and store users
+func (s *posProductUnitService) readAccessibleProduct(roleName string, productID string, jwtPayload *pb.JWTPayload) (*pb.PosProduct, error) {
+	posProduct, err := s.repoProduct.ReadPosProduct(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch roleName {
+	case os.Getenv("COMPANY_USER_ROLE"):
+		if !utils.VerifyCompanyUserAccess(roleName, posProduct.CompanyId, jwtPayload.CompanyId) {
+			return nil, errors.New("company users can only access product units within their company")
+		}
+	case os.Getenv("BRANCH_USER_ROLE"):
+		if !utils.VerifyBranchUserAccess(roleName, posProduct.BranchId, jwtPayload.BranchId) {
+			return nil, errors.New("branch users can only access product units within their branch")
+		}
+	case os.Getenv("STORE_USER_ROLE"):
+		if !utils.VerifyStoreUserAccess(roleName, posProduct.BranchId, jwtPayload.BranchId) {
+			return nil, errors.New("store users can only access product units within their branch")
+		}
+	}
+
+	return posProduct, nil
+}
+
+// validateProductUnit checks the unit and factor of a product unit and returns the normalized unit
+func validateProductUnit(posProduct *pb.PosProduct, posProductUnit *pb.PosProductUnit) (string, error) {
+	unit := utils.NormalizeUnit(posProductUnit.Unit)
+	if unit == "" {
+		return "", errors.New("error product unit, unit could not be empty")
+	}
+
+	baseUnit := posProduct.BaseUnit
+	if baseUnit == "" {
+		baseUnit = entity.DefaultBaseUnit
+	}
+	if unit == baseUnit {
+		return "", errors.New("error product unit, unit could not be the base unit of the product")
+	}
+
+	if posProductUnit.Factor <= 0 {
+		return "", errors.New("error product unit, factor must be positive")
+	}
+
+	return unit, nil
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosProductVariantService interface {
+	SetPosProductOptions(ctx context.Context, req *pb.SetPosProductOptionsRequest) (*pb.SetPosProductOptionsResponse, error)
+	CreatePosProductVariant(ctx context.Context, req *pb.CreatePosProductVariantRequest) (*pb.CreatePosProductVariantResponse, error)
+}
+
+type posProductVariantService struct {
+	pb.UnimplementedPosProductVariantServiceServer
+	repo               repository.PosProductVariantRepository
+	repoProduct        repository.PosProductRepository
+	CompanyServiceConn *grpc.ClientConn
+}
+
+func NewPosProductVariantService(repo repository.PosProductVariantRepository, repoProduct repository.PosProductRepository, companyServiceConn *grpc.ClientConn) *posProductVariantService {
+	return &posProductVariantService{
+		repo:               repo,
+		repoProduct:        repoProduct,
+		CompanyServiceConn: companyServiceConn,
+	}
+}
+
+func (s *posProductVariantService) SetPosProductOptions(ctx context.Context, req *pb.SetPosProductOptionsRequest) (*pb.SetPosProductOptionsResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to set product options")
+	}
+
+	posProduct, err := s.readAccessibleProduct(loginRole.PosRole.RoleName, req.ProductId, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	optionNames := make([]string, len(req.OptionNames))
+	seen := make(map[string]bool, len(req.OptionNames))
+	for i, optionName := range req.OptionNames {
+		optionName = strings.TrimSpace(optionName)
+		if optionName == "" {
+			return nil, errors.New("error set product options, option name could not be empty")
+		}
+		if seen[strings.ToLower(optionName)] {
+			return nil, errors.New("error set product options, option " + optionName + " is given twice")
+		}
+		seen[strings.ToLower(optionName)] = true
+		optionNames[i] = optionName
+	}
+
+	posProductOptions, err := s.repo.SetPosProductOptions(uuid.MustParse(posProduct.ProductId), optionNames, uuid.MustParse(req.JwtPayload.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetPosProductOptionsResponse{
+		Options: posProductOptions,
+	}, nil
+}
+
+func (s *posProductVariantService) CreatePosProductVariant(ctx context.Context, req *pb.CreatePosProductVariantRequest) (*pb.CreatePosProductVariantResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to create new product variant")
+	}
+
+	if req.PosProduct == nil {
+		return nil, errors.New("error create product variant, product variant could not be empty")
+	}
+
+	parent, err := s.readAccessibleProduct(loginRole.PosRole.RoleName, req.ParentProductId, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if parent.ParentProductId != "" {
+		return nil, errors.New("error create product variant, a variant can not have variants")
+	}
+
+	barcode, err := utils.NormalizeBarcode(req.PosProduct.ProductBarcodeId)
+	if err != nil {
+		return nil, errors.New("error create product variant, " + err.Error())
+	}
+	if barcode == "" {
+		return nil, errors.New("error create product variant, barcode could not be empty")
+	}
+
+	values := make(map[string]string, len(req.PosProduct.VariantValues))
+	valueNames := make([]string, 0, len(req.PosProduct.VariantValues))
+	for _, variantValue := range req.PosProduct.VariantValues {
+		optionName := strings.TrimSpace(variantValue.OptionName)
+		value := strings.TrimSpace(variantValue.Value)
+		if value == "" {
+			return nil, errors.New("error create product variant, value of option " + optionName + " could not be empty")
+		}
+		if _, ok := values[optionName]; ok {
+			return nil, errors.New("error create product variant, option " + optionName + " is given twice")
+		}
+		values[optionName] = value
+		valueNames = append(valueNames, value)
+	}
+
+	// The variant takes the fields of its parent it does not set
+	productName := strings.TrimSpace(req.PosProduct.ProductName)
+	if productName == "" {
+		productName = parent.ProductName + " " + strings.Join(valueNames, " / ")
+	}
+
+	price := parent.Price
+	if req.PosProduct.Price > 0 {
+		price = req.PosProduct.Price
+	}
+
+	costPrice := parent.CostPrice
+	if req.PosProduct.CostPrice > 0 {
+		costPrice = req.PosProduct.CostPrice
+	}
+
+	reorderLevel := parent.ReorderLevel
+	if req.PosProduct.ReorderLevel > 0 {
+		reorderLevel = req.PosProduct.ReorderLevel
+	}
+
+	now := time.Now()
+	parentProductID := uuid.MustParse(parent.ProductId)
+	gormProduct := &entity.PosProduct{
+		ProductID:          uuid.New(), // auto
+		ProductBarcodeID:   barcode,
+		ProductName:        productName,
+		Price:              price,
+		CostPrice:          costPrice,
+		CategoryID:         uuid.MustParse(parent.CategoryId),    // auto
+		SubCategoryID:      uuid.MustParse(parent.SubCategoryId), // auto
+		StockQuantity:      0,                                    // auto default 0
+		ReorderLevel:       int(reorderLevel),
+		SupplierID:         uuid.MustParse(parent.SupplierId), // auto
+		ProductDescription: parent.ProductDescription,         // auto
+		Active:             req.PosProduct.Active,
+		Serialized:         parent.Serialized, // auto
+		BaseUnit:           parent.BaseUnit,   // auto
+		ParentProductID:    &parentProductID,  // auto
+		PriceOverride:      req.PosProduct.Price > 0,
+		StoreID:            uuid.Nil,
+		BranchID:           utils.ParseUUID(parent.BranchId),      // auto
+		CompanyID:          uuid.MustParse(parent.CompanyId),      // auto
+		CreatedAt:          now,                                   // auto
+		CreatedBy:          uuid.MustParse(req.JwtPayload.UserId), // auto
+		UpdatedAt:          now,                                   // auto
+		UpdatedBy:          uuid.MustParse(req.JwtPayload.UserId), // auto
+	}
+
+	if req.PosProduct.ProductDescription != "" {
+		gormProduct.ProductDescription = req.PosProduct.ProductDescription
+	}
+
+	err = s.repo.CreatePosProductVariant(gormProduct, values)
+	if err != nil {
+		return nil, err
+	}
+
+	variantValues, err := s.repo.ReadPosProductVariantValues(gormProduct.ProductID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreatePosProductVariantResponse{
+		PosProduct: &pb.PosProduct{
+			ProductId:          gormProduct.ProductID.String(),
+			ProductBarcodeId:   gormProduct.ProductBarcodeID,
+			ProductName:        gormProduct.ProductName,
+			Price:              gormProduct.Price,
+			CostPrice:          gormProduct.CostPrice,
+			CategoryId:         gormProduct.CategoryID.String(),
+			SubCategoryId:      gormProduct.SubCategoryID.String(),
+			ReorderLevel:       int32(gormProduct.ReorderLevel),
+			SupplierId:         gormProduct.SupplierID.String(),
+			ProductDescription: gormProduct.ProductDescription,
+			Active:             gormProduct.Active,
+			Serialized:         gormProduct.Serialized,
+			BaseUnit:           gormProduct.BaseUnit,
+			ParentProductId:    parent.ProductId,
+			PriceOverride:      gormProduct.PriceOverride,
+			VariantValues:      variantValues,
+			BranchId:           parent.BranchId,
+			CompanyId:          parent.CompanyId,
+			CreatedAt:          timestamppb.New(now),
+			CreatedBy:          gormProduct.CreatedBy.String(),
+			UpdatedAt:          timestamppb.New(now),
+			UpdatedBy:          gormProduct.UpdatedBy.String(),
+		},
+	}, nil
+}
+
+// readAccessibleProduct returns the product when it is within the company, or within the branch
+// for branch users
+func (s *posProductVariantService) readAccessibleProduct(roleName string, productID string, jwtPayload *pb.JWTPayload) (*pb.PosProduct, error) {
+	posProduct, err := s.repoProduct.ReadPosProduct(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch roleName {
+	case os.Getenv("COMPANY_USER_ROLE"):
+		if !utils.VerifyCompanyUserAccess(roleName, posProduct.CompanyId, jwtPayload.CompanyId) {
+			return nil, errors.New("company users can only access product variants within their company")
+		}
+	case os.Getenv("BRANCH_USER_ROLE"):
+		if !utils.VerifyBranchUserAccess(roleName, posProduct.BranchId, jwtPayload.BranchId) {
+			return nil, errors.New("branch users can only access product variants within their branch")
+		}
+	}
+
+	return posProduct, nil
+}
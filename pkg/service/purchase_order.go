@@ -0,0 +1,565 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosPurchaseOrderService interface {
+	CreatePosPurchaseOrder(ctx context.Context, req *pb.CreatePosPurchaseOrderRequest) (*pb.CreatePosPurchaseOrderResponse, error)
+	ReadPosPurchaseOrder(ctx context.Context, req *pb.ReadPosPurchaseOrderRequest) (*pb.ReadPosPurchaseOrderResponse, error)
+	UpdatePosPurchaseOrder(ctx context.Context, req *pb.UpdatePosPurchaseOrderRequest) (*pb.UpdatePosPurchaseOrderResponse, error)
+	SubmitPosPurchaseOrder(ctx context.Context, req *pb.SubmitPosPurchaseOrderRequest) (*pb.SubmitPosPurchaseOrderResponse, error)
+	ReceivePosPurchaseOrder(ctx context.Context, req *pb.ReceivePosPurchaseOrderRequest) (*pb.ReceivePosPurchaseOrderResponse, error)
+	CancelPosPurchaseOrder(ctx context.Context, req *pb.CancelPosPurchaseOrderRequest) (*pb.CancelPosPurchaseOrderResponse, error)
+	ReadAllPosPurchaseOrders(ctx context.Context, req *pb.ReadAllPosPurchaseOrdersRequest) (*pb.ReadAllPosPurchaseOrdersResponse, error)
+	GenerateReplenishmentPurchaseOrders(ctx context.Context, req *pb.GenerateReplenishmentPurchaseOrdersRequest) (*pb.GenerateReplenishmentPurchaseOrdersResponse, error)
+}
+
+type posPurchaseOrderService struct {
+	pb.UnimplementedPosPurchaseOrderServiceServer
+	repoPurchaseOrder  repository.PosPurchaseOrderRepository
+	repoProduct        repository.PosProductRepository
+	repoSupplier       repository.PosSupplierRepository
+	CompanyServiceConn *grpc.ClientConn
+}
+
+func NewPosPurchaseOrderService(repoPurchaseOrder repository.PosPurchaseOrderRepository, repoProduct repository.PosProductRepository, repoSupplier repository.PosSupplierRepository, companyServiceConn *grpc.ClientConn) *posPurchaseOrderService {
+	return &posPurchaseOrderService{
+		repoPurchaseOrder:  repoPurchaseOrder,
+		repoProduct:        repoProduct,
+		repoSupplier:       repoSupplier,
+		CompanyServiceConn: companyServiceConn,
+	}
+}
+
+func (s *posPurchaseOrderService) CreatePosPurchaseOrder(ctx context.Context, req *pb.CreatePosPurchaseOrderRequest) (*pb.CreatePosPurchaseOrderResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to create new purchase order")
+	}
+
+	companyRole := os.Getenv("COMPANY_USER_ROLE")
+	branchRole := os.Getenv("BRANCH_USER_ROLE")
+
+	switch loginRole.PosRole.RoleName {
+	case companyRole:
+		if req.PosPurchaseOrder.BranchId == "" {
+			return nil, errors.New("error created purchase order, branch id could not be empty")
+		}
+	case branchRole:
+		req.PosPurchaseOrder.BranchId = req.JwtPayload.BranchId
+	}
+
+	// Check if Branch ID is correct
+	_, err = utils.GetPosStoreBranchById(s.CompanyServiceConn, req.PosPurchaseOrder.BranchId, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyPurchaseOrderSupplier(req.PosPurchaseOrder, req.JwtPayload); err != nil {
+		return nil, err
+	}
+
+	now := timestamppb.New(time.Now())
+	req.PosPurchaseOrder.PurchaseOrderId = uuid.New().String()
+	req.PosPurchaseOrder.Status = entity.PurchaseOrderStatusDraft
+
+	// Convert pb.PosPurchaseOrder to entity.PosPurchaseOrder
+	gormPurchaseOrder := &entity.PosPurchaseOrder{
+		PurchaseOrderID: uuid.MustParse(req.PosPurchaseOrder.PurchaseOrderId), // auto
+		SupplierID:      uuid.MustParse(req.PosPurchaseOrder.SupplierId),
+		StoreID:         uuid.MustParse(req.PosPurchaseOrder.StoreId),
+		BranchID:        uuid.MustParse(req.PosPurchaseOrder.BranchId),
+		Status:          req.PosPurchaseOrder.Status, // auto
+		Note:            req.PosPurchaseOrder.Note,
+		CompanyID:       uuid.MustParse(req.JwtPayload.CompanyId), // auto
+		CreatedAt:       now.AsTime(),                             // auto
+		CreatedBy:       uuid.MustParse(req.JwtPayload.UserId),    // auto
+		UpdatedAt:       now.AsTime(),                             // auto
+		UpdatedBy:       uuid.MustParse(req.JwtPayload.UserId),    // auto
+	}
+
+	gormItems, err := s.buildPurchaseOrderItems(gormPurchaseOrder.PurchaseOrderID, req.PosPurchaseOrder.Items, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repoPurchaseOrder.CreatePosPurchaseOrder(gormPurchaseOrder, gormItems)
+	if err != nil {
+		return nil, err
+	}
+
+	posPurchaseOrder, err := s.repoPurchaseOrder.ReadPosPurchaseOrder(req.PosPurchaseOrder.PurchaseOrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreatePosPurchaseOrderResponse{
+		PosPurchaseOrder: posPurchaseOrder,
+	}, nil
+}
+
+func (s *posPurchaseOrderService) ReadPosPurchaseOrder(ctx context.Context, req *pb.ReadPosPurchaseOrderRequest) (*pb.ReadPosPurchaseOrderResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read purchase order")
+	}
+
+	posPurchaseOrder, err := s.repoPurchaseOrder.ReadPosPurchaseOrder(req.PurchaseOrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.VerifyStoreScopeAccess(loginRole.PosRole.RoleName, posPurchaseOrder.CompanyId, posPurchaseOrder.BranchId, posPurchaseOrder.StoreId, req.JwtPayload) {
+		return nil, errors.New("users can only retrieve purchase order within their company, branch or store")
+	}
+
+	return &pb.ReadPosPurchaseOrderResponse{
+		PosPurchaseOrder: posPurchaseOrder,
+	}, nil
+}
+
+func (s *posPurchaseOrderService) UpdatePosPurchaseOrder(ctx context.Context, req *pb.UpdatePosPurchaseOrderRequest) (*pb.UpdatePosPurchaseOrderResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to update purchase order")
+	}
+
+	posPurchaseOrder, err := s.repoPurchaseOrder.ReadPosPurchaseOrder(req.PosPurchaseOrder.PurchaseOrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.VerifyStoreScopeAccess(loginRole.PosRole.RoleName, posPurchaseOrder.CompanyId, posPurchaseOrder.BranchId, posPurchaseOrder.StoreId, req.JwtPayload) {
+		return nil, errors.New("users can only update purchase order within their company or branch")
+	}
+
+	// The branch of a purchase order can not be changed
+	req.PosPurchaseOrder.BranchId = posPurchaseOrder.BranchId
+
+	if err := s.verifyPurchaseOrderSupplier(req.PosPurchaseOrder, req.JwtPayload); err != nil {
+		return nil, err
+	}
+
+	gormPurchaseOrder := &entity.PosPurchaseOrder{
+		PurchaseOrderID: uuid.MustParse(posPurchaseOrder.PurchaseOrderId),
+		SupplierID:      uuid.MustParse(req.PosPurchaseOrder.SupplierId),
+		StoreID:         uuid.MustParse(req.PosPurchaseOrder.StoreId),
+		Note:            req.PosPurchaseOrder.Note,
+		UpdatedAt:       time.Now(),                            // auto
+		UpdatedBy:       uuid.MustParse(req.JwtPayload.UserId), // auto
+	}
+
+	gormItems, err := s.buildPurchaseOrderItems(gormPurchaseOrder.PurchaseOrderID, req.PosPurchaseOrder.Items, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repoPurchaseOrder.UpdatePosPurchaseOrder(gormPurchaseOrder, gormItems)
+	if err != nil {
+		return nil, err
+	}
+
+	posPurchaseOrder, err = s.repoPurchaseOrder.ReadPosPurchaseOrder(posPurchaseOrder.PurchaseOrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdatePosPurchaseOrderResponse{
+		PosPurchaseOrder: posPurchaseOrder,
+	}, nil
+}
+
+func (s *posPurchaseOrderService) SubmitPosPurchaseOrder(ctx context.Context, req *pb.SubmitPosPurchaseOrderRequest) (*pb.SubmitPosPurchaseOrderResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to submit purchase order")
+	}
+
+	posPurchaseOrder, err := s.repoPurchaseOrder.ReadPosPurchaseOrder(req.PurchaseOrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.VerifyStoreScopeAccess(loginRole.PosRole.RoleName, posPurchaseOrder.CompanyId, posPurchaseOrder.BranchId, posPurchaseOrder.StoreId, req.JwtPayload) {
+		return nil, errors.New("users can only submit purchase order within their company or branch")
+	}
+
+	err = s.repoPurchaseOrder.SubmitPosPurchaseOrder(req.PurchaseOrderId, uuid.MustParse(req.JwtPayload.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	posPurchaseOrder, err = s.repoPurchaseOrder.ReadPosPurchaseOrder(req.PurchaseOrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SubmitPosPurchaseOrderResponse{
+		PosPurchaseOrder: posPurchaseOrder,
+	}, nil
+}
+
+func (s *posPurchaseOrderService) ReceivePosPurchaseOrder(ctx context.Context, req *pb.ReceivePosPurchaseOrderRequest) (*pb.ReceivePosPurchaseOrderResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to receive purchase order")
+	}
+
+	if len(req.Lines) == 0 {
+		return nil, errors.New("error receive purchase order, lines could not be empty")
+	}
+
+	posPurchaseOrder, err := s.repoPurchaseOrder.ReadPosPurchaseOrder(req.PurchaseOrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Store users can only receive the deliveries of their own store
+	if !utils.VerifyStoreScopeAccess(loginRole.PosRole.RoleName, posPurchaseOrder.CompanyId, posPurchaseOrder.BranchId, posPurchaseOrder.StoreId, req.JwtPayload) {
+		return nil, errors.New("users can only receive purchase order within their company, branch or store")
+	}
+
+	lines := make([]repository.PosPurchaseOrderReceiptLine, len(req.Lines))
+	for i, line := range req.Lines {
+		if line.Unit == "" && line.Quantity <= 0 {
+			return nil, errors.New("error receive purchase order, received quantity must be positive")
+		}
+		if line.Unit != "" && line.UnitQuantity <= 0 {
+			return nil, errors.New("error receive purchase order, received unit quantity must be positive")
+		}
+		if line.UnitCost < 0 {
+			return nil, errors.New("error receive purchase order, unit cost could not be negative")
+		}
+
+		lines[i] = repository.PosPurchaseOrderReceiptLine{
+			PurchaseOrderItemID: uuid.MustParse(line.PurchaseOrderItemId),
+			Quantity:            int(line.Quantity),
+			UnitCost:            line.UnitCost,
+			LotCode:             line.LotCode,
+			ExpiryDate:          utils.TimeFromTimestamp(line.ExpiryDate),
+			SerialNumbers:       line.SerialNumbers,
+			Unit:                utils.NormalizeUnit(line.Unit),
+			UnitQuantity:        line.UnitQuantity,
+		}
+	}
+
+	err = s.repoPurchaseOrder.ReceivePosPurchaseOrder(req.PurchaseOrderId, lines, req.Note, uuid.MustParse(req.JwtPayload.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	posPurchaseOrder, err = s.repoPurchaseOrder.ReadPosPurchaseOrder(req.PurchaseOrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReceivePosPurchaseOrderResponse{
+		PosPurchaseOrder: posPurchaseOrder,
+	}, nil
+}
+
+func (s *posPurchaseOrderService) CancelPosPurchaseOrder(ctx context.Context, req *pb.CancelPosPurchaseOrderRequest) (*pb.CancelPosPurchaseOrderResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to cancel purchase order")
+	}
+
+	posPurchaseOrder, err := s.repoPurchaseOrder.ReadPosPurchaseOrder(req.PurchaseOrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.VerifyStoreScopeAccess(loginRole.PosRole.RoleName, posPurchaseOrder.CompanyId, posPurchaseOrder.BranchId, posPurchaseOrder.StoreId, req.JwtPayload) {
+		return nil, errors.New("users can only cancel purchase order within their company or branch")
+	}
+
+	err = s.repoPurchaseOrder.CancelPosPurchaseOrder(req.PurchaseOrderId, uuid.MustParse(req.JwtPayload.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	posPurchaseOrder, err = s.repoPurchaseOrder.ReadPosPurchaseOrder(req.PurchaseOrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CancelPosPurchaseOrderResponse{
+		PosPurchaseOrder: posPurchaseOrder,
+	}, nil
+}
+
+func (s *posPurchaseOrderService) ReadAllPosPurchaseOrders(ctx context.Context, req *pb.ReadAllPosPurchaseOrdersRequest) (*pb.ReadAllPosPurchaseOrdersResponse, error) {
+	pagination := dto.Pagination{
+		Limit: int(req.Limit),
+		Page:  int(req.Page),
+	}
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read all purchase order")
+	}
+
+	paginationResult, err := s.repoPurchaseOrder.ReadAllPosPurchaseOrders(pagination, loginRole.PosRole.RoleName, req.JwtPayload, req.Status, req.SupplierId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadAllPosPurchaseOrdersResponse{
+		PosPurchaseOrders: paginationResult.Records.([]*pb.PosPurchaseOrder),
+		Limit:             int32(pagination.Limit),
+		Page:              int32(pagination.Page),
+		MaxPage:           int32(paginationResult.TotalPages),
+		Count:             paginationResult.TotalRecords,
+	}, nil
+}
+
+// GenerateReplenishmentPurchaseOrders creates one draft purchase order per store and supplier for
+// the products at or below their reorder level. Each product is ordered up to its max level,
+// minus the quantity that is already on open orders.
+func (s *posPurchaseOrderService) GenerateReplenishmentPurchaseOrders(ctx context.Context, req *pb.GenerateReplenishmentPurchaseOrdersRequest) (*pb.GenerateReplenishmentPurchaseOrdersResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to generate replenishment purchase order")
+	}
+
+	companyRole := os.Getenv("COMPANY_USER_ROLE")
+	branchRole := os.Getenv("BRANCH_USER_ROLE")
+
+	switch loginRole.PosRole.RoleName {
+	case companyRole:
+		if req.BranchId == "" {
+			return nil, errors.New("error generate replenishment purchase order, branch id could not be empty")
+		}
+	case branchRole:
+		req.BranchId = req.JwtPayload.BranchId
+	}
+
+	if req.DefaultMaxLevel < 0 {
+		return nil, errors.New("error generate replenishment purchase order, default max level could not be negative")
+	}
+
+	// Check if Branch ID is correct
+	_, err = utils.GetPosStoreBranchById(s.CompanyServiceConn, req.BranchId, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := s.repoPurchaseOrder.ReadReplenishmentCandidates(req.BranchId, req.StoreId)
+	if err != nil {
+		return nil, err
+	}
+
+	type orderKey struct {
+		storeID    uuid.UUID
+		supplierID uuid.UUID
+	}
+
+	now := time.Now()
+	userID := uuid.MustParse(req.JwtPayload.UserId)
+	purchaseOrderIDs := make(map[orderKey]uuid.UUID)
+	var gormPurchaseOrders []entity.PosPurchaseOrder
+	var gormItems []entity.PosPurchaseOrderItem
+
+	for _, candidate := range candidates {
+		maxLevel := candidate.MaxLevel
+		if maxLevel <= 0 {
+			maxLevel = int(req.DefaultMaxLevel)
+		}
+
+		orderQuantity := maxLevel - candidate.Quantity - candidate.OpenQuantity
+		if orderQuantity <= 0 {
+			continue
+		}
+
+		key := orderKey{storeID: candidate.StoreID, supplierID: candidate.SupplierID}
+		purchaseOrderID, ok := purchaseOrderIDs[key]
+		if !ok {
+			purchaseOrderID = uuid.New()
+			purchaseOrderIDs[key] = purchaseOrderID
+
+			gormPurchaseOrders = append(gormPurchaseOrders, entity.PosPurchaseOrder{
+				PurchaseOrderID: purchaseOrderID,
+				SupplierID:      candidate.SupplierID,
+				StoreID:         candidate.StoreID,
+				BranchID:        candidate.BranchID,
+				Status:          entity.PurchaseOrderStatusDraft,
+				Note:            "replenishment",
+				CompanyID:       candidate.CompanyID,
+				CreatedAt:       now,
+				CreatedBy:       userID,
+				UpdatedAt:       now,
+				UpdatedBy:       userID,
+			})
+		}
+
+		gormItems = append(gormItems, entity.PosPurchaseOrderItem{
+			PurchaseOrderItemID: uuid.New(),
+			PurchaseOrderID:     purchaseOrderID,
+			ProductID:           candidate.ProductID,
+			Quantity:            orderQuantity,
+			ReceivedQuantity:    0,
+			UnitCost:            candidate.CostPrice,
+		})
+	}
+
+	posPurchaseOrders := make([]*pb.PosPurchaseOrder, 0, len(gormPurchaseOrders))
+	if len(gormPurchaseOrders) == 0 {
+		return &pb.GenerateReplenishmentPurchaseOrdersResponse{
+			PosPurchaseOrders: posPurchaseOrders,
+		}, nil
+	}
+
+	err = s.repoPurchaseOrder.CreatePosPurchaseOrders(gormPurchaseOrders, gormItems)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, gormPurchaseOrder := range gormPurchaseOrders {
+		posPurchaseOrder, err := s.repoPurchaseOrder.ReadPosPurchaseOrder(gormPurchaseOrder.PurchaseOrderID.String())
+		if err != nil {
+			return nil, err
+		}
+		posPurchaseOrders = append(posPurchaseOrders, posPurchaseOrder)
+	}
+
+	return &pb.GenerateReplenishmentPurchaseOrdersResponse{
+		PosPurchaseOrders: posPurchaseOrders,
+	}, nil
+}
+
+// verifyPurchaseOrderSupplier checks the delivery store is set and the supplier belongs to the
+// branch of the purchase order
+func (s *posPurchaseOrderService) verifyPurchaseOrderSupplier(posPurchaseOrder *pb.PosPurchaseOrder, jwtPayload *pb.JWTPayload) error {
+	if posPurchaseOrder.StoreId == "" {
+		return errors.New("error purchase order, store id could not be empty")
+	}
+
+	if posPurchaseOrder.SupplierId == "" {
+		return errors.New("error purchase order, supplier id could not be empty")
+	}
+
+	posSupplier, err := s.repoSupplier.ReadPosSupplier(posPurchaseOrder.SupplierId)
+	if err != nil {
+		return err
+	}
+
+	if posSupplier.CompanyId != jwtPayload.CompanyId || posSupplier.BranchId != posPurchaseOrder.BranchId {
+		return errors.New("error purchase order, supplier is not found within the branch")
+	}
+
+	return nil
+}
+
+// buildPurchaseOrderItems validates the order lines and converts them to entities
+func (s *posPurchaseOrderService) buildPurchaseOrderItems(purchaseOrderID uuid.UUID, items []*pb.PosPurchaseOrderItem, jwtPayload *pb.JWTPayload) ([]entity.PosPurchaseOrderItem, error) {
+	if len(items) == 0 {
+		return nil, errors.New("error purchase order, items could not be empty")
+	}
+
+	gormItems := make([]entity.PosPurchaseOrderItem, len(items))
+	for i, item := range items {
+		if item.Quantity <= 0 {
+			return nil, errors.New("error purchase order, item quantity must be positive")
+		}
+		if item.UnitCost < 0 {
+			return nil, errors.New("error purchase order, item unit cost could not be negative")
+		}
+
+		// Check if product is exist within the company
+		posProduct, err := s.repoProduct.ReadPosProduct(item.ProductId)
+		if err != nil {
+			return nil, err
+		}
+		if posProduct.CompanyId != jwtPayload.CompanyId {
+			return nil, errors.New("error purchase order, product is not found within the company")
+		}
+
+		gormItems[i] = entity.PosPurchaseOrderItem{
+			PurchaseOrderItemID: uuid.New(),      // auto
+			PurchaseOrderID:     purchaseOrderID, // auto
+			ProductID:           uuid.MustParse(item.ProductId),
+			Quantity:            int(item.Quantity),
+			ReceivedQuantity:    0, // auto
+			UnitCost:            item.UnitCost,
+		}
+	}
+
+	return gormItems, nil
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PosInventoryReconciliationService interface {
+	ReconcilePosInventory(ctx context.Context, req *pb.ReconcilePosInventoryRequest) (*pb.ReconcilePosInventoryResponse, error)
+}
+
+type posInventoryReconciliationService struct {
+	pb.UnimplementedPosInventoryReconciliationServiceServer
+	repo               repository.PosInventoryReconciliationRepository
+	CompanyServiceConn *grpc.ClientConn
+}
+
+func NewPosInventoryReconciliationService(repo repository.PosInventoryReconciliationRepository, companyServiceConn *grpc.ClientConn) *posInventoryReconciliationService {
+	return &posInventoryReconciliationService{
+		repo:               repo,
+		CompanyServiceConn: companyServiceConn,
+	}
+}
+
+func (s *posInventoryReconciliationService) ReconcilePosInventory(ctx context.Context, req *pb.ReconcilePosInventoryRequest) (*pb.ReconcilePosInventoryResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to reconcile inventory")
+	}
+
+	if req.Apply && !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to post inventory reconciliation entries")
+	}
+
+	for _, id := range []string{req.ProductId, req.BranchId, req.StoreId} {
+		if _, err := utils.ParseOptionalUUID(id); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "error reconcile inventory, "+err.Error())
+		}
+	}
+
+	// Branch and store users only reconcile within their own branch or store
+	branchID := req.BranchId
+	storeID := req.StoreId
+	switch loginRole.PosRole.RoleName {
+	case os.Getenv("BRANCH_USER_ROLE"):
+		if branchID != "" && branchID != req.JwtPayload.BranchId {
+			return nil, errors.New("branch users can only reconcile inventory within their branch")
+		}
+		branchID = req.JwtPayload.BranchId
+	case os.Getenv("STORE_USER_ROLE"):
+		if (branchID != "" && branchID != req.JwtPayload.BranchId) || (storeID != "" && storeID != req.JwtPayload.StoreId) {
+			return nil, errors.New("store users can only reconcile inventory within their store")
+		}
+		branchID = req.JwtPayload.BranchId
+		storeID = req.JwtPayload.StoreId
+	}
+
+	posInventoryDiscrepancies, err := s.repo.ReconcilePosInventory(req.JwtPayload.CompanyId, branchID, storeID, req.ProductId, req.Apply, uuid.MustParse(req.JwtPayload.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	var correctedCount int32
+	for _, posInventoryDiscrepancy := range posInventoryDiscrepancies {
+		if posInventoryDiscrepancy.Corrected {
+			correctedCount++
+		}
+	}
+
+	return &pb.ReconcilePosInventoryResponse{
+		PosInventoryDiscrepancies: posInventoryDiscrepancies,
+		CorrectedCount:            correctedCount,
+	}, nil
+}
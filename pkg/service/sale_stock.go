@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Failure policies of DeductStockForSale
+const (
+	SaleFailurePolicyAllOrNothing = "all_or_nothing"
+	SaleFailurePolicyPartial      = "partial"
+)
+
+type PosSaleStockService interface {
+	DeductStockForSale(ctx context.Context, req *pb.DeductStockForSaleRequest) (*pb.DeductStockForSaleResponse, error)
+	ReturnStockForSale(ctx context.Context, req *pb.ReturnStockForSaleRequest) (*pb.ReturnStockForSaleResponse, error)
+}
+
+type posSaleStockService struct {
+	pb.UnimplementedPosSaleStockServiceServer
+	repo               repository.PosSaleStockRepository
+	CompanyServiceConn *grpc.ClientConn
+}
+
+func NewPosSaleStockService(repo repository.PosSaleStockRepository, companyServiceConn *grpc.ClientConn) *posSaleStockService {
+	return &posSaleStockService{
+		repo:               repo,
+		CompanyServiceConn: companyServiceConn,
+	}
+}
+
+func (s *posSaleStockService) DeductStockForSale(ctx context.Context, req *pb.DeductStockForSaleRequest) (*pb.DeductStockForSaleResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to deduct stock for sale")
+	}
+
+	saleID, err := utils.ParseOptionalUUID(req.SaleId)
+	if err != nil || saleID == nil {
+		return nil, status.Error(codes.InvalidArgument, "error deduct stock for sale, sale id is not valid")
+	}
+
+	if len(req.Lines) == 0 {
+		return nil, errors.New("error deduct stock for sale, lines could not be empty")
+	}
+
+	failurePolicy := req.FailurePolicy
+	if failurePolicy == "" {
+		failurePolicy = SaleFailurePolicyAllOrNothing
+	}
+	if failurePolicy != SaleFailurePolicyAllOrNothing && failurePolicy != SaleFailurePolicyPartial {
+		return nil, errors.New("error deduct stock for sale, failure policy must be all_or_nothing or partial")
+	}
+
+	now := time.Now()
+	movement := entity.PosInventoryHistory{
+		Date:        now,
+		Note:        "sale " + saleID.String(),
+		ReferenceNo: req.ReferenceNo,
+		CompanyID:   uuid.MustParse(req.JwtPayload.CompanyId),
+		CreatedAt:   now,
+		CreatedBy:   uuid.MustParse(req.JwtPayload.UserId),
+		UpdatedAt:   now,
+		UpdatedBy:   uuid.MustParse(req.JwtPayload.UserId),
+	}
+
+	// set Branch ID Store ID base in login role
+	branchID := req.BranchId
+	storeID := req.StoreId
+	switch loginRole.PosRole.RoleName {
+	case os.Getenv("BRANCH_USER_ROLE"):
+		branchID = req.JwtPayload.BranchId
+	case os.Getenv("STORE_USER_ROLE"):
+		branchID = req.JwtPayload.BranchId
+		storeID = req.JwtPayload.StoreId
+	}
+
+	movement.BranchID, err = utils.ParseOptionalUUID(branchID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "error deduct stock for sale, branch "+err.Error())
+	}
+
+	movement.StoreID, err = utils.ParseOptionalUUID(storeID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "error deduct stock for sale, store "+err.Error())
+	}
+
+	if movement.BranchID == nil {
+		return nil, errors.New("error deduct stock for sale, branch id could not be empty")
+	}
+
+	if movement.StoreID == nil {
+		return nil, errors.New("error deduct stock for sale, store id could not be empty")
+	}
+
+	// The store must belong to the branch of the sale and to the company of the login user
+	err = utils.VerifyStoreBranch(s.CompanyServiceConn, storeID, branchID, req.JwtPayload)
+	if err != nil {
+		return nil, errors.New("error deduct stock for sale, " + err.Error())
+	}
+
+	results, err := s.repo.DeductStockForSale(*saleID, req.Lines, failurePolicy == SaleFailurePolicyPartial, movement)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.DeductStockForSaleResponse{
+		SaleId:  saleID.String(),
+		Results: results,
+	}
+
+	for _, result := range results {
+		if result.Accepted {
+			res.AcceptedCount++
+		} else {
+			res.RejectedCount++
+		}
+	}
+
+	return res, nil
+}
+
+func (s *posSaleStockService) ReturnStockForSale(ctx context.Context, req *pb.ReturnStockForSaleRequest) (*pb.ReturnStockForSaleResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to return stock for sale")
+	}
+
+	saleID, err := utils.ParseOptionalUUID(req.SaleId)
+	if err != nil || saleID == nil {
+		return nil, status.Error(codes.InvalidArgument, "error return stock for sale, sale id is not valid")
+	}
+
+	if len(req.Lines) == 0 {
+		return nil, errors.New("error return stock for sale, lines could not be empty")
+	}
+
+	note := req.Note
+	if note == "" {
+		note = "return of sale " + saleID.String()
+	}
+
+	now := time.Now()
+	movement := entity.PosInventoryHistory{
+		Date:        now,
+		Note:        note,
+		ReferenceNo: req.ReferenceNo,
+		CompanyID:   uuid.MustParse(req.JwtPayload.CompanyId),
+		CreatedAt:   now,
+		CreatedBy:   uuid.MustParse(req.JwtPayload.UserId),
+		UpdatedAt:   now,
+		UpdatedBy:   uuid.MustParse(req.JwtPayload.UserId),
+	}
+
+	// Branch and store users can only return the sales of their own branch or store
+	switch loginRole.PosRole.RoleName {
+	case os.Getenv("BRANCH_USER_ROLE"):
+		movement.BranchID, err = utils.ParseOptionalUUID(req.JwtPayload.BranchId)
+	case os.Getenv("STORE_USER_ROLE"):
+		movement.BranchID, err = utils.ParseOptionalUUID(req.JwtPayload.BranchId)
+		if err == nil {
+			movement.StoreID, err = utils.ParseOptionalUUID(req.JwtPayload.StoreId)
+		}
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "error return stock for sale, "+err.Error())
+	}
+
+	results, err := s.repo.ReturnStockForSale(*saleID, req.Lines, movement)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReturnStockForSaleResponse{
+		SaleId:  saleID.String(),
+		Results: results,
+	}, nil
+}
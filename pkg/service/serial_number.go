@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"google.golang.org/grpc"
+)
+
+type PosSerialNumberService interface {
+	ReadPosSerialNumberHistory(ctx context.Context, req *pb.ReadPosSerialNumberHistoryRequest) (*pb.ReadPosSerialNumberHistoryResponse, error)
+}
+
+type posSerialNumberService struct {
+	pb.UnimplementedPosSerialNumberServiceServer
+	repo               repository.PosSerialNumberRepository
+	CompanyServiceConn *grpc.ClientConn
+}
+
+func NewPosSerialNumberService(repo repository.PosSerialNumberRepository, companyServiceConn *grpc.ClientConn) *posSerialNumberService {
+	return &posSerialNumberService{
+		repo:               repo,
+		CompanyServiceConn: companyServiceConn,
+	}
+}
+
+func (s *posSerialNumberService) ReadPosSerialNumberHistory(ctx context.Context, req *pb.ReadPosSerialNumberHistoryRequest) (*pb.ReadPosSerialNumberHistoryResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read serial number history")
+	}
+
+	if req.SerialNumber == "" {
+		return nil, errors.New("error read serial number history, serial number could not be empty")
+	}
+
+	posSerialNumber, err := s.repo.ReadPosSerialNumber(req.JwtPayload.CompanyId, req.SerialNumber, req.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.VerifyStoreScopeAccess(loginRole.PosRole.RoleName, posSerialNumber.CompanyId, posSerialNumber.BranchId, posSerialNumber.StoreId, req.JwtPayload) {
+		return nil, errors.New("users can only read serial number history within their company, branch or store")
+	}
+
+	posInventoryHistories, err := s.repo.ReadPosSerialNumberHistory(posSerialNumber.SerialId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadPosSerialNumberHistoryResponse{
+		PosSerialNumber:       posSerialNumber,
+		PosInventoryHistories: posInventoryHistories,
+	}, nil
+}
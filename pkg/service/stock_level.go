@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosStockLevelService interface {
+	ReadPosStockLevel(ctx context.Context, req *pb.ReadPosStockLevelRequest) (*pb.ReadPosStockLevelResponse, error)
+	UpdatePosStockLevel(ctx context.Context, req *pb.UpdatePosStockLevelRequest) (*pb.UpdatePosStockLevelResponse, error)
+	ReadAllPosStockLevels(ctx context.Context, req *pb.ReadAllPosStockLevelsRequest) (*pb.ReadAllPosStockLevelsResponse, error)
+}
+
+type posStockLevelService struct {
+	pb.UnimplementedPosStockLevelServiceServer
+	repoStockLevel     repository.PosStockLevelRepository
+	repoProduct        repository.PosProductRepository
+	CompanyServiceConn *grpc.ClientConn
+}
+
+func NewPosStockLevelService(repoStockLevel repository.PosStockLevelRepository, repoProduct repository.PosProductRepository, companyServiceConn *grpc.ClientConn) *posStockLevelService {
+	return &posStockLevelService{
+		repoStockLevel:     repoStockLevel,
+		repoProduct:        repoProduct,
+		CompanyServiceConn: companyServiceConn,
+	}
+}
+
+func (s *posStockLevelService) ReadPosStockLevel(ctx context.Context, req *pb.ReadPosStockLevelRequest) (*pb.ReadPosStockLevelResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read stock level")
+	}
+
+	// Store users can only read the stock level of their own store
+	if loginRole.PosRole.RoleName == os.Getenv("STORE_USER_ROLE") {
+		req.StoreId = req.JwtPayload.StoreId
+	}
+
+	if req.StoreId == "" {
+		return nil, errors.New("error get stock level, store id could not be empty")
+	}
+
+	// Check if product is exist within the company
+	posProduct, err := s.repoProduct.ReadPosProduct(req.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	if posProduct.CompanyId != req.JwtPayload.CompanyId {
+		return nil, errors.New("users can only retrieve stock level within their company")
+	}
+
+	posStockLevel, err := s.repoStockLevel.ReadPosStockLevel(req.ProductId, req.StoreId)
+	if err != nil {
+		return nil, err
+	}
+
+	branchRole := os.Getenv("BRANCH_USER_ROLE")
+
+	if loginRole.PosRole.RoleName == branchRole && posStockLevel.BranchId != "" {
+		if !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, posStockLevel.BranchId, req.JwtPayload.BranchId) {
+			return nil, errors.New("branch users can only retrieve stock level within their branch")
+		}
+	}
+
+	return &pb.ReadPosStockLevelResponse{
+		PosStockLevel: posStockLevel,
+	}, nil
+}
+
+func (s *posStockLevelService) UpdatePosStockLevel(ctx context.Context, req *pb.UpdatePosStockLevelRequest) (*pb.UpdatePosStockLevelResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to update stock level")
+	}
+
+	if req.PosStockLevel.StoreId == "" {
+		return nil, errors.New("error update stock level, store id could not be empty")
+	}
+
+	if req.PosStockLevel.ReorderLevel < 0 || req.PosStockLevel.MaxLevel < 0 {
+		return nil, errors.New("error update stock level, reorder level and max level could not be negative")
+	}
+
+	if req.PosStockLevel.MaxLevel > 0 && req.PosStockLevel.MaxLevel < req.PosStockLevel.ReorderLevel {
+		return nil, errors.New("error update stock level, max level could not be lower than reorder level")
+	}
+
+	// Check if product is exist within the company
+	posProduct, err := s.repoProduct.ReadPosProduct(req.PosStockLevel.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	if posProduct.CompanyId != req.JwtPayload.CompanyId {
+		return nil, errors.New("users can only update stock level within their company")
+	}
+
+	// Get the current stock level to be updated
+	currentStockLevel, err := s.repoStockLevel.ReadPosStockLevel(req.PosStockLevel.ProductId, req.PosStockLevel.StoreId)
+	if err != nil {
+		return nil, err
+	}
+
+	companyRole := os.Getenv("COMPANY_USER_ROLE")
+	branchRole := os.Getenv("BRANCH_USER_ROLE")
+
+	// set Branch ID base in login role
+	switch loginRole.PosRole.RoleName {
+	case companyRole:
+		if currentStockLevel.BranchId != "" {
+			req.PosStockLevel.BranchId = currentStockLevel.BranchId
+		}
+
+		if req.PosStockLevel.BranchId == "" {
+			return nil, errors.New("error update stock level, branch id could not be empty")
+		}
+	case branchRole:
+		if currentStockLevel.BranchId != "" && !utils.VerifyBranchUserAccess(loginRole.PosRole.RoleName, currentStockLevel.BranchId, req.JwtPayload.BranchId) {
+			return nil, errors.New("branch users can only update stock level within their branch")
+		}
+		req.PosStockLevel.BranchId = req.JwtPayload.BranchId
+	}
+
+	now := timestamppb.New(time.Now())
+
+	// Convert pb.PosStockLevel to entity.PosStockLevel
+	gormStockLevel := &entity.PosStockLevel{
+		ProductID:    uuid.MustParse(req.PosStockLevel.ProductId),
+		StoreID:      uuid.MustParse(req.PosStockLevel.StoreId),
+		ReorderLevel: int(req.PosStockLevel.ReorderLevel),
+		MaxLevel:     int(req.PosStockLevel.MaxLevel),
+		BranchID:     uuid.MustParse(req.PosStockLevel.BranchId), // auto
+		CompanyID:    uuid.MustParse(req.JwtPayload.CompanyId),   // auto
+		CreatedAt:    now.AsTime(),                               // auto
+		CreatedBy:    uuid.MustParse(req.JwtPayload.UserId),      // auto
+		UpdatedAt:    now.AsTime(),                               // auto
+		UpdatedBy:    uuid.MustParse(req.JwtPayload.UserId),      // auto
+	}
+
+	err = s.repoStockLevel.UpdatePosStockLevel(gormStockLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	posStockLevel, err := s.repoStockLevel.ReadPosStockLevel(req.PosStockLevel.ProductId, req.PosStockLevel.StoreId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdatePosStockLevelResponse{
+		PosStockLevel: posStockLevel,
+	}, nil
+}
+
+func (s *posStockLevelService) ReadAllPosStockLevels(ctx context.Context, req *pb.ReadAllPosStockLevelsRequest) (*pb.ReadAllPosStockLevelsResponse, error) {
+	pagination := dto.Pagination{
+		Limit: int(req.Limit),
+		Page:  int(req.Page),
+	}
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read all stock level")
+	}
+
+	paginationResult, err := s.repoStockLevel.ReadAllPosStockLevels(pagination, loginRole.PosRole.RoleName, req.JwtPayload, req.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	posStockLevels := paginationResult.Records.([]entity.PosStockLevel)
+	pbPosStockLevels := make([]*pb.PosStockLevel, len(posStockLevels))
+
+	for i, posStockLevel := range posStockLevels {
+		pbPosStockLevels[i] = &pb.PosStockLevel{
+			ProductId:    posStockLevel.ProductID.String(),
+			StoreId:      posStockLevel.StoreID.String(),
+			Quantity:     int32(posStockLevel.Quantity),
+			ReorderLevel: int32(posStockLevel.ReorderLevel),
+			MaxLevel:     int32(posStockLevel.MaxLevel),
+			BranchId:     posStockLevel.BranchID.String(),
+			CompanyId:    posStockLevel.CompanyID.String(),
+			CreatedAt:    timestamppb.New(posStockLevel.CreatedAt),
+			CreatedBy:    posStockLevel.CreatedBy.String(),
+			UpdatedAt:    timestamppb.New(posStockLevel.UpdatedAt),
+			UpdatedBy:    posStockLevel.UpdatedBy.String(),
+		}
+	}
+
+	return &pb.ReadAllPosStockLevelsResponse{
+		PosStockLevels: pbPosStockLevels,
+		Limit:          int32(pagination.Limit),
+		Page:           int32(pagination.Page),
+		MaxPage:        int32(paginationResult.TotalPages),
+		Count:          paginationResult.TotalRecords,
+	}, nil
+}
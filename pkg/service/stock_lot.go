@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"google.golang.org/grpc"
+)
+
+type PosStockLotService interface {
+	ReadAllPosStockLots(ctx context.Context, req *pb.ReadAllPosStockLotsRequest) (*pb.ReadAllPosStockLotsResponse, error)
+	ListExpiringPosStockLots(ctx context.Context, req *pb.ListExpiringPosStockLotsRequest) (*pb.ListExpiringPosStockLotsResponse, error)
+}
+
+type posStockLotService struct {
+	pb.UnimplementedPosStockLotServiceServer
+	repo               repository.PosStockLotRepository
+	CompanyServiceConn *grpc.ClientConn
+}
+
+func NewPosStockLotService(repo repository.PosStockLotRepository, companyServiceConn *grpc.ClientConn) *posStockLotService {
+	return &posStockLotService{
+		repo:               repo,
+		CompanyServiceConn: companyServiceConn,
+	}
+}
+
+func (s *posStockLotService) ReadAllPosStockLots(ctx context.Context, req *pb.ReadAllPosStockLotsRequest) (*pb.ReadAllPosStockLotsResponse, error) {
+	pagination := dto.Pagination{
+		Limit: int(req.Limit),
+		Page:  int(req.Page),
+	}
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read all stock lots")
+	}
+
+	paginationResult, err := s.repo.ReadAllPosStockLots(pagination, loginRole.PosRole.RoleName, req.JwtPayload, req.ProductId, req.StoreId, req.IncludeEmpty)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadAllPosStockLotsResponse{
+		PosStockLots: paginationResult.Records.([]*pb.PosStockLot),
+		Limit:        int32(pagination.Limit),
+		Page:         int32(pagination.Page),
+		MaxPage:      int32(paginationResult.TotalPages),
+		Count:        paginationResult.TotalRecords,
+	}, nil
+}
+
+func (s *posStockLotService) ListExpiringPosStockLots(ctx context.Context, req *pb.ListExpiringPosStockLotsRequest) (*pb.ListExpiringPosStockLotsResponse, error) {
+	pagination := dto.Pagination{
+		Limit: int(req.Limit),
+		Page:  int(req.Page),
+	}
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read expiring stock lots")
+	}
+
+	if req.Days < 0 {
+		return nil, errors.New("days must not be negative")
+	}
+
+	expiresBefore := time.Now().AddDate(0, 0, int(req.Days))
+
+	paginationResult, err := s.repo.ListExpiringPosStockLots(pagination, loginRole.PosRole.RoleName, req.JwtPayload, expiresBefore, req.StoreId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListExpiringPosStockLotsResponse{
+		PosStockLots: paginationResult.Records.([]*pb.PosStockLot),
+		Limit:        int32(pagination.Limit),
+		Page:         int32(pagination.Page),
+		MaxPage:      int32(paginationResult.TotalPages),
+		Count:        paginationResult.TotalRecords,
+	}, nil
+}
@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+// defaultStockReservationTTL is used when neither the request nor STOCK_RESERVATION_TTL sets a TTL
+const defaultStockReservationTTL = 15 * time.Minute
+
+type PosStockReservationService interface {
+	ReservePosStock(ctx context.Context, req *pb.ReservePosStockRequest) (*pb.ReservePosStockResponse, error)
+	CommitPosStockReservation(ctx context.Context, req *pb.CommitPosStockReservationRequest) (*pb.CommitPosStockReservationResponse, error)
+	ReleasePosStockReservation(ctx context.Context, req *pb.ReleasePosStockReservationRequest) (*pb.ReleasePosStockReservationResponse, error)
+}
+
+type posStockReservationService struct {
+	pb.UnimplementedPosStockReservationServiceServer
+	repoReservation    repository.PosStockReservationRepository
+	repoProduct        repository.PosProductRepository
+	CompanyServiceConn *grpc.ClientConn
+}
+
+func NewPosStockReservationService(repoReservation repository.PosStockReservationRepository, repoProduct repository.PosProductRepository, companyServiceConn *grpc.ClientConn) *posStockReservationService {
+	return &posStockReservationService{
+		repoReservation:    repoReservation,
+		repoProduct:        repoProduct,
+		CompanyServiceConn: companyServiceConn,
+	}
+}
+
+func (s *posStockReservationService) ReservePosStock(ctx context.Context, req *pb.ReservePosStockRequest) (*pb.ReservePosStockResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to reserve stock")
+	}
+
+	if req.PosStockReservation == nil {
+		return nil, errors.New("error reserve stock, stock reservation could not be empty")
+	}
+
+	if req.PosStockReservation.Quantity <= 0 {
+		return nil, errors.New("error reserve stock, quantity must be positive")
+	}
+
+	if req.TtlSeconds < 0 {
+		return nil, errors.New("error reserve stock, ttl seconds could not be negative")
+	}
+
+	companyRole := os.Getenv("COMPANY_USER_ROLE")
+	branchRole := os.Getenv("BRANCH_USER_ROLE")
+	storeRole := os.Getenv("STORE_USER_ROLE")
+
+	// set Branch ID Store ID base in login role
+	switch loginRole.PosRole.RoleName {
+	case branchRole:
+		req.PosStockReservation.BranchId = req.JwtPayload.BranchId
+	case storeRole:
+		req.PosStockReservation.BranchId = req.JwtPayload.BranchId
+		req.PosStockReservation.StoreId = req.JwtPayload.StoreId
+	}
+
+	if loginRole.PosRole.RoleName == companyRole && req.PosStockReservation.BranchId == "" {
+		return nil, errors.New("error reserve stock, branch id could not be empty")
+	}
+
+	if req.PosStockReservation.StoreId == "" {
+		return nil, errors.New("error reserve stock, store id could not be empty")
+	}
+
+	// Check if product is exist within the company
+	posProduct, err := s.repoProduct.ReadPosProduct(req.PosStockReservation.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if posProduct.CompanyId != req.JwtPayload.CompanyId {
+		return nil, errors.New("error reserve stock, product is not found within the company")
+	}
+
+	ttl := stockReservationTTL()
+	if req.TtlSeconds > 0 {
+		ttl = time.Duration(req.TtlSeconds) * time.Second
+	}
+
+	now := time.Now()
+	gormStockReservation := &entity.PosStockReservation{
+		ReservationID: uuid.New(),
+		ProductID:     uuid.MustParse(req.PosStockReservation.ProductId),
+		StoreID:       uuid.MustParse(req.PosStockReservation.StoreId),
+		Quantity:      int(req.PosStockReservation.Quantity),
+		Status:        entity.ReservationStatusActive,
+		ReferenceNo:   req.PosStockReservation.ReferenceNo,
+		ExpiresAt:     now.Add(ttl),
+		BranchID:      uuid.MustParse(req.PosStockReservation.BranchId),
+		CompanyID:     uuid.MustParse(req.JwtPayload.CompanyId),
+		CreatedAt:     now,
+		CreatedBy:     uuid.MustParse(req.JwtPayload.UserId),
+		UpdatedAt:     now,
+		UpdatedBy:     uuid.MustParse(req.JwtPayload.UserId),
+	}
+
+	err = s.repoReservation.ReservePosStock(gormStockReservation)
+	if err != nil {
+		return nil, err
+	}
+
+	posStockReservation, err := s.repoReservation.ReadPosStockReservation(gormStockReservation.ReservationID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReservePosStockResponse{
+		PosStockReservation: posStockReservation,
+	}, nil
+}
+
+func (s *posStockReservationService) CommitPosStockReservation(ctx context.Context, req *pb.CommitPosStockReservationRequest) (*pb.CommitPosStockReservationResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to commit stock reservation")
+	}
+
+	posStockReservation, err := s.repoReservation.ReadPosStockReservation(req.ReservationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.VerifyStoreScopeAccess(loginRole.PosRole.RoleName, posStockReservation.CompanyId, posStockReservation.BranchId, posStockReservation.StoreId, req.JwtPayload) {
+		return nil, errors.New("users can only commit stock reservation within their company, branch or store")
+	}
+
+	err = s.repoReservation.CommitPosStockReservation(req.ReservationId, req.Note, req.SerialNumbers, uuid.MustParse(req.JwtPayload.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	posStockReservation, err = s.repoReservation.ReadPosStockReservation(req.ReservationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CommitPosStockReservationResponse{
+		PosStockReservation: posStockReservation,
+	}, nil
+}
+
+func (s *posStockReservationService) ReleasePosStockReservation(ctx context.Context, req *pb.ReleasePosStockReservationRequest) (*pb.ReleasePosStockReservationResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to release stock reservation")
+	}
+
+	posStockReservation, err := s.repoReservation.ReadPosStockReservation(req.ReservationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.VerifyStoreScopeAccess(loginRole.PosRole.RoleName, posStockReservation.CompanyId, posStockReservation.BranchId, posStockReservation.StoreId, req.JwtPayload) {
+		return nil, errors.New("users can only release stock reservation within their company, branch or store")
+	}
+
+	err = s.repoReservation.ReleasePosStockReservation(req.ReservationId, uuid.MustParse(req.JwtPayload.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	posStockReservation, err = s.repoReservation.ReadPosStockReservation(req.ReservationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReleasePosStockReservationResponse{
+		PosStockReservation: posStockReservation,
+	}, nil
+}
+
+// stockReservationTTL returns the TTL configured in STOCK_RESERVATION_TTL, e.g. 10m
+func stockReservationTTL() time.Duration {
+	value := os.Getenv("STOCK_RESERVATION_TTL")
+	if value == "" {
+		return defaultStockReservationTTL
+	}
+
+	ttl, err := time.ParseDuration(value)
+	if err != nil || ttl <= 0 {
+		log.Printf("invalid STOCK_RESERVATION_TTL %q, using %s", value, defaultStockReservationTTL)
+		return defaultStockReservationTTL
+	}
+
+	return ttl
+}
@@ -0,0 +1,425 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosStockTakeService interface {
+	CreatePosStockTake(ctx context.Context, req *pb.CreatePosStockTakeRequest) (*pb.CreatePosStockTakeResponse, error)
+	ReadPosStockTake(ctx context.Context, req *pb.ReadPosStockTakeRequest) (*pb.ReadPosStockTakeResponse, error)
+	SubmitPosStockTakeCounts(ctx context.Context, req *pb.SubmitPosStockTakeCountsRequest) (*pb.SubmitPosStockTakeCountsResponse, error)
+	ReadPosStockTakeVariance(ctx context.Context, req *pb.ReadPosStockTakeVarianceRequest) (*pb.ReadPosStockTakeVarianceResponse, error)
+	ApprovePosStockTake(ctx context.Context, req *pb.ApprovePosStockTakeRequest) (*pb.ApprovePosStockTakeResponse, error)
+	CancelPosStockTake(ctx context.Context, req *pb.CancelPosStockTakeRequest) (*pb.CancelPosStockTakeResponse, error)
+	ReadAllPosStockTakes(ctx context.Context, req *pb.ReadAllPosStockTakesRequest) (*pb.ReadAllPosStockTakesResponse, error)
+}
+
+type posStockTakeService struct {
+	pb.UnimplementedPosStockTakeServiceServer
+	repoStockTake      repository.PosStockTakeRepository
+	repoProduct        repository.PosProductRepository
+	repoCategory       repository.PosProductCategoryRepository
+	CompanyServiceConn *grpc.ClientConn
+}
+
+func NewPosStockTakeService(repoStockTake repository.PosStockTakeRepository, repoProduct repository.PosProductRepository, repoCategory repository.PosProductCategoryRepository, companyServiceConn *grpc.ClientConn) *posStockTakeService {
+	return &posStockTakeService{
+		repoStockTake:      repoStockTake,
+		repoProduct:        repoProduct,
+		repoCategory:       repoCategory,
+		CompanyServiceConn: companyServiceConn,
+	}
+}
+
+func (s *posStockTakeService) CreatePosStockTake(ctx context.Context, req *pb.CreatePosStockTakeRequest) (*pb.CreatePosStockTakeResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to create new stock take")
+	}
+
+	companyRole := os.Getenv("COMPANY_USER_ROLE")
+	branchRole := os.Getenv("BRANCH_USER_ROLE")
+	storeRole := os.Getenv("STORE_USER_ROLE")
+
+	// set Branch ID Store ID base in login role
+	switch loginRole.PosRole.RoleName {
+	case branchRole:
+		req.PosStockTake.BranchId = req.JwtPayload.BranchId
+	case storeRole:
+		req.PosStockTake.BranchId = req.JwtPayload.BranchId
+		req.PosStockTake.StoreId = req.JwtPayload.StoreId
+	}
+
+	if loginRole.PosRole.RoleName == companyRole && req.PosStockTake.BranchId == "" {
+		return nil, errors.New("error created stock take, branch id could not be empty")
+	}
+
+	if req.PosStockTake.StoreId == "" {
+		return nil, errors.New("error created stock take, store id could not be empty")
+	}
+
+	now := timestamppb.New(time.Now())
+	req.PosStockTake.StockTakeId = uuid.New().String()
+	req.PosStockTake.Status = entity.StockTakeStatusOpen
+
+	// Convert pb.PosStockTake to entity.PosStockTake
+	gormStockTake := &entity.PosStockTake{
+		StockTakeID: uuid.MustParse(req.PosStockTake.StockTakeId), // auto
+		StoreID:     uuid.MustParse(req.PosStockTake.StoreId),
+		BranchID:    uuid.MustParse(req.PosStockTake.BranchId),
+		Scope:       req.PosStockTake.Scope,
+		CategoryID:  nil,
+		Status:      req.PosStockTake.Status, // auto
+		Note:        req.PosStockTake.Note,
+		CompanyID:   uuid.MustParse(req.JwtPayload.CompanyId), // auto
+		CreatedAt:   now.AsTime(),                             // auto
+		CreatedBy:   uuid.MustParse(req.JwtPayload.UserId),    // auto
+		UpdatedAt:   now.AsTime(),                             // auto
+		UpdatedBy:   uuid.MustParse(req.JwtPayload.UserId),    // auto
+	}
+
+	var productIDs []uuid.UUID
+
+	switch req.PosStockTake.Scope {
+	case entity.StockTakeScopeStore:
+	case entity.StockTakeScopeCategory:
+		if req.PosStockTake.CategoryId == "" {
+			return nil, errors.New("error created stock take, category id could not be empty")
+		}
+
+		// Check if category ID is correct
+		posCategory, err := s.repoCategory.ReadPosProductCategory(req.PosStockTake.CategoryId)
+		if err != nil {
+			return nil, err
+		}
+		if posCategory.CompanyId != req.JwtPayload.CompanyId {
+			return nil, errors.New("error created stock take, category is not found within the company")
+		}
+
+		gormStockTake.CategoryID = utils.ParseUUID(req.PosStockTake.CategoryId)
+	case entity.StockTakeScopeProducts:
+		if len(req.PosStockTake.Items) == 0 {
+			return nil, errors.New("error created stock take, items could not be empty")
+		}
+
+		seen := make(map[uuid.UUID]bool)
+		for _, item := range req.PosStockTake.Items {
+			productID := uuid.MustParse(item.ProductId)
+			if !seen[productID] {
+				seen[productID] = true
+				productIDs = append(productIDs, productID)
+			}
+		}
+	default:
+		return nil, errors.New("error created stock take, scope must be store, category or products")
+	}
+
+	err = s.repoStockTake.CreatePosStockTake(gormStockTake, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	posStockTake, err := s.repoStockTake.ReadPosStockTake(req.PosStockTake.StockTakeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreatePosStockTakeResponse{
+		PosStockTake: posStockTake,
+	}, nil
+}
+
+func (s *posStockTakeService) ReadPosStockTake(ctx context.Context, req *pb.ReadPosStockTakeRequest) (*pb.ReadPosStockTakeResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read stock take")
+	}
+
+	posStockTake, err := s.repoStockTake.ReadPosStockTake(req.StockTakeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.VerifyStoreScopeAccess(loginRole.PosRole.RoleName, posStockTake.CompanyId, posStockTake.BranchId, posStockTake.StoreId, req.JwtPayload) {
+		return nil, errors.New("users can only retrieve stock take within their company, branch or store")
+	}
+
+	return &pb.ReadPosStockTakeResponse{
+		PosStockTake: posStockTake,
+	}, nil
+}
+
+func (s *posStockTakeService) SubmitPosStockTakeCounts(ctx context.Context, req *pb.SubmitPosStockTakeCountsRequest) (*pb.SubmitPosStockTakeCountsResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to submit stock take counts")
+	}
+
+	if len(req.Counts) == 0 {
+		return nil, errors.New("error submit stock take counts, counts could not be empty")
+	}
+
+	posStockTake, err := s.repoStockTake.ReadPosStockTake(req.StockTakeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.VerifyStoreScopeAccess(loginRole.PosRole.RoleName, posStockTake.CompanyId, posStockTake.BranchId, posStockTake.StoreId, req.JwtPayload) {
+		return nil, errors.New("users can only submit stock take counts within their company, branch or store")
+	}
+
+	counts := make(map[uuid.UUID]int)
+	for _, count := range req.Counts {
+		if count.CountedQuantity < 0 {
+			return nil, errors.New("error submit stock take counts, counted quantity could not be negative")
+		}
+
+		productID := count.ProductId
+		countedQuantity := int(count.CountedQuantity)
+		// Resolve counts scanned by barcode, a case barcode counts the units in the case and a
+		// weight label its weight
+		if productID == "" && count.ProductBarcodeId != "" {
+			productBarcodeID, err := utils.NormalizeBarcode(count.ProductBarcodeId)
+			if err != nil {
+				return nil, errors.New("error submit stock take counts, " + err.Error())
+			}
+			posProduct, posProductBarcode, embedded, err := s.repoProduct.ReadPosProductBarcode(posStockTake.CompanyId, productBarcodeID)
+			if err != nil {
+				return nil, err
+			}
+			productID = posProduct.ProductId
+			if embedded != nil {
+				countedQuantity *= int(embedded.Quantity)
+			} else {
+				countedQuantity *= int(posProductBarcode.Quantity)
+			}
+		}
+
+		if productID == "" {
+			return nil, errors.New("error submit stock take counts, product id or barcode could not be empty")
+		}
+
+		if req.Accumulate {
+			counts[uuid.MustParse(productID)] += countedQuantity
+		} else {
+			counts[uuid.MustParse(productID)] = countedQuantity
+		}
+	}
+
+	err = s.repoStockTake.SubmitPosStockTakeCounts(req.StockTakeId, counts, req.Accumulate, uuid.MustParse(req.JwtPayload.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	posStockTake, err = s.repoStockTake.ReadPosStockTake(req.StockTakeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SubmitPosStockTakeCountsResponse{
+		PosStockTake: posStockTake,
+	}, nil
+}
+
+func (s *posStockTakeService) ReadPosStockTakeVariance(ctx context.Context, req *pb.ReadPosStockTakeVarianceRequest) (*pb.ReadPosStockTakeVarianceResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read stock take variance")
+	}
+
+	posStockTake, err := s.repoStockTake.ReadPosStockTake(req.StockTakeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.VerifyStoreScopeAccess(loginRole.PosRole.RoleName, posStockTake.CompanyId, posStockTake.BranchId, posStockTake.StoreId, req.JwtPayload) {
+		return nil, errors.New("users can only retrieve stock take variance within their company, branch or store")
+	}
+
+	resp := &pb.ReadPosStockTakeVarianceResponse{
+		StockTakeId: posStockTake.StockTakeId,
+		Variances:   make([]*pb.PosStockTakeVariance, 0, len(posStockTake.Items)),
+	}
+
+	for _, item := range posStockTake.Items {
+		posProduct, err := s.repoProduct.ReadPosProduct(item.ProductId)
+		if err != nil {
+			return nil, err
+		}
+
+		variance := &pb.PosStockTakeVariance{
+			ProductId:        item.ProductId,
+			ProductName:      posProduct.ProductName,
+			ExpectedQuantity: item.ExpectedQuantity,
+			CountedQuantity:  item.CountedQuantity,
+			Counted:          item.Counted,
+			CostPrice:        posProduct.CostPrice,
+			Serialized:       posProduct.Serialized,
+		}
+
+		// Products that were not counted do not have a variance and are not posted on approval
+		if item.Counted {
+			variance.Variance = item.CountedQuantity - item.ExpectedQuantity
+			variance.VarianceCost = float64(variance.Variance) * posProduct.CostPrice
+		}
+
+		resp.TotalVarianceQuantity += variance.Variance
+		resp.TotalVarianceCost += variance.VarianceCost
+		resp.Variances = append(resp.Variances, variance)
+	}
+
+	return resp, nil
+}
+
+func (s *posStockTakeService) ApprovePosStockTake(ctx context.Context, req *pb.ApprovePosStockTakeRequest) (*pb.ApprovePosStockTakeResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to approve stock take")
+	}
+
+	posStockTake, err := s.repoStockTake.ReadPosStockTake(req.StockTakeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.VerifyStoreScopeAccess(loginRole.PosRole.RoleName, posStockTake.CompanyId, posStockTake.BranchId, posStockTake.StoreId, req.JwtPayload) {
+		return nil, errors.New("users can only approve stock take within their company or branch")
+	}
+
+	err = s.repoStockTake.ApprovePosStockTake(req.StockTakeId, uuid.MustParse(req.JwtPayload.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	posStockTake, err = s.repoStockTake.ReadPosStockTake(req.StockTakeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ApprovePosStockTakeResponse{
+		PosStockTake: posStockTake,
+	}, nil
+}
+
+func (s *posStockTakeService) CancelPosStockTake(ctx context.Context, req *pb.CancelPosStockTakeRequest) (*pb.CancelPosStockTakeResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to cancel stock take")
+	}
+
+	posStockTake, err := s.repoStockTake.ReadPosStockTake(req.StockTakeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.VerifyStoreScopeAccess(loginRole.PosRole.RoleName, posStockTake.CompanyId, posStockTake.BranchId, posStockTake.StoreId, req.JwtPayload) {
+		return nil, errors.New("users can only cancel stock take within their company, branch or store")
+	}
+
+	err = s.repoStockTake.CancelPosStockTake(req.StockTakeId, uuid.MustParse(req.JwtPayload.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	posStockTake, err = s.repoStockTake.ReadPosStockTake(req.StockTakeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CancelPosStockTakeResponse{
+		PosStockTake: posStockTake,
+	}, nil
+}
+
+func (s *posStockTakeService) ReadAllPosStockTakes(ctx context.Context, req *pb.ReadAllPosStockTakesRequest) (*pb.ReadAllPosStockTakesResponse, error) {
+	pagination := dto.Pagination{
+		Limit: int(req.Limit),
+		Page:  int(req.Page),
+	}
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read all stock take")
+	}
+
+	paginationResult, err := s.repoStockTake.ReadAllPosStockTakes(pagination, loginRole.PosRole.RoleName, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadAllPosStockTakesResponse{
+		PosStockTakes: paginationResult.Records.([]*pb.PosStockTake),
+		Limit:         int32(pagination.Limit),
+		Page:          int32(pagination.Page),
+		MaxPage:       int32(paginationResult.TotalPages),
+		Count:         paginationResult.TotalRecords,
+	}, nil
+}
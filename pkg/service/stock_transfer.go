@@ -0,0 +1,350 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/dto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosStockTransferService interface {
+	CreatePosStockTransfer(ctx context.Context, req *pb.CreatePosStockTransferRequest) (*pb.CreatePosStockTransferResponse, error)
+	ReadPosStockTransfer(ctx context.Context, req *pb.ReadPosStockTransferRequest) (*pb.ReadPosStockTransferResponse, error)
+	DispatchPosStockTransfer(ctx context.Context, req *pb.DispatchPosStockTransferRequest) (*pb.DispatchPosStockTransferResponse, error)
+	ReceivePosStockTransfer(ctx context.Context, req *pb.ReceivePosStockTransferRequest) (*pb.ReceivePosStockTransferResponse, error)
+	CancelPosStockTransfer(ctx context.Context, req *pb.CancelPosStockTransferRequest) (*pb.CancelPosStockTransferResponse, error)
+	ReadAllPosStockTransfers(ctx context.Context, req *pb.ReadAllPosStockTransfersRequest) (*pb.ReadAllPosStockTransfersResponse, error)
+}
+
+type posStockTransferService struct {
+	pb.UnimplementedPosStockTransferServiceServer
+	repoTransfer       repository.PosStockTransferRepository
+	repoProduct        repository.PosProductRepository
+	CompanyServiceConn *grpc.ClientConn
+}
+
+func NewPosStockTransferService(repoTransfer repository.PosStockTransferRepository, repoProduct repository.PosProductRepository, companyServiceConn *grpc.ClientConn) *posStockTransferService {
+	return &posStockTransferService{
+		repoTransfer:       repoTransfer,
+		repoProduct:        repoProduct,
+		CompanyServiceConn: companyServiceConn,
+	}
+}
+
+func (s *posStockTransferService) CreatePosStockTransfer(ctx context.Context, req *pb.CreatePosStockTransferRequest) (*pb.CreatePosStockTransferResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to create new stock transfer")
+	}
+
+	if len(req.PosStockTransfer.Items) == 0 {
+		return nil, errors.New("error created stock transfer, items could not be empty")
+	}
+
+	if req.PosStockTransfer.ToStoreId == "" || req.PosStockTransfer.ToBranchId == "" {
+		return nil, errors.New("error created stock transfer, destination store id and branch id could not be empty")
+	}
+
+	companyRole := os.Getenv("COMPANY_USER_ROLE")
+	branchRole := os.Getenv("BRANCH_USER_ROLE")
+	storeRole := os.Getenv("STORE_USER_ROLE")
+
+	// set source Branch ID Store ID base in login role
+	switch loginRole.PosRole.RoleName {
+	case branchRole:
+		req.PosStockTransfer.FromBranchId = req.JwtPayload.BranchId
+	case storeRole:
+		req.PosStockTransfer.FromBranchId = req.JwtPayload.BranchId
+		req.PosStockTransfer.FromStoreId = req.JwtPayload.StoreId
+	}
+
+	if loginRole.PosRole.RoleName == companyRole && req.PosStockTransfer.FromBranchId == "" {
+		return nil, errors.New("error created stock transfer, source branch id could not be empty")
+	}
+
+	if req.PosStockTransfer.FromStoreId == "" {
+		return nil, errors.New("error created stock transfer, source store id could not be empty")
+	}
+
+	if req.PosStockTransfer.FromStoreId == req.PosStockTransfer.ToStoreId {
+		return nil, errors.New("error created stock transfer, source and destination store could not be the same")
+	}
+
+	// Stock can only leave the stores of the login user scope, it can go to any store of the company
+	if !utils.VerifyStoreScopeAccess(loginRole.PosRole.RoleName, req.JwtPayload.CompanyId, req.PosStockTransfer.FromBranchId, req.PosStockTransfer.FromStoreId, req.JwtPayload) {
+		return nil, errors.New("users can only transfer stock out of stores within their company, branch or store")
+	}
+
+	if err := utils.VerifyStoreBranch(s.CompanyServiceConn, req.PosStockTransfer.FromStoreId, req.PosStockTransfer.FromBranchId, req.JwtPayload); err != nil {
+		return nil, errors.New("error created stock transfer, " + err.Error())
+	}
+
+	if err := utils.VerifyStoreBranch(s.CompanyServiceConn, req.PosStockTransfer.ToStoreId, req.PosStockTransfer.ToBranchId, req.JwtPayload); err != nil {
+		return nil, errors.New("error created stock transfer, " + err.Error())
+	}
+
+	now := timestamppb.New(time.Now())
+	req.PosStockTransfer.TransferId = uuid.New().String()
+	req.PosStockTransfer.Status = entity.TransferStatusCreated
+	req.PosStockTransfer.CompanyId = req.JwtPayload.CompanyId
+	req.PosStockTransfer.CreatedAt = now
+	req.PosStockTransfer.CreatedBy = req.JwtPayload.UserId
+	req.PosStockTransfer.UpdatedAt = now
+	req.PosStockTransfer.UpdatedBy = req.JwtPayload.UserId
+
+	// Convert pb.PosStockTransfer to entity.PosStockTransfer
+	gormStockTransfer := &entity.PosStockTransfer{
+		TransferID:   uuid.MustParse(req.PosStockTransfer.TransferId), // auto
+		FromStoreID:  uuid.MustParse(req.PosStockTransfer.FromStoreId),
+		FromBranchID: uuid.MustParse(req.PosStockTransfer.FromBranchId),
+		ToStoreID:    uuid.MustParse(req.PosStockTransfer.ToStoreId),
+		ToBranchID:   uuid.MustParse(req.PosStockTransfer.ToBranchId),
+		Status:       req.PosStockTransfer.Status, // auto
+		Note:         req.PosStockTransfer.Note,
+		CompanyID:    uuid.MustParse(req.JwtPayload.CompanyId), // auto
+		CreatedAt:    req.PosStockTransfer.CreatedAt.AsTime(),  // auto
+		CreatedBy:    uuid.MustParse(req.JwtPayload.UserId),    // auto
+		UpdatedAt:    req.PosStockTransfer.UpdatedAt.AsTime(),  // auto
+		UpdatedBy:    uuid.MustParse(req.JwtPayload.UserId),    // auto
+	}
+
+	gormItems := make([]entity.PosStockTransferItem, len(req.PosStockTransfer.Items))
+	for i, item := range req.PosStockTransfer.Items {
+		if item.Quantity <= 0 {
+			return nil, errors.New("error created stock transfer, item quantity must be positive")
+		}
+
+		// Check if product is exist within the company
+		posProduct, err := s.repoProduct.ReadPosProduct(item.ProductId)
+		if err != nil {
+			return nil, err
+		}
+		if posProduct.CompanyId != req.JwtPayload.CompanyId {
+			return nil, errors.New("error created stock transfer, product is not found within the company")
+		}
+
+		if posProduct.Serialized && len(item.SerialNumbers) != int(item.Quantity) {
+			return nil, errors.New("error created stock transfer, items of serialized products must list a serial number for every unit")
+		}
+		if !posProduct.Serialized && len(item.SerialNumbers) > 0 {
+			return nil, errors.New("error created stock transfer, serial numbers can only be given for serialized products")
+		}
+
+		item.TransferItemId = uuid.New().String()
+		item.TransferId = req.PosStockTransfer.TransferId
+
+		gormItems[i] = entity.PosStockTransferItem{
+			TransferItemID: uuid.MustParse(item.TransferItemId), // auto
+			TransferID:     gormStockTransfer.TransferID,        // auto
+			ProductID:      uuid.MustParse(item.ProductId),
+			Quantity:       int(item.Quantity),
+			SerialNumbers:  item.SerialNumbers,
+		}
+	}
+
+	err = s.repoTransfer.CreatePosStockTransfer(gormStockTransfer, gormItems)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreatePosStockTransferResponse{
+		PosStockTransfer: req.PosStockTransfer,
+	}, nil
+}
+
+func (s *posStockTransferService) ReadPosStockTransfer(ctx context.Context, req *pb.ReadPosStockTransferRequest) (*pb.ReadPosStockTransferResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read stock transfer")
+	}
+
+	posStockTransfer, err := s.repoTransfer.ReadPosStockTransfer(req.TransferId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Users of both the source and the destination are allowed to read the transfer
+	if !utils.VerifyStoreScopeAccess(loginRole.PosRole.RoleName, posStockTransfer.CompanyId, posStockTransfer.FromBranchId, posStockTransfer.FromStoreId, req.JwtPayload) &&
+		!utils.VerifyStoreScopeAccess(loginRole.PosRole.RoleName, posStockTransfer.CompanyId, posStockTransfer.ToBranchId, posStockTransfer.ToStoreId, req.JwtPayload) {
+		return nil, errors.New("users can only retrieve stock transfer within their company, branch or store")
+	}
+
+	return &pb.ReadPosStockTransferResponse{
+		PosStockTransfer: posStockTransfer,
+	}, nil
+}
+
+func (s *posStockTransferService) DispatchPosStockTransfer(ctx context.Context, req *pb.DispatchPosStockTransferRequest) (*pb.DispatchPosStockTransferResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to dispatch stock transfer")
+	}
+
+	posStockTransfer, err := s.repoTransfer.ReadPosStockTransfer(req.TransferId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the source side can dispatch the transfer
+	if !utils.VerifyStoreScopeAccess(loginRole.PosRole.RoleName, posStockTransfer.CompanyId, posStockTransfer.FromBranchId, posStockTransfer.FromStoreId, req.JwtPayload) {
+		return nil, errors.New("users can only dispatch stock transfer from their company, branch or store")
+	}
+
+	err = s.repoTransfer.DispatchPosStockTransfer(req.TransferId, uuid.MustParse(req.JwtPayload.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	posStockTransfer, err = s.repoTransfer.ReadPosStockTransfer(req.TransferId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DispatchPosStockTransferResponse{
+		PosStockTransfer: posStockTransfer,
+	}, nil
+}
+
+func (s *posStockTransferService) ReceivePosStockTransfer(ctx context.Context, req *pb.ReceivePosStockTransferRequest) (*pb.ReceivePosStockTransferResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to receive stock transfer")
+	}
+
+	posStockTransfer, err := s.repoTransfer.ReadPosStockTransfer(req.TransferId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the destination side can receive the transfer
+	if !utils.VerifyStoreScopeAccess(loginRole.PosRole.RoleName, posStockTransfer.CompanyId, posStockTransfer.ToBranchId, posStockTransfer.ToStoreId, req.JwtPayload) {
+		return nil, errors.New("users can only receive stock transfer into their company, branch or store")
+	}
+
+	err = s.repoTransfer.ReceivePosStockTransfer(req.TransferId, uuid.MustParse(req.JwtPayload.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	posStockTransfer, err = s.repoTransfer.ReadPosStockTransfer(req.TransferId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReceivePosStockTransferResponse{
+		PosStockTransfer: posStockTransfer,
+	}, nil
+}
+
+func (s *posStockTransferService) CancelPosStockTransfer(ctx context.Context, req *pb.CancelPosStockTransferRequest) (*pb.CancelPosStockTransferResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to cancel stock transfer")
+	}
+
+	posStockTransfer, err := s.repoTransfer.ReadPosStockTransfer(req.TransferId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the source side can cancel the transfer
+	if !utils.VerifyStoreScopeAccess(loginRole.PosRole.RoleName, posStockTransfer.CompanyId, posStockTransfer.FromBranchId, posStockTransfer.FromStoreId, req.JwtPayload) {
+		return nil, errors.New("users can only cancel stock transfer from their company, branch or store")
+	}
+
+	err = s.repoTransfer.CancelPosStockTransfer(req.TransferId, uuid.MustParse(req.JwtPayload.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	posStockTransfer, err = s.repoTransfer.ReadPosStockTransfer(req.TransferId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CancelPosStockTransferResponse{
+		PosStockTransfer: posStockTransfer,
+	}, nil
+}
+
+func (s *posStockTransferService) ReadAllPosStockTransfers(ctx context.Context, req *pb.ReadAllPosStockTransfersRequest) (*pb.ReadAllPosStockTransfersResponse, error) {
+	pagination := dto.Pagination{
+		Limit: int(req.Limit),
+		Page:  int(req.Page),
+	}
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read all stock transfer")
+	}
+
+	paginationResult, err := s.repoTransfer.ReadAllPosStockTransfers(pagination, loginRole.PosRole.RoleName, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadAllPosStockTransfersResponse{
+		PosStockTransfers: paginationResult.Records.([]*pb.PosStockTransfer),
+		Limit:             int32(pagination.Limit),
+		Page:              int32(pagination.Page),
+		MaxPage:           int32(paginationResult.TotalPages),
+		Count:             paginationResult.TotalRecords,
+	}, nil
+}
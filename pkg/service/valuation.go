@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	pb "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/pkg/repository"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/utils"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PosInventoryValuationService interface {
+	GetPosInventoryValuation(ctx context.Context, req *pb.GetPosInventoryValuationRequest) (*pb.GetPosInventoryValuationResponse, error)
+}
+
+type posInventoryValuationService struct {
+	pb.UnimplementedPosInventoryValuationServiceServer
+	repo               repository.PosInventoryValuationRepository
+	CompanyServiceConn *grpc.ClientConn
+}
+
+func NewPosInventoryValuationService(repo repository.PosInventoryValuationRepository, companyServiceConn *grpc.ClientConn) *posInventoryValuationService {
+	return &posInventoryValuationService{
+		repo:               repo,
+		CompanyServiceConn: companyServiceConn,
+	}
+}
+
+func (s *posInventoryValuationService) GetPosInventoryValuation(ctx context.Context, req *pb.GetPosInventoryValuationRequest) (*pb.GetPosInventoryValuationResponse, error) {
+	// Extract role ID from JWT payload
+	jwtRoleID := req.JwtPayload.Role
+
+	// Get user login role name
+	loginRole, err := utils.GetPosRoleById(s.CompanyServiceConn, jwtRoleID, req.JwtPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.IsCompanyOrBranchOrStoreUser(loginRole.PosRole.RoleName) {
+		return nil, errors.New("users are not allowed to read inventory valuation")
+	}
+
+	asOf := time.Now()
+	if req.AsOf != nil {
+		asOf = req.AsOf.AsTime()
+	}
+
+	groupBy := req.GroupBy
+	if groupBy == "" {
+		groupBy = repository.ValuationGroupByStore
+	}
+
+	valuationLines, err := s.repo.ReadPosInventoryValuation(loginRole.PosRole.RoleName, req.JwtPayload, asOf, groupBy, req.BranchId, req.StoreId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.GetPosInventoryValuationResponse{
+		Lines:   valuationLines,
+		AsOf:    timestamppb.New(asOf),
+		GroupBy: groupBy,
+	}
+
+	for _, valuationLine := range valuationLines {
+		res.TotalQuantity += valuationLine.Quantity
+		res.TotalCostValue += valuationLine.CostValue
+		res.TotalRetailValue += valuationLine.RetailValue
+	}
+
+	res.TotalCostValue = math.Round(res.TotalCostValue*100) / 100
+	res.TotalRetailValue = math.Round(res.TotalRetailValue*100) / 100
+	res.TotalMarginValue = math.Round((res.TotalRetailValue-res.TotalCostValue)*100) / 100
+	if res.TotalRetailValue != 0 {
+		res.TotalMarginPercent = math.Round(res.TotalMarginValue/res.TotalRetailValue*10000) / 100
+	}
+
+	return res, nil
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/midlleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func PosCostingSettingRoutes(r *gin.Engine, posCostingSettingController controller.PosCostingSettingController) {
+	routes := r.Group("/api")
+
+	// Apply the JWT middleware to all routes in this group
+	routes.Use(midlleware.JWTAuthMiddleware())
+
+	routesV1 := routes.Group("/v1/costing-settings")
+	// Get PosCostingSetting of the login company
+	routesV1.GET("/pos_costing_setting", posCostingSettingController.HandleReadPosCostingSettingRequest)
+	// Update PosCostingSetting of the login company
+	routesV1.PUT("/pos_costing_setting", posCostingSettingController.HandleUpdatePosCostingSettingRequest)
+}
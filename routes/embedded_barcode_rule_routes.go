@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/midlleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func PosEmbeddedBarcodeRuleRoutes(r *gin.Engine, posEmbeddedBarcodeRuleController controller.PosEmbeddedBarcodeRuleController) {
+	routes := r.Group("/api")
+
+	// Apply the JWT middleware to all routes in this group
+	routes.Use(midlleware.JWTAuthMiddleware())
+
+	routesV1 := routes.Group("/v1/embedded-barcode-rules")
+	// Create New PosEmbeddedBarcodeRule
+	routesV1.POST("/pos_embedded_barcode_rule", posEmbeddedBarcodeRuleController.HandleCreatePosEmbeddedBarcodeRuleRequest)
+	// Update Existing PosEmbeddedBarcodeRule
+	routesV1.PUT("/pos_embedded_barcode_rule/:id", posEmbeddedBarcodeRuleController.HandleUpdatePosEmbeddedBarcodeRuleRequest)
+	// Delete PosEmbeddedBarcodeRule
+	routesV1.DELETE("/pos_embedded_barcode_rule/:id", posEmbeddedBarcodeRuleController.HandleDeletePosEmbeddedBarcodeRuleRequest)
+	// Get All PosEmbeddedBarcodeRules of the company
+	routesV1.GET("/pos_embedded_barcode_rules", posEmbeddedBarcodeRuleController.HandleReadAllPosEmbeddedBarcodeRulesRequest)
+}
@@ -18,10 +18,12 @@ func PosInventoryHistoryRoutes(r *gin.Engine, posInventoryHistoryController cont
 	routesV1.POST("/pos_inventory_history", posInventoryHistoryController.HandleCreatePosInventoryHistoryRequest)
 	// Get PosInventoryHistory by ID
 	routesV1.GET("/pos_inventory_history/:id", posInventoryHistoryController.HandleReadPosInventoryHistoryRequest)
-	// Update Existing PosInventoryHistory
-	routesV1.PUT("/pos_inventory_history/:id", posInventoryHistoryController.HandleUpdatePosInventoryHistoryRequest)
-	// Delete PosInventoryHistory
-	routesV1.DELETE("/pos_inventory_history/:id", posInventoryHistoryController.HandleDeletePosInventoryHistoryRequest)
+	// Reverse Existing PosInventoryHistory, ledger entries can not be updated or deleted
+	routesV1.POST("/pos_inventory_history/:id/reverse", posInventoryHistoryController.HandleReversePosInventoryHistoryRequest)
 	// Get All PosInventoryHistories
 	routesV1.GET("/pos_inventory_histories", posInventoryHistoryController.HandleReadAllPosInventoryHistoriesRequest)
+	// Get Stock As Of Date
+	routesV1.GET("/pos_stock_as_of", posInventoryHistoryController.HandleGetStockAsOfRequest)
+	// Get Stock Card of a Product
+	routesV1.GET("/pos_stock_card/:product_id", posInventoryHistoryController.HandleReadPosStockCardRequest)
 }
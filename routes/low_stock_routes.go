@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/midlleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func PosLowStockRoutes(r *gin.Engine, posLowStockController controller.PosLowStockController) {
+	routes := r.Group("/api")
+
+	// Apply the JWT middleware to all routes in this group
+	routes.Use(midlleware.JWTAuthMiddleware())
+
+	routesV1 := routes.Group("/v1/low-stock")
+	// Get All Products at or below their reorder level
+	routesV1.GET("/pos_low_stock_products", posLowStockController.HandleListLowStockProductsRequest)
+	// Get All PosLowStockAlerts
+	routesV1.GET("/pos_low_stock_alerts", posLowStockController.HandleReadAllPosLowStockAlertsRequest)
+	// Acknowledge PosLowStockAlert
+	routesV1.PUT("/pos_low_stock_alert/:id/acknowledge", posLowStockController.HandleAcknowledgePosLowStockAlertRequest)
+}
@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/midlleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func PosNegativeStockRoutes(r *gin.Engine, posNegativeStockController controller.PosNegativeStockController) {
+	routes := r.Group("/api")
+
+	// Apply the JWT middleware to all routes in this group
+	routes.Use(midlleware.JWTAuthMiddleware())
+
+	routesV1 := routes.Group("/v1/negative-stock")
+	// Get All PosNegativeStockSettings
+	routesV1.GET("/pos_negative_stock_settings", posNegativeStockController.HandleReadPosNegativeStockSettingsRequest)
+	// Get the Negative Stock Policy of a Branch or Store
+	routesV1.GET("/pos_negative_stock_policy", posNegativeStockController.HandleResolvePosNegativeStockPolicyRequest)
+	// Set PosNegativeStockSetting of a Company, Branch or Store
+	routesV1.PUT("/pos_negative_stock_setting", posNegativeStockController.HandleUpdatePosNegativeStockSettingRequest)
+	// Delete PosNegativeStockSetting
+	routesV1.DELETE("/pos_negative_stock_setting/:scope_id", posNegativeStockController.HandleDeletePosNegativeStockSettingRequest)
+	// Get All PosNegativeStockViolations
+	routesV1.GET("/pos_negative_stock_violations", posNegativeStockController.HandleReadAllPosNegativeStockViolationsRequest)
+	// Review PosNegativeStockViolation
+	routesV1.PUT("/pos_negative_stock_violation/:id/review", posNegativeStockController.HandleReviewPosNegativeStockViolationRequest)
+}
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/midlleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func PosProductBarcodeRoutes(r *gin.Engine, posProductBarcodeController controller.PosProductBarcodeController) {
+	routes := r.Group("/api")
+
+	// Apply the JWT middleware to all routes in this group
+	routes.Use(midlleware.JWTAuthMiddleware())
+
+	routesV1 := routes.Group("/v1/product-barcodes")
+	// Create New PosProductBarcode
+	routesV1.POST("/pos_product_barcode", posProductBarcodeController.HandleCreatePosProductBarcodeRequest)
+	// Delete PosProductBarcode
+	routesV1.DELETE("/pos_product_barcode/:id", posProductBarcodeController.HandleDeletePosProductBarcodeRequest)
+	// Get All PosProductBarcodes of a PosProduct
+	routesV1.GET("/pos_product_barcodes", posProductBarcodeController.HandleReadAllPosProductBarcodesRequest)
+	// Generate an internal EAN-13, added to the PosProduct when product_id is given
+	routesV1.POST("/internal_barcode", posProductBarcodeController.HandleGenerateInternalBarcodeRequest)
+}
@@ -15,7 +15,7 @@ func PosProductRoutes(r *gin.Engine, posProductController controller.PosProductC
 	routesV1 := routes.Group("/v1/products")
 	// Create New PosProduct
 	routesV1.POST("/pos_product", posProductController.HandleCreatePosProductRequest)
-	// Get PosProduct by ID
+	// Get PosProduct by ID, with its options and variants with include_variants=true
 	routesV1.GET("/pos_product/:id", posProductController.HandleReadPosProductRequest)
 	// get PosProduct by Barcode ID
 	routesV1.GET("/pos_product_barcode/:id", posProductController.HandleReadPosProductBarcodeRequest)
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/midlleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func PosProductUnitRoutes(r *gin.Engine, posProductUnitController controller.PosProductUnitController) {
+	routes := r.Group("/api")
+
+	// Apply the JWT middleware to all routes in this group
+	routes.Use(midlleware.JWTAuthMiddleware())
+
+	routesV1 := routes.Group("/v1/product-units")
+	// Create New PosProductUnit
+	routesV1.POST("/pos_product_unit", posProductUnitController.HandleCreatePosProductUnitRequest)
+	// Update Existing PosProductUnit
+	routesV1.PUT("/pos_product_unit/:id", posProductUnitController.HandleUpdatePosProductUnitRequest)
+	// Delete PosProductUnit
+	routesV1.DELETE("/pos_product_unit/:id", posProductUnitController.HandleDeletePosProductUnitRequest)
+	// Get All PosProductUnits of a PosProduct
+	routesV1.GET("/pos_product_units", posProductUnitController.HandleReadAllPosProductUnitsRequest)
+}
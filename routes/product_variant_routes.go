@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/midlleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func PosProductVariantRoutes(r *gin.Engine, posProductVariantController controller.PosProductVariantController) {
+	routes := r.Group("/api")
+
+	// Apply the JWT middleware to all routes in this group
+	routes.Use(midlleware.JWTAuthMiddleware())
+
+	routesV1 := routes.Group("/v1/product-variants")
+	// Set the option axes of a parent PosProduct
+	routesV1.PUT("/pos_product/:id/options", posProductVariantController.HandleSetPosProductOptionsRequest)
+	// Create New variant of a parent PosProduct
+	routesV1.POST("/pos_product/:id/variant", posProductVariantController.HandleCreatePosProductVariantRequest)
+}
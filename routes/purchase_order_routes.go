@@ -0,0 +1,33 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/midlleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func PosPurchaseOrderRoutes(r *gin.Engine, posPurchaseOrderController controller.PosPurchaseOrderController) {
+	routes := r.Group("/api")
+
+	// Apply the JWT middleware to all routes in this group
+	routes.Use(midlleware.JWTAuthMiddleware())
+
+	routesV1 := routes.Group("/v1/purchase-orders")
+	// Create New draft PosPurchaseOrder
+	routesV1.POST("/pos_purchase_order", posPurchaseOrderController.HandleCreatePosPurchaseOrderRequest)
+	// Get PosPurchaseOrder by ID
+	routesV1.GET("/pos_purchase_order/:id", posPurchaseOrderController.HandleReadPosPurchaseOrderRequest)
+	// Update draft PosPurchaseOrder
+	routesV1.PUT("/pos_purchase_order/:id", posPurchaseOrderController.HandleUpdatePosPurchaseOrderRequest)
+	// Submit PosPurchaseOrder to the supplier
+	routesV1.PUT("/pos_purchase_order/:id/submit", posPurchaseOrderController.HandleSubmitPosPurchaseOrderRequest)
+	// Receive PosPurchaseOrder delivery into the store
+	routesV1.PUT("/pos_purchase_order/:id/receive", posPurchaseOrderController.HandleReceivePosPurchaseOrderRequest)
+	// Cancel PosPurchaseOrder
+	routesV1.PUT("/pos_purchase_order/:id/cancel", posPurchaseOrderController.HandleCancelPosPurchaseOrderRequest)
+	// Generate draft PosPurchaseOrders for the low stock products
+	routesV1.POST("/pos_purchase_orders/replenishment", posPurchaseOrderController.HandleGenerateReplenishmentPurchaseOrdersRequest)
+	// Get All PosPurchaseOrders
+	routesV1.GET("/pos_purchase_orders", posPurchaseOrderController.HandleReadAllPosPurchaseOrdersRequest)
+}
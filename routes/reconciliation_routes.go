@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/midlleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func PosInventoryReconciliationRoutes(r *gin.Engine, posInventoryReconciliationController controller.PosInventoryReconciliationController) {
+	routes := r.Group("/api")
+
+	// Apply the JWT middleware to all routes in this group
+	routes.Use(midlleware.JWTAuthMiddleware())
+
+	routesV1 := routes.Group("/v1/inventory-reconciliation")
+	// Report the discrepancies between the ledger and the stock, without correcting them
+	routesV1.GET("/pos_inventory_discrepancies", posInventoryReconciliationController.HandleGetPosInventoryDiscrepanciesRequest)
+	// Post reconciliation entries correcting the ledger to the stock
+	routesV1.POST("/pos_inventory_reconciliation", posInventoryReconciliationController.HandleReconcilePosInventoryRequest)
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/midlleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func PosSaleStockRoutes(r *gin.Engine, posSaleStockController controller.PosSaleStockController) {
+	routes := r.Group("/api")
+
+	// Apply the JWT middleware to all routes in this group
+	routes.Use(midlleware.JWTAuthMiddleware())
+
+	routesV1 := routes.Group("/v1/sale-stock")
+	// Deduct the stock of every line of a sale basket in one transaction
+	routesV1.POST("/pos_sale_stock_deduction", posSaleStockController.HandleDeductStockForSaleRequest)
+	// Restock returned lines of a sale
+	routesV1.POST("/pos_sale_stock_return", posSaleStockController.HandleReturnStockForSaleRequest)
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/midlleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func PosSerialNumberRoutes(r *gin.Engine, posSerialNumberController controller.PosSerialNumberController) {
+	routes := r.Group("/api")
+
+	// Apply the JWT middleware to all routes in this group
+	routes.Use(midlleware.JWTAuthMiddleware())
+
+	routesV1 := routes.Group("/v1/serial-numbers")
+	// Get Movement History of a PosSerialNumber
+	routesV1.GET("/pos_serial_number/:serial_number/history", posSerialNumberController.HandleReadPosSerialNumberHistoryRequest)
+}
@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/midlleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func PosStockLevelRoutes(r *gin.Engine, posStockLevelController controller.PosStockLevelController) {
+	routes := r.Group("/api")
+
+	// Apply the JWT middleware to all routes in this group
+	routes.Use(midlleware.JWTAuthMiddleware())
+
+	routesV1 := routes.Group("/v1/stock-levels")
+	// Get PosStockLevel by Product ID and Store ID
+	routesV1.GET("/pos_stock_level/:product_id/:store_id", posStockLevelController.HandleReadPosStockLevelRequest)
+	// Update reorder and max level of PosStockLevel
+	routesV1.PUT("/pos_stock_level/:product_id/:store_id", posStockLevelController.HandleUpdatePosStockLevelRequest)
+	// Get All PosStockLevels
+	routesV1.GET("/pos_stock_levels", posStockLevelController.HandleReadAllPosStockLevelsRequest)
+}
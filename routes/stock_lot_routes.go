@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/midlleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func PosStockLotRoutes(r *gin.Engine, posStockLotController controller.PosStockLotController) {
+	routes := r.Group("/api")
+
+	// Apply the JWT middleware to all routes in this group
+	routes.Use(midlleware.JWTAuthMiddleware())
+
+	routesV1 := routes.Group("/v1/stock-lots")
+	// Get All PosStockLots
+	routesV1.GET("/pos_stock_lots", posStockLotController.HandleReadAllPosStockLotsRequest)
+	// Get PosStockLots expiring within the given number of days
+	routesV1.GET("/pos_stock_lots/expiring", posStockLotController.HandleListExpiringPosStockLotsRequest)
+}
@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/midlleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func PosStockReservationRoutes(r *gin.Engine, posStockReservationController controller.PosStockReservationController) {
+	routes := r.Group("/api")
+
+	// Apply the JWT middleware to all routes in this group
+	routes.Use(midlleware.JWTAuthMiddleware())
+
+	routesV1 := routes.Group("/v1/stock-reservations")
+	// Reserve stock for a checkout
+	routesV1.POST("/pos_stock_reservation", posStockReservationController.HandleReservePosStockRequest)
+	// Commit PosStockReservation as a sale
+	routesV1.PUT("/pos_stock_reservation/:id/commit", posStockReservationController.HandleCommitPosStockReservationRequest)
+	// Release PosStockReservation
+	routesV1.PUT("/pos_stock_reservation/:id/release", posStockReservationController.HandleReleasePosStockReservationRequest)
+}
@@ -0,0 +1,31 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/midlleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func PosStockTakeRoutes(r *gin.Engine, posStockTakeController controller.PosStockTakeController) {
+	routes := r.Group("/api")
+
+	// Apply the JWT middleware to all routes in this group
+	routes.Use(midlleware.JWTAuthMiddleware())
+
+	routesV1 := routes.Group("/v1/stock-takes")
+	// Create New PosStockTake and snapshot the expected quantities
+	routesV1.POST("/pos_stock_take", posStockTakeController.HandleCreatePosStockTakeRequest)
+	// Get PosStockTake by ID
+	routesV1.GET("/pos_stock_take/:id", posStockTakeController.HandleReadPosStockTakeRequest)
+	// Submit counted quantities by product ID or barcode
+	routesV1.PUT("/pos_stock_take/:id/counts", posStockTakeController.HandleSubmitPosStockTakeCountsRequest)
+	// Get PosStockTake variance report before posting
+	routesV1.GET("/pos_stock_take/:id/variance", posStockTakeController.HandleReadPosStockTakeVarianceRequest)
+	// Approve PosStockTake and post the variances into inventory history
+	routesV1.PUT("/pos_stock_take/:id/approve", posStockTakeController.HandleApprovePosStockTakeRequest)
+	// Cancel PosStockTake
+	routesV1.PUT("/pos_stock_take/:id/cancel", posStockTakeController.HandleCancelPosStockTakeRequest)
+	// Get All PosStockTakes
+	routesV1.GET("/pos_stock_takes", posStockTakeController.HandleReadAllPosStockTakesRequest)
+}
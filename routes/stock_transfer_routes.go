@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/midlleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func PosStockTransferRoutes(r *gin.Engine, posStockTransferController controller.PosStockTransferController) {
+	routes := r.Group("/api")
+
+	// Apply the JWT middleware to all routes in this group
+	routes.Use(midlleware.JWTAuthMiddleware())
+
+	routesV1 := routes.Group("/v1/stock-transfers")
+	// Create New PosStockTransfer
+	routesV1.POST("/pos_stock_transfer", posStockTransferController.HandleCreatePosStockTransferRequest)
+	// Get PosStockTransfer by ID
+	routesV1.GET("/pos_stock_transfer/:id", posStockTransferController.HandleReadPosStockTransferRequest)
+	// Dispatch PosStockTransfer from the source store
+	routesV1.PUT("/pos_stock_transfer/:id/dispatch", posStockTransferController.HandleDispatchPosStockTransferRequest)
+	// Receive PosStockTransfer into the destination store
+	routesV1.PUT("/pos_stock_transfer/:id/receive", posStockTransferController.HandleReceivePosStockTransferRequest)
+	// Cancel PosStockTransfer
+	routesV1.PUT("/pos_stock_transfer/:id/cancel", posStockTransferController.HandleCancelPosStockTransferRequest)
+	// Get All PosStockTransfers
+	routesV1.GET("/pos_stock_transfers", posStockTransferController.HandleReadAllPosStockTransfersRequest)
+}
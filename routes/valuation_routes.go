@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/controller"
+	"github.com/Andrewalifb/alpha-pos-system-product-service/api/midlleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func PosInventoryValuationRoutes(r *gin.Engine, posInventoryValuationController controller.PosInventoryValuationController) {
+	routes := r.Group("/api")
+
+	// Apply the JWT middleware to all routes in this group
+	routes.Use(midlleware.JWTAuthMiddleware())
+
+	routesV1 := routes.Group("/v1/inventory-valuation")
+	// Get PosInventoryValuation as JSON, or as CSV with format=csv
+	routesV1.GET("/pos_inventory_valuation", posInventoryValuationController.HandleGetPosInventoryValuationRequest)
+}
@@ -32,6 +32,11 @@ CREATE TABLE pos_products (
     supplier_id UUID,
     product_description TEXT,
     active BOOLEAN DEFAULT TRUE,
+    serialized BOOLEAN DEFAULT FALSE,
+    base_unit VARCHAR(20) DEFAULT 'each',
+    parent_product_id UUID REFERENCES pos_products(product_id),
+    has_variants BOOLEAN DEFAULT FALSE,
+    price_override BOOLEAN DEFAULT FALSE,
     store_id UUID,
     branch_id UUID,
     company_id UUID NOT NULL,
@@ -55,12 +60,25 @@ CREATE TABLE pos_suppliers (
     updated_by UUID
 );
 
-CREATE TABLE pos_inventory_history (
+CREATE TABLE pos_inventory_histories (
     inventory_id UUID PRIMARY KEY,
     product_id UUID REFERENCES pos_products(product_id) NOT NULL,
     store_id UUID,
     date TIMESTAMP NOT NULL,
     quantity INT NOT NULL,
+    movement_type VARCHAR(50) NOT NULL DEFAULT 'adjustment',
+    note TEXT,
+    reference_no VARCHAR(255),
+    transfer_id UUID,
+    purchase_order_id UUID,
+    lot_code VARCHAR(100),
+    expiry_date TIMESTAMP,
+    unit_cost DECIMAL(12, 4),
+    cost_amount DECIMAL(14, 4),
+    reversal_of_id UUID REFERENCES pos_inventory_histories(inventory_id),
+    sale_id UUID,
+    unit VARCHAR(20),
+    unit_quantity DECIMAL(14, 4),
     branch_id UUID,
     company_id UUID NOT NULL,
     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
@@ -69,6 +87,19 @@ CREATE TABLE pos_inventory_history (
     updated_by UUID
 );
 
+-- A ledger entry can be reversed only once
+CREATE UNIQUE INDEX pos_inventory_histories_reversal_of_idx ON pos_inventory_histories (reversal_of_id) WHERE reversal_of_id IS NOT NULL;
+
+-- Movement lists are scoped to a company, branch or store, filtered by product or user and ordered by date
+CREATE INDEX pos_inventory_histories_company_date_idx ON pos_inventory_histories (company_id, date);
+CREATE INDEX pos_inventory_histories_branch_date_idx ON pos_inventory_histories (branch_id, date);
+CREATE INDEX pos_inventory_histories_store_date_idx ON pos_inventory_histories (store_id, date);
+CREATE INDEX pos_inventory_histories_product_date_idx ON pos_inventory_histories (product_id, date);
+CREATE INDEX pos_inventory_histories_created_by_idx ON pos_inventory_histories (created_by, date);
+
+-- The sale and return lines of a sale are read back when it is returned
+CREATE INDEX pos_inventory_histories_sale_idx ON pos_inventory_histories (sale_id) WHERE sale_id IS NOT NULL;
+
 CREATE TABLE pos_promotions (
     promotion_id UUID PRIMARY KEY,
     product_id UUID REFERENCES pos_products(product_id) NOT NULL,
@@ -84,3 +115,324 @@ CREATE TABLE pos_promotions (
     updated_at TIMESTAMP,
     updated_by UUID
 );
+
+CREATE TABLE pos_stock_transfers (
+    transfer_id UUID PRIMARY KEY,
+    from_store_id UUID NOT NULL,
+    from_branch_id UUID NOT NULL,
+    to_store_id UUID NOT NULL,
+    to_branch_id UUID NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    note TEXT,
+    dispatched_at TIMESTAMP,
+    dispatched_by UUID,
+    received_at TIMESTAMP,
+    received_by UUID,
+    company_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_by UUID,
+    updated_at TIMESTAMP,
+    updated_by UUID
+);
+
+CREATE TABLE pos_stock_transfer_items (
+    transfer_item_id UUID PRIMARY KEY,
+    transfer_id UUID REFERENCES pos_stock_transfers(transfer_id) NOT NULL,
+    product_id UUID REFERENCES pos_products(product_id) NOT NULL,
+    quantity INT NOT NULL
+);
+
+CREATE TABLE pos_stock_levels (
+    product_id UUID REFERENCES pos_products(product_id) NOT NULL,
+    store_id UUID NOT NULL,
+    quantity INT NOT NULL,
+    reorder_level INT,
+    max_level INT,
+    branch_id UUID NOT NULL,
+    company_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_by UUID,
+    updated_at TIMESTAMP,
+    updated_by UUID,
+    PRIMARY KEY (product_id, store_id)
+);
+
+CREATE TABLE pos_stock_takes (
+    stock_take_id UUID PRIMARY KEY,
+    store_id UUID NOT NULL,
+    branch_id UUID NOT NULL,
+    scope VARCHAR(20) NOT NULL,
+    category_id UUID REFERENCES pos_product_categories(category_id),
+    status VARCHAR(20) NOT NULL,
+    note TEXT,
+    approved_at TIMESTAMP,
+    approved_by UUID,
+    company_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_by UUID,
+    updated_at TIMESTAMP,
+    updated_by UUID
+);
+
+CREATE TABLE pos_stock_take_items (
+    stock_take_item_id UUID PRIMARY KEY,
+    stock_take_id UUID REFERENCES pos_stock_takes(stock_take_id) NOT NULL,
+    product_id UUID REFERENCES pos_products(product_id) NOT NULL,
+    expected_quantity INT NOT NULL,
+    counted_quantity INT,
+    counted_at TIMESTAMP,
+    counted_by UUID
+);
+
+CREATE TABLE pos_low_stock_alerts (
+    alert_id UUID PRIMARY KEY,
+    product_id UUID REFERENCES pos_products(product_id) NOT NULL,
+    store_id UUID NOT NULL,
+    branch_id UUID NOT NULL,
+    company_id UUID NOT NULL,
+    quantity INT NOT NULL,
+    reorder_level INT NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    acknowledged_at TIMESTAMP,
+    acknowledged_by UUID,
+    resolved_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP
+);
+
+-- Only one unresolved alert per product and store
+CREATE UNIQUE INDEX pos_low_stock_alerts_unresolved_idx ON pos_low_stock_alerts (product_id, store_id) WHERE status IN ('open', 'acknowledged');
+
+CREATE TABLE pos_purchase_orders (
+    purchase_order_id UUID PRIMARY KEY,
+    supplier_id UUID REFERENCES pos_suppliers(supplier_id) NOT NULL,
+    store_id UUID NOT NULL,
+    branch_id UUID NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    note TEXT,
+    submitted_at TIMESTAMP,
+    submitted_by UUID,
+    received_at TIMESTAMP,
+    company_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_by UUID,
+    updated_at TIMESTAMP,
+    updated_by UUID
+);
+
+CREATE TABLE pos_purchase_order_items (
+    purchase_order_item_id UUID PRIMARY KEY,
+    purchase_order_id UUID REFERENCES pos_purchase_orders(purchase_order_id) NOT NULL,
+    product_id UUID REFERENCES pos_products(product_id) NOT NULL,
+    quantity INT NOT NULL,
+    received_quantity INT NOT NULL DEFAULT 0,
+    unit_cost DECIMAL(10, 2) NOT NULL
+);
+
+CREATE TABLE pos_stock_lots (
+    lot_id UUID PRIMARY KEY,
+    product_id UUID REFERENCES pos_products(product_id) NOT NULL,
+    store_id UUID NOT NULL,
+    lot_code VARCHAR(100) NOT NULL,
+    expiry_date TIMESTAMP,
+    quantity INT NOT NULL,
+    branch_id UUID NOT NULL,
+    company_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP,
+    UNIQUE (product_id, store_id, lot_code)
+);
+
+CREATE TABLE pos_inventory_history_lots (
+    inventory_id UUID REFERENCES pos_inventory_histories(inventory_id) NOT NULL,
+    lot_id UUID REFERENCES pos_stock_lots(lot_id) NOT NULL,
+    quantity INT NOT NULL,
+    PRIMARY KEY (inventory_id, lot_id)
+);
+
+CREATE TABLE pos_costing_settings (
+    company_id UUID PRIMARY KEY,
+    costing_method VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_by UUID,
+    updated_at TIMESTAMP,
+    updated_by UUID
+);
+
+CREATE TABLE pos_cost_layers (
+    cost_layer_id UUID PRIMARY KEY,
+    product_id UUID REFERENCES pos_products(product_id) NOT NULL,
+    store_id UUID NOT NULL,
+    inventory_id UUID NOT NULL,
+    unit_cost DECIMAL(12, 4) NOT NULL,
+    quantity INT NOT NULL,
+    remaining_quantity INT NOT NULL,
+    branch_id UUID NOT NULL,
+    company_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP
+);
+
+CREATE INDEX pos_cost_layers_open_idx ON pos_cost_layers (product_id, store_id, created_at) WHERE remaining_quantity > 0;
+
+CREATE TABLE pos_stock_reservations (
+    reservation_id UUID PRIMARY KEY,
+    product_id UUID REFERENCES pos_products(product_id) NOT NULL,
+    store_id UUID NOT NULL,
+    quantity INT NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    reference_no VARCHAR(255),
+    expires_at TIMESTAMP NOT NULL,
+    inventory_id UUID,
+    branch_id UUID NOT NULL,
+    company_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_by UUID,
+    updated_at TIMESTAMP,
+    updated_by UUID
+);
+
+-- Active holds are summed on every reservation, sale and product read
+CREATE INDEX pos_stock_reservations_active_idx ON pos_stock_reservations (product_id, store_id, expires_at) WHERE status = 'active';
+
+CREATE TABLE pos_serial_numbers (
+    serial_id UUID PRIMARY KEY,
+    product_id UUID REFERENCES pos_products(product_id) NOT NULL,
+    serial_number VARCHAR(100) NOT NULL,
+    store_id UUID NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    branch_id UUID NOT NULL,
+    company_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP,
+    UNIQUE (product_id, serial_number)
+);
+
+-- Serial numbers are looked up within the company
+CREATE INDEX pos_serial_numbers_company_serial_idx ON pos_serial_numbers (company_id, serial_number);
+
+CREATE TABLE pos_inventory_history_serials (
+    inventory_id UUID REFERENCES pos_inventory_histories(inventory_id) NOT NULL,
+    serial_id UUID REFERENCES pos_serial_numbers(serial_id) NOT NULL,
+    PRIMARY KEY (inventory_id, serial_id)
+);
+
+CREATE INDEX pos_inventory_history_serials_serial_idx ON pos_inventory_history_serials (serial_id);
+
+CREATE TABLE pos_stock_transfer_item_serials (
+    transfer_item_id UUID REFERENCES pos_stock_transfer_items(transfer_item_id) NOT NULL,
+    serial_number VARCHAR(100) NOT NULL,
+    PRIMARY KEY (transfer_item_id, serial_number)
+);
+
+CREATE TABLE pos_product_units (
+    product_unit_id UUID PRIMARY KEY,
+    product_id UUID REFERENCES pos_products(product_id) NOT NULL,
+    unit VARCHAR(20) NOT NULL,
+    factor DECIMAL(14, 4) NOT NULL,
+    allow_fraction BOOLEAN DEFAULT FALSE,
+    company_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_by UUID,
+    updated_at TIMESTAMP,
+    updated_by UUID,
+    UNIQUE (product_id, unit)
+);
+
+CREATE TABLE pos_negative_stock_settings (
+    scope_id UUID PRIMARY KEY,
+    scope_type VARCHAR(20) NOT NULL,
+    policy VARCHAR(20) NOT NULL,
+    branch_id UUID,
+    company_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_by UUID,
+    updated_at TIMESTAMP,
+    updated_by UUID
+);
+
+CREATE TABLE pos_negative_stock_violations (
+    violation_id UUID PRIMARY KEY,
+    inventory_id UUID REFERENCES pos_inventory_histories(inventory_id) NOT NULL,
+    product_id UUID REFERENCES pos_products(product_id) NOT NULL,
+    store_id UUID NOT NULL,
+    quantity_before INT NOT NULL,
+    quantity_after INT NOT NULL,
+    policy VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    review_note TEXT,
+    reviewed_at TIMESTAMP,
+    reviewed_by UUID,
+    branch_id UUID NOT NULL,
+    company_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_by UUID,
+    updated_at TIMESTAMP
+);
+
+-- Open violations are listed for review
+CREATE INDEX pos_negative_stock_violations_status_idx ON pos_negative_stock_violations (company_id, status, created_at);
+
+CREATE TABLE pos_product_options (
+    option_id UUID PRIMARY KEY,
+    product_id UUID REFERENCES pos_products(product_id) NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    position INT NOT NULL,
+    company_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_by UUID,
+    updated_at TIMESTAMP,
+    updated_by UUID,
+    UNIQUE (product_id, name)
+);
+
+CREATE TABLE pos_product_variant_values (
+    product_id UUID REFERENCES pos_products(product_id) NOT NULL,
+    option_id UUID REFERENCES pos_product_options(option_id) NOT NULL,
+    value VARCHAR(100) NOT NULL,
+    PRIMARY KEY (product_id, option_id)
+);
+
+-- Variants are read by their parent product
+CREATE INDEX pos_products_parent_product_idx ON pos_products (parent_product_id) WHERE parent_product_id IS NOT NULL;
+
+CREATE TABLE pos_product_barcodes (
+    barcode_id UUID PRIMARY KEY,
+    product_id UUID REFERENCES pos_products(product_id) NOT NULL,
+    barcode VARCHAR(255) NOT NULL,
+    barcode_type VARCHAR(20) NOT NULL,
+    quantity INT NOT NULL DEFAULT 1,
+    company_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_by UUID,
+    updated_at TIMESTAMP,
+    updated_by UUID,
+    UNIQUE (company_id, barcode)
+);
+
+-- Barcodes are listed per product
+CREATE INDEX pos_product_barcodes_product_idx ON pos_product_barcodes (product_id);
+
+CREATE TABLE pos_internal_barcode_sequences (
+    company_id UUID NOT NULL,
+    prefix_range VARCHAR(30) NOT NULL,
+    next_value BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP,
+    PRIMARY KEY (company_id, prefix_range)
+);
+
+CREATE TABLE pos_embedded_barcode_rules (
+    rule_id UUID PRIMARY KEY,
+    prefix VARCHAR(5) NOT NULL,
+    plu_digits INT NOT NULL,
+    value_digits INT NOT NULL,
+    value_type VARCHAR(10) NOT NULL,
+    decimals INT NOT NULL DEFAULT 0,
+    unit VARCHAR(20),
+    company_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_by UUID,
+    updated_at TIMESTAMP,
+    updated_by UUID,
+    UNIQUE (company_id, prefix)
+);
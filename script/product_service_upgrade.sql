@@ -0,0 +1,59 @@
+-- Changes for databases created by an earlier product_service.sql or by AutoMigrate, run once after
+-- the tables of product_service.sql exist. A database created from product_service.sql does not
+-- need them.
+
+-- The movements are kept in pos_inventory_histories, the table the service reads and writes
+CREATE UNIQUE INDEX IF NOT EXISTS pos_inventory_histories_reversal_of_idx ON pos_inventory_histories (reversal_of_id) WHERE reversal_of_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS pos_inventory_histories_company_date_idx ON pos_inventory_histories (company_id, date);
+CREATE INDEX IF NOT EXISTS pos_inventory_histories_branch_date_idx ON pos_inventory_histories (branch_id, date);
+CREATE INDEX IF NOT EXISTS pos_inventory_histories_store_date_idx ON pos_inventory_histories (store_id, date);
+CREATE INDEX IF NOT EXISTS pos_inventory_histories_product_date_idx ON pos_inventory_histories (product_id, date);
+CREATE INDEX IF NOT EXISTS pos_inventory_histories_created_by_idx ON pos_inventory_histories (created_by, date);
+CREATE INDEX IF NOT EXISTS pos_inventory_histories_sale_idx ON pos_inventory_histories (sale_id) WHERE sale_id IS NOT NULL;
+
+-- Products created before stock was kept per store get the first stock level of their store from
+-- their stock quantity. Every seeded level gets an opening balance, posted by the system user, so
+-- the ledger of the store adds up to it.
+WITH seeded AS (
+    INSERT INTO pos_stock_levels (product_id, store_id, quantity, reorder_level, max_level, branch_id, company_id, created_at, created_by, updated_at, updated_by)
+    SELECT p.product_id, p.store_id, p.stock_quantity, p.reorder_level, 0, p.branch_id, p.company_id, NOW(), p.updated_by, NOW(), p.updated_by
+    FROM pos_products p
+    WHERE p.store_id IS NOT NULL AND p.store_id <> '00000000-0000-0000-0000-000000000000' AND p.branch_id IS NOT NULL
+    AND NOT EXISTS (SELECT 1 FROM pos_stock_levels sl WHERE sl.product_id = p.product_id)
+    ON CONFLICT (product_id, store_id) DO NOTHING
+    RETURNING product_id, store_id, quantity, branch_id, company_id
+), opening AS (
+    SELECT s.product_id, s.store_id, s.branch_id, s.company_id, p.cost_price,
+        s.quantity - COALESCE((SELECT SUM(h.quantity) FROM pos_inventory_histories h WHERE h.product_id = s.product_id AND h.store_id = s.store_id), 0) AS quantity
+    FROM seeded s
+    JOIN pos_products p ON p.product_id = s.product_id
+)
+INSERT INTO pos_inventory_histories (inventory_id, product_id, store_id, date, quantity, movement_type, note, unit_cost, cost_amount, branch_id, company_id, created_at, created_by, updated_at, updated_by)
+SELECT gen_random_uuid(), o.product_id, o.store_id, NOW(), o.quantity, 'opening_balance', 'opening balance of the seeded stock level', o.cost_price, o.quantity * o.cost_price, o.branch_id, o.company_id, NOW(), '00000000-0000-0000-0000-000000000001', NOW(), '00000000-0000-0000-0000-000000000001'
+FROM opening o
+WHERE o.quantity <> 0;
+
+-- valid_upc_a reports whether the code is a UPC-A with a valid check digit, it only lives for
+-- the session running this script
+CREATE FUNCTION pg_temp.valid_upc_a(code TEXT) RETURNS BOOLEAN AS $$
+    SELECT CASE WHEN code ~ '^[0-9]{12}$' THEN
+        (10 - (SELECT SUM(substr(code, i, 1)::INT * CASE WHEN i % 2 = 1 THEN 3 ELSE 1 END) FROM generate_series(1, 11) i) % 10) % 10 = substr(code, 12, 1)::INT
+    ELSE FALSE END
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- UPC-A barcodes are stored as the EAN-13 they stand for, before the barcodes are backfilled so
+-- a UPC-A and its EAN-13 end up as one barcode. A UPC-A whose EAN-13 is already stored for the
+-- company is dropped, codes with an invalid check digit are left as they are.
+UPDATE pos_products SET product_barcode_id = '0' || product_barcode_id WHERE pg_temp.valid_upc_a(product_barcode_id);
+DELETE FROM pos_product_barcodes b
+WHERE pg_temp.valid_upc_a(b.barcode)
+AND EXISTS (SELECT 1 FROM pos_product_barcodes e WHERE e.company_id = b.company_id AND e.barcode = '0' || b.barcode);
+UPDATE pos_product_barcodes SET barcode = '0' || barcode WHERE pg_temp.valid_upc_a(barcode);
+
+-- Existing products keep the barcode they were created with, the first product of a company with a barcode keeps it
+INSERT INTO pos_product_barcodes (barcode_id, product_id, barcode, barcode_type, quantity, company_id, created_at, created_by, updated_at, updated_by)
+SELECT DISTINCT ON (company_id, product_barcode_id) gen_random_uuid(), product_id, product_barcode_id, 'manufacturer', 1, company_id, created_at, created_by, updated_at, updated_by
+FROM pos_products
+WHERE product_barcode_id <> ''
+ORDER BY company_id, product_barcode_id, created_at
+ON CONFLICT (company_id, barcode) DO NOTHING;
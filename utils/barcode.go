@@ -0,0 +1,154 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NormalizeBarcode returns the barcode in the form it is stored. Numeric barcodes of a GTIN length
+// must have a valid check digit: EAN-8, UPC-E, UPC-A, EAN-13 and GTIN-14. UPC-A and UPC-E are
+// stored as the EAN-13 they stand for, so either scan finds the same product. Other barcodes are
+// only trimmed and lower cased, e.g. internal codes with letters.
+func NormalizeBarcode(barcode string) (string, error) {
+	barcode = strings.ToLower(strings.TrimSpace(barcode))
+	if !isDigits(barcode) {
+		return barcode, nil
+	}
+
+	switch len(barcode) {
+	case 8:
+		// An 8 digit barcode is a UPC-E when it starts with number system 0 or 1 and its check
+		// digit says so, or else an EAN-8. Both check digits can hold, e.g. 01234565.
+		if upcA, ok := expandUPCE(barcode); ok && ValidGTIN(upcA) {
+			return "0" + upcA, nil
+		}
+		if !ValidGTIN(barcode) {
+			return "", fmt.Errorf("barcode %s has an invalid check digit for EAN-8 or UPC-E", barcode)
+		}
+	case 12:
+		if !ValidGTIN(barcode) {
+			return "", fmt.Errorf("barcode %s has an invalid check digit for UPC-A", barcode)
+		}
+		return "0" + barcode, nil
+	case 13:
+		if !ValidGTIN(barcode) {
+			return "", fmt.Errorf("barcode %s has an invalid check digit for EAN-13", barcode)
+		}
+	case 14:
+		if !ValidGTIN(barcode) {
+			return "", fmt.Errorf("barcode %s has an invalid check digit for GTIN-14", barcode)
+		}
+	}
+
+	return barcode, nil
+}
+
+// ValidGTIN reports whether the last digit of a numeric barcode is the GTIN check digit of the
+// digits before it
+func ValidGTIN(barcode string) bool {
+	if len(barcode) < 2 || !isDigits(barcode) {
+		return false
+	}
+
+	return GTINCheckDigit(barcode[:len(barcode)-1]) == barcode[len(barcode)-1]
+}
+
+// GTINCheckDigit returns the check digit of the digits of a GTIN without it. From the right, the
+// digits are weighted 3 and 1 in turn and the check digit brings their sum to a multiple of 10.
+func GTINCheckDigit(digits string) byte {
+	sum := 0
+	for i := 0; i < len(digits); i++ {
+		digit := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 0 {
+			digit *= 3
+		}
+		sum += digit
+	}
+
+	return byte('0' + (10-sum%10)%10)
+}
+
+// expandUPCE returns the UPC-A of an 8 digit UPC-E, number system and check digit included
+func expandUPCE(upcE string) (string, bool) {
+	numberSystem := upcE[0]
+	if numberSystem != '0' && numberSystem != '1' {
+		return "", false
+	}
+
+	d := upcE[1:7]
+	check := upcE[7:]
+
+	var body string
+	switch d[5] {
+	case '0', '1', '2':
+		body = d[0:2] + d[5:6] + "0000" + d[2:5]
+	case '3':
+		body = d[0:3] + "00000" + d[3:5]
+	case '4':
+		body = d[0:4] + "00000" + d[4:5]
+	default:
+		body = d[0:5] + "0000" + d[5:6]
+	}
+
+	return string(numberSystem) + body + check, true
+}
+
+func isDigits(value string) bool {
+	if value == "" {
+		return false
+	}
+
+	for i := 0; i < len(value); i++ {
+		if value[i] < '0' || value[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ParseBarcodePrefixRange parses a range of EAN-13 prefixes of the same length, e.g. 040-049
+func ParseBarcodePrefixRange(prefixRange string) (string, string, error) {
+	parts := strings.Split(strings.TrimSpace(prefixRange), "-")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("barcode prefix range %q must be given as from-to", prefixRange)
+	}
+
+	from, to := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+	if !isDigits(from) || !isDigits(to) || len(from) != len(to) {
+		return "", "", fmt.Errorf("barcode prefix range %q must have numeric prefixes of the same length", prefixRange)
+	}
+
+	if len(from) > 11 {
+		return "", "", fmt.Errorf("barcode prefix range %q leaves no digits for the item", prefixRange)
+	}
+
+	if from > to {
+		return "", "", fmt.Errorf("barcode prefix range %q starts after it ends", prefixRange)
+	}
+
+	return from, to, nil
+}
+
+// InternalBarcode returns the EAN-13 at position n of a prefix range, the positions run through
+// the item numbers of the first prefix before moving to the next. It returns false once n is
+// past the end of the range.
+func InternalBarcode(from string, to string, n int64) (string, bool) {
+	itemDigits := 12 - len(from)
+	itemCount := int64(1)
+	for i := 0; i < itemDigits; i++ {
+		itemCount *= 10
+	}
+
+	fromValue, _ := strconv.ParseInt(from, 10, 64)
+	toValue, _ := strconv.ParseInt(to, 10, 64)
+
+	prefix := fromValue + n/itemCount
+	if n < 0 || prefix > toValue {
+		return "", false
+	}
+
+	body := fmt.Sprintf("%0*d%0*d", len(from), prefix, itemDigits, n%itemCount)
+	return body + string(GTINCheckDigit(body)), true
+}
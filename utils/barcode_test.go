@@ -0,0 +1,145 @@
+package utils
+
+import "testing"
+
+func TestGTINCheckDigit(t *testing.T) {
+	tests := []struct {
+		name   string
+		digits string
+		want   byte
+	}{
+		{"EAN-13", "400638133393", '1'},
+		{"UPC-A", "03600029145", '2'},
+		{"EAN-8", "9638507", '4'},
+		{"GTIN-14", "1001234567890", '2'},
+		{"sum already a multiple of ten", "040000000000", '8'},
+		{"all zeros", "000000000000", '0'},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GTINCheckDigit(tt.digits); got != tt.want {
+				t.Errorf("GTINCheckDigit(%q) = %q, want %q", tt.digits, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeBarcode(t *testing.T) {
+	tests := []struct {
+		name    string
+		barcode string
+		want    string
+		wantErr bool
+	}{
+		{"EAN-13 is kept", "4006381333931", "4006381333931", false},
+		{"EAN-13 with invalid check digit", "4006381333932", "", true},
+		{"UPC-A is stored as EAN-13", "036000291452", "0036000291452", false},
+		{"UPC-A with invalid check digit", "036000291453", "", true},
+		{"UPC-E is stored as EAN-13", "04252614", "0042100005264", false},
+		{"valid as UPC-E and EAN-8 reads as UPC-E", "01234565", "0012345000065", false},
+		{"EAN-8 outside the UPC-E number systems", "96385074", "96385074", false},
+		{"EAN-8 with number system zero", "00012348", "00012348", false},
+		{"invalid as UPC-E and EAN-8", "96385075", "", true},
+		{"GTIN-14 is kept", "10012345678902", "10012345678902", false},
+		{"GTIN-14 with invalid check digit", "10012345678901", "", true},
+		{"spaces are trimmed", " 4006381333931 ", "4006381333931", false},
+		{"internal code is lower cased", " SKU-001A ", "sku-001a", false},
+		{"numeric code of another length is kept", "12345", "12345", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeBarcode(tt.barcode)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeBarcode(%q) error = %v, wantErr %v", tt.barcode, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeBarcode(%q) = %q, want %q", tt.barcode, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandUPCE(t *testing.T) {
+	tests := []struct {
+		name   string
+		upcE   string
+		want   string
+		wantOk bool
+	}{
+		{"last digit 0 to 2 is the manufacturer digit", "04252614", "042100005264", true},
+		{"last digit 3", "01234535", "012300000455", true},
+		{"last digit 4", "01234545", "012340000055", true},
+		{"last digit 5 to 9 is the item digit", "01234565", "012345000065", true},
+		{"number system 1", "11234565", "112345000065", true},
+		{"number system 2 is not UPC-E", "21234565", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := expandUPCE(tt.upcE)
+			if ok != tt.wantOk || got != tt.want {
+				t.Errorf("expandUPCE(%q) = %q, %v, want %q, %v", tt.upcE, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestParseBarcodePrefixRange(t *testing.T) {
+	tests := []struct {
+		name        string
+		prefixRange string
+		wantFrom    string
+		wantTo      string
+		wantErr     bool
+	}{
+		{"range", "040-049", "040", "049", false},
+		{"spaces are trimmed", " 20 - 29 ", "20", "29", false},
+		{"single prefix", "2-2", "2", "2", false},
+		{"missing end", "040", "", "", true},
+		{"prefixes of different length", "04-049", "", "", true},
+		{"non numeric prefix", "04a-049", "", "", true},
+		{"no digits left for the item", "000000000000-000000000001", "", "", true},
+		{"starts after it ends", "049-040", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to, err := ParseBarcodePrefixRange(tt.prefixRange)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseBarcodePrefixRange(%q) error = %v, wantErr %v", tt.prefixRange, err, tt.wantErr)
+			}
+			if from != tt.wantFrom || to != tt.wantTo {
+				t.Errorf("ParseBarcodePrefixRange(%q) = %q, %q, want %q, %q", tt.prefixRange, from, to, tt.wantFrom, tt.wantTo)
+			}
+		})
+	}
+}
+
+func TestInternalBarcode(t *testing.T) {
+	tests := []struct {
+		name   string
+		from   string
+		to     string
+		n      int64
+		want   string
+		wantOk bool
+	}{
+		{"first of the range", "040", "049", 0, "0400000000008", true},
+		{"second of the range", "040", "049", 1, "0400000000015", true},
+		{"moves to the next prefix", "040", "049", 1000000000, "0410000000007", true},
+		{"last of the range", "040", "049", 9999999999, "0499999999998", true},
+		{"past the end of the range", "040", "049", 10000000000, "", false},
+		{"negative position", "040", "049", -1, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := InternalBarcode(tt.from, tt.to, tt.n)
+			if ok != tt.wantOk || got != tt.want {
+				t.Errorf("InternalBarcode(%q, %q, %d) = %q, %v, want %q, %v", tt.from, tt.to, tt.n, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
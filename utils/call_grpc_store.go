@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	pos "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+	"google.golang.org/grpc"
+)
+
+// GetPosStoreById makes a gRPC request to the company service and returns the store
+func GetPosStoreById(conn *grpc.ClientConn, id string, jwtPayload *pos.JWTPayload) (*pos.ReadPosStoreResponse, error) {
+
+	// Create a new PosStoreService client
+	client := pos.NewPosStoreServiceClient(conn)
+
+	// Prepare the request
+	req := &pos.ReadPosStoreRequest{
+		StoreId:    id,
+		JwtPayload: jwtPayload,
+	}
+
+	// Call the gRPC method
+	resp, err := client.ReadPosStore(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call gRPC method: %w", err)
+	}
+
+	return resp, nil
+}
+
+// VerifyStoreBranch checks that the store exists within the company of the login user and belongs
+// to the given branch
+func VerifyStoreBranch(conn *grpc.ClientConn, storeId, branchId string, jwtPayload *pos.JWTPayload) error {
+	resp, err := GetPosStoreById(conn, storeId, jwtPayload)
+	if err != nil {
+		return err
+	}
+
+	if resp.PosStore.GetCompanyId() != jwtPayload.CompanyId {
+		return fmt.Errorf("store %s is not found within the company", storeId)
+	}
+
+	if resp.PosStore.GetBranchId() != branchId {
+		return fmt.Errorf("store %s does not belong to branch %s", storeId, branchId)
+	}
+
+	return nil
+}
@@ -0,0 +1,11 @@
+package utils
+
+// IdempotencyKeyHeader is the REST header carrying the idempotency key of a request, it is passed to
+// the gRPC server as the IdempotencyKeyMetadata metadata
+const (
+	IdempotencyKeyHeader   = "Idempotency-Key"
+	IdempotencyKeyMetadata = "idempotency-key"
+)
+
+// MaxIdempotencyKeyLength is the longest idempotency key accepted
+const MaxIdempotencyKeyLength = 255
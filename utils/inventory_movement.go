@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+)
+
+// movementTypeSigns maps every movement type to the sign its quantity must have,
+// 1 for increases, -1 for decreases and 0 when both directions are allowed
+var movementTypeSigns = map[string]int{
+	entity.MovementTypePurchaseReceipt: 1,
+	entity.MovementTypeSale:            -1,
+	entity.MovementTypeReturn:          1,
+	entity.MovementTypeDamage:          -1,
+	entity.MovementTypeTransferIn:      1,
+	entity.MovementTypeTransferOut:     -1,
+	entity.MovementTypeCountCorrection: 0,
+	entity.MovementTypeReversal:        0,
+	entity.MovementTypeReconciliation:  0,
+}
+
+// ValidateMovementQuantity checks the quantity of an inventory movement against the sign rule of its type
+func ValidateMovementQuantity(movementType string, quantity int) error {
+	sign, ok := movementTypeSigns[movementType]
+	if !ok {
+		return fmt.Errorf("invalid movement type %q", movementType)
+	}
+
+	if quantity == 0 {
+		return fmt.Errorf("quantity of %s movement could not be zero", movementType)
+	}
+
+	if sign > 0 && quantity < 0 {
+		return fmt.Errorf("quantity of %s movement must be positive", movementType)
+	}
+
+	if sign < 0 && quantity > 0 {
+		return fmt.Errorf("quantity of %s movement must be negative", movementType)
+	}
+
+	return nil
+}
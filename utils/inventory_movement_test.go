@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/Andrewalifb/alpha-pos-system-product-service/entity"
+)
+
+func TestValidateMovementQuantity(t *testing.T) {
+	tests := []struct {
+		name         string
+		movementType string
+		quantity     int
+		wantErr      bool
+	}{
+		{"purchase receipt increases", entity.MovementTypePurchaseReceipt, 5, false},
+		{"purchase receipt can not decrease", entity.MovementTypePurchaseReceipt, -5, true},
+		{"sale decreases", entity.MovementTypeSale, -1, false},
+		{"sale can not increase", entity.MovementTypeSale, 1, true},
+		{"return increases", entity.MovementTypeReturn, 2, false},
+		{"return can not decrease", entity.MovementTypeReturn, -2, true},
+		{"damage decreases", entity.MovementTypeDamage, -3, false},
+		{"damage can not increase", entity.MovementTypeDamage, 3, true},
+		{"transfer in increases", entity.MovementTypeTransferIn, 4, false},
+		{"transfer out decreases", entity.MovementTypeTransferOut, -4, false},
+		{"transfer out can not increase", entity.MovementTypeTransferOut, 4, true},
+		{"count correction increases", entity.MovementTypeCountCorrection, 1, false},
+		{"count correction decreases", entity.MovementTypeCountCorrection, -1, false},
+		{"reversal goes both ways", entity.MovementTypeReversal, -7, false},
+		{"reconciliation goes both ways", entity.MovementTypeReconciliation, 7, false},
+		{"zero quantity", entity.MovementTypeCountCorrection, 0, true},
+		{"unknown movement type", "gift", 1, true},
+		{"empty movement type", "", 1, true},
+		{"adjustment can not be posted", entity.MovementTypeAdjustment, 1, true},
+		{"opening balance can not be posted", entity.MovementTypeOpeningBalance, 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateMovementQuantity(tt.movementType, tt.quantity)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateMovementQuantity(%q, %d) error = %v, wantErr %v", tt.movementType, tt.quantity, err, tt.wantErr)
+			}
+		})
+	}
+}
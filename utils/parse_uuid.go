@@ -1,8 +1,35 @@
 package utils
 
-import "github.com/google/uuid"
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
 
 func ParseUUID(s string) *uuid.UUID {
 	u := uuid.MustParse(s)
 	return &u
 }
+
+// UUIDString returns an empty string for a nil UUID instead of panicking
+func UUIDString(u *uuid.UUID) string {
+	if u == nil {
+		return ""
+	}
+	return u.String()
+}
+
+// ParseOptionalUUID returns nil for an empty string and an error for a malformed UUID, unlike
+// ParseUUID it never panics on request input
+func ParseOptionalUUID(s string) (*uuid.UUID, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	u, err := uuid.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a valid id", s)
+	}
+
+	return &u, nil
+}
@@ -1,6 +1,10 @@
 package utils
 
-import "os"
+import (
+	"os"
+
+	pos "github.com/Andrewalifb/alpha-pos-system-product-service/api/proto"
+)
 
 func IsSuperUser(userRole string) bool {
 	return userRole == os.Getenv("SUPER_USER_ROLE")
@@ -45,3 +49,21 @@ func VerifyBranchUserAccess(loginRole, branchId, jwtBranchId string) bool {
 func VerifyCompanyUserAccess(loginRole, companyId, jwtCompanyId string) bool {
 	return loginRole == os.Getenv("COMPANY_USER_ROLE") && companyId == jwtCompanyId
 }
+
+// VerifyStoreScopeAccess checks a record owned by a company, branch and store against the login user scope
+func VerifyStoreScopeAccess(loginRole, companyId, branchId, storeId string, jwtPayload *pos.JWTPayload) bool {
+	if companyId != jwtPayload.CompanyId {
+		return false
+	}
+
+	switch loginRole {
+	case os.Getenv("COMPANY_USER_ROLE"):
+		return VerifyCompanyUserAccess(loginRole, companyId, jwtPayload.CompanyId)
+	case os.Getenv("BRANCH_USER_ROLE"):
+		return VerifyBranchUserAccess(loginRole, branchId, jwtPayload.BranchId)
+	case os.Getenv("STORE_USER_ROLE"):
+		return VerifyStoreUserAccess(loginRole, storeId, jwtPayload.StoreId)
+	}
+
+	return false
+}
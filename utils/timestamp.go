@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TimestampFromTime returns nil for a nil time instead of panicking
+func TimestampFromTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// TimeFromTimestamp returns nil for a timestamp that is not set
+func TimeFromTimestamp(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// ParseAsOfTime accepts a date (2006-01-02), meaning the end of that day, or an RFC3339 time
+func ParseAsOfTime(value string) (time.Time, error) {
+	if date, err := time.Parse("2006-01-02", value); err == nil {
+		return date.AddDate(0, 0, 1).Add(-time.Microsecond), nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// ParseFromTime accepts a date (2006-01-02), meaning the start of that day, or an RFC3339 time
+func ParseFromTime(value string) (time.Time, error) {
+	if date, err := time.Parse("2006-01-02", value); err == nil {
+		return date, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
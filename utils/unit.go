@@ -0,0 +1,8 @@
+package utils
+
+import "strings"
+
+// NormalizeUnit returns the unit of measure in the form it is stored, e.g. " KG" becomes "kg"
+func NormalizeUnit(unit string) string {
+	return strings.ToLower(strings.TrimSpace(unit))
+}